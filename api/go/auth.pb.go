@@ -27,16 +27,24 @@ type Status int32
 const (
 	Status_OK    Status = 0
 	Status_ERROR Status = 1
+	//the password doesn't decrypt the key of the account
+	Status_UNAUTHENTICATED Status = 2
+	//the account is unknown
+	Status_NOT_FOUND Status = 3
 )
 
 var Status_name = map[int32]string{
 	0: "OK",
 	1: "ERROR",
+	2: "UNAUTHENTICATED",
+	3: "NOT_FOUND",
 }
 
 var Status_value = map[string]int32{
-	"OK":    0,
-	"ERROR": 1,
+	"OK":              0,
+	"ERROR":           1,
+	"UNAUTHENTICATED": 2,
+	"NOT_FOUND":       3,
 }
 
 func (x Status) String() string {
@@ -205,9 +213,11 @@ func (m *AddressInfo) GetMsg() string {
 }
 
 type CipherParameter struct {
-	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Message              []byte   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Message  []byte `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	//uncompressed public key to encrypt for, instead of the recorded one of address
+	PublicKey            []byte   `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -259,6 +269,13 @@ func (m *CipherParameter) GetMessage() []byte {
 	return nil
 }
 
+func (m *CipherParameter) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
 type CipherText struct {
 	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=api.Status" json:"status,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -314,6 +331,526 @@ func (m *CipherText) GetMsg() string {
 	return ""
 }
 
+type ListParameter struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListParameter) Reset()         { *m = ListParameter{} }
+func (m *ListParameter) String() string { return proto.CompactTextString(m) }
+func (*ListParameter) ProtoMessage()    {}
+func (*ListParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{5}
+}
+
+func (m *ListParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListParameter.Unmarshal(m, b)
+}
+func (m *ListParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListParameter.Marshal(b, m, deterministic)
+}
+func (m *ListParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListParameter.Merge(m, src)
+}
+func (m *ListParameter) XXX_Size() int {
+	return xxx_messageInfo_ListParameter.Size(m)
+}
+func (m *ListParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListParameter proto.InternalMessageInfo
+
+type AddressList struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=api.Status" json:"status,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Msg                  string   `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddressList) Reset()         { *m = AddressList{} }
+func (m *AddressList) String() string { return proto.CompactTextString(m) }
+func (*AddressList) ProtoMessage()    {}
+func (*AddressList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{6}
+}
+
+func (m *AddressList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddressList.Unmarshal(m, b)
+}
+func (m *AddressList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddressList.Marshal(b, m, deterministic)
+}
+func (m *AddressList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressList.Merge(m, src)
+}
+func (m *AddressList) XXX_Size() int {
+	return xxx_messageInfo_AddressList.Size(m)
+}
+func (m *AddressList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressList proto.InternalMessageInfo
+
+func (m *AddressList) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_OK
+}
+
+func (m *AddressList) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *AddressList) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type ExportParameter struct {
+	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ExportPsd            string   `protobuf:"bytes,3,opt,name=export_psd,json=exportPsd,proto3" json:"export_psd,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportParameter) Reset()         { *m = ExportParameter{} }
+func (m *ExportParameter) String() string { return proto.CompactTextString(m) }
+func (*ExportParameter) ProtoMessage()    {}
+func (*ExportParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{7}
+}
+
+func (m *ExportParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportParameter.Unmarshal(m, b)
+}
+func (m *ExportParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportParameter.Marshal(b, m, deterministic)
+}
+func (m *ExportParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportParameter.Merge(m, src)
+}
+func (m *ExportParameter) XXX_Size() int {
+	return xxx_messageInfo_ExportParameter.Size(m)
+}
+func (m *ExportParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportParameter proto.InternalMessageInfo
+
+func (m *ExportParameter) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ExportParameter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExportParameter) GetExportPsd() string {
+	if m != nil {
+		return m.ExportPsd
+	}
+	return ""
+}
+
+type KeystoreContent struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=api.Status" json:"status,omitempty"`
+	Content              []byte   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Msg                  string   `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KeystoreContent) Reset()         { *m = KeystoreContent{} }
+func (m *KeystoreContent) String() string { return proto.CompactTextString(m) }
+func (*KeystoreContent) ProtoMessage()    {}
+func (*KeystoreContent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{8}
+}
+
+func (m *KeystoreContent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KeystoreContent.Unmarshal(m, b)
+}
+func (m *KeystoreContent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KeystoreContent.Marshal(b, m, deterministic)
+}
+func (m *KeystoreContent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeystoreContent.Merge(m, src)
+}
+func (m *KeystoreContent) XXX_Size() int {
+	return xxx_messageInfo_KeystoreContent.Size(m)
+}
+func (m *KeystoreContent) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeystoreContent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeystoreContent proto.InternalMessageInfo
+
+func (m *KeystoreContent) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_OK
+}
+
+func (m *KeystoreContent) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *KeystoreContent) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type PasswordParameter struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OldPassword          string   `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PasswordParameter) Reset()         { *m = PasswordParameter{} }
+func (m *PasswordParameter) String() string { return proto.CompactTextString(m) }
+func (*PasswordParameter) ProtoMessage()    {}
+func (*PasswordParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{9}
+}
+
+func (m *PasswordParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PasswordParameter.Unmarshal(m, b)
+}
+func (m *PasswordParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PasswordParameter.Marshal(b, m, deterministic)
+}
+func (m *PasswordParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PasswordParameter.Merge(m, src)
+}
+func (m *PasswordParameter) XXX_Size() int {
+	return xxx_messageInfo_PasswordParameter.Size(m)
+}
+func (m *PasswordParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PasswordParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PasswordParameter proto.InternalMessageInfo
+
+func (m *PasswordParameter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PasswordParameter) GetOldPassword() string {
+	if m != nil {
+		return m.OldPassword
+	}
+	return ""
+}
+
+func (m *PasswordParameter) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type SignatureParameter struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message              []byte   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignatureParameter) Reset()         { *m = SignatureParameter{} }
+func (m *SignatureParameter) String() string { return proto.CompactTextString(m) }
+func (*SignatureParameter) ProtoMessage()    {}
+func (*SignatureParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{10}
+}
+
+func (m *SignatureParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureParameter.Unmarshal(m, b)
+}
+func (m *SignatureParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureParameter.Marshal(b, m, deterministic)
+}
+func (m *SignatureParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureParameter.Merge(m, src)
+}
+func (m *SignatureParameter) XXX_Size() int {
+	return xxx_messageInfo_SignatureParameter.Size(m)
+}
+func (m *SignatureParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureParameter proto.InternalMessageInfo
+
+func (m *SignatureParameter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignatureParameter) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SignatureParameter) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type MnemonicParameter struct {
+	Password             string   `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Mnemonic             string   `protobuf:"bytes,2,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Passphrase           string   `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Path                 string   `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Index                uint32   `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	Count                uint32   `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Strength             int32    `protobuf:"varint,7,opt,name=strength,proto3" json:"strength,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MnemonicParameter) Reset()         { *m = MnemonicParameter{} }
+func (m *MnemonicParameter) String() string { return proto.CompactTextString(m) }
+func (*MnemonicParameter) ProtoMessage()    {}
+func (*MnemonicParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{11}
+}
+
+func (m *MnemonicParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MnemonicParameter.Unmarshal(m, b)
+}
+func (m *MnemonicParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MnemonicParameter.Marshal(b, m, deterministic)
+}
+func (m *MnemonicParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MnemonicParameter.Merge(m, src)
+}
+func (m *MnemonicParameter) XXX_Size() int {
+	return xxx_messageInfo_MnemonicParameter.Size(m)
+}
+func (m *MnemonicParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MnemonicParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MnemonicParameter proto.InternalMessageInfo
+
+func (m *MnemonicParameter) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *MnemonicParameter) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *MnemonicParameter) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *MnemonicParameter) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MnemonicParameter) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MnemonicParameter) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MnemonicParameter) GetStrength() int32 {
+	if m != nil {
+		return m.Strength
+	}
+	return 0
+}
+
+type MnemonicInfo struct {
+	Status               Status   `protobuf:"varint,1,opt,name=status,proto3,enum=api.Status" json:"status,omitempty"`
+	Mnemonic             string   `protobuf:"bytes,2,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Addresses            []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Msg                  string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MnemonicInfo) Reset()         { *m = MnemonicInfo{} }
+func (m *MnemonicInfo) String() string { return proto.CompactTextString(m) }
+func (*MnemonicInfo) ProtoMessage()    {}
+func (*MnemonicInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{12}
+}
+
+func (m *MnemonicInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MnemonicInfo.Unmarshal(m, b)
+}
+func (m *MnemonicInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MnemonicInfo.Marshal(b, m, deterministic)
+}
+func (m *MnemonicInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MnemonicInfo.Merge(m, src)
+}
+func (m *MnemonicInfo) XXX_Size() int {
+	return xxx_messageInfo_MnemonicInfo.Size(m)
+}
+func (m *MnemonicInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_MnemonicInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MnemonicInfo proto.InternalMessageInfo
+
+func (m *MnemonicInfo) GetStatus() Status {
+	if m != nil {
+		return m.Status
+	}
+	return Status_OK
+}
+
+func (m *MnemonicInfo) GetMnemonic() string {
+	if m != nil {
+		return m.Mnemonic
+	}
+	return ""
+}
+
+func (m *MnemonicInfo) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *MnemonicInfo) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type ReKeyParameter struct {
+	Password  string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Delegatee string `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	//uncompressed public key of the delegatee, which is looked up by its address if empty
+	DelegateeKey         []byte   `protobuf:"bytes,4,opt,name=delegatee_key,json=delegateeKey,proto3" json:"delegatee_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReKeyParameter) Reset()         { *m = ReKeyParameter{} }
+func (m *ReKeyParameter) String() string { return proto.CompactTextString(m) }
+func (*ReKeyParameter) ProtoMessage()    {}
+func (*ReKeyParameter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bbd6f3875b0e874, []int{13}
+}
+
+func (m *ReKeyParameter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReKeyParameter.Unmarshal(m, b)
+}
+func (m *ReKeyParameter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReKeyParameter.Marshal(b, m, deterministic)
+}
+func (m *ReKeyParameter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReKeyParameter.Merge(m, src)
+}
+func (m *ReKeyParameter) XXX_Size() int {
+	return xxx_messageInfo_ReKeyParameter.Size(m)
+}
+func (m *ReKeyParameter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReKeyParameter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReKeyParameter proto.InternalMessageInfo
+
+func (m *ReKeyParameter) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ReKeyParameter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReKeyParameter) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *ReKeyParameter) GetDelegateeKey() []byte {
+	if m != nil {
+		return m.DelegateeKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("api.Status", Status_name, Status_value)
 	proto.RegisterType((*ImportParameter)(nil), "api.ImportParameter")
@@ -321,121 +858,293 @@ func init() {
 	proto.RegisterType((*AddressInfo)(nil), "api.AddressInfo")
 	proto.RegisterType((*CipherParameter)(nil), "api.CipherParameter")
 	proto.RegisterType((*CipherText)(nil), "api.CipherText")
+	proto.RegisterType((*ListParameter)(nil), "api.ListParameter")
+	proto.RegisterType((*AddressList)(nil), "api.AddressList")
+	proto.RegisterType((*ExportParameter)(nil), "api.ExportParameter")
+	proto.RegisterType((*KeystoreContent)(nil), "api.KeystoreContent")
+	proto.RegisterType((*PasswordParameter)(nil), "api.PasswordParameter")
+	proto.RegisterType((*SignatureParameter)(nil), "api.SignatureParameter")
+	proto.RegisterType((*MnemonicParameter)(nil), "api.MnemonicParameter")
+	proto.RegisterType((*MnemonicInfo)(nil), "api.MnemonicInfo")
+	proto.RegisterType((*ReKeyParameter)(nil), "api.ReKeyParameter")
+}
+
+func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
+
+var fileDescriptor_8bbd6f3875b0e874 = []byte{
+	// 882 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x25, 0x5b, 0x31, 0x47, 0x1f, 0xa4, 0x37, 0x69, 0x4b, 0x08, 0x4e, 0xe1, 0x30, 0x17,
+	0xb7, 0x07, 0x1f, 0xd2, 0xef, 0xd6, 0x48, 0x60, 0x58, 0x6a, 0x63, 0xa8, 0xb1, 0x05, 0x5a, 0x6e,
+	0x6f, 0x35, 0xd6, 0xe2, 0x58, 0x22, 0x22, 0x91, 0x04, 0x77, 0x15, 0x9b, 0xa7, 0xf6, 0xda, 0x1f,
+	0x56, 0xf4, 0x6f, 0x15, 0xfb, 0x41, 0x52, 0x94, 0xe5, 0x82, 0x76, 0x6e, 0x9c, 0xc7, 0x79, 0x3b,
+	0x3b, 0xb3, 0x6f, 0x1f, 0x09, 0x40, 0x17, 0x7c, 0x7a, 0x10, 0x27, 0x11, 0x8f, 0x48, 0x9d, 0xc6,
+	0x81, 0xbb, 0x00, 0xeb, 0x64, 0x1e, 0x47, 0x09, 0x1f, 0xd2, 0x84, 0xce, 0x91, 0x63, 0x42, 0xbe,
+	0x00, 0x7b, 0x1c, 0x85, 0x1c, 0x43, 0x7e, 0x19, 0x53, 0xc6, 0x6e, 0xa2, 0xc4, 0x77, 0x8c, 0x3d,
+	0x63, 0xdf, 0xf4, 0x2c, 0x8d, 0x0f, 0x35, 0x4c, 0x9e, 0x03, 0x04, 0x92, 0x7d, 0x19, 0x33, 0xdf,
+	0xa9, 0xc9, 0x24, 0x53, 0x21, 0x43, 0xe6, 0x13, 0x07, 0x9e, 0x68, 0x86, 0x53, 0xdf, 0x33, 0xf6,
+	0x5b, 0x5e, 0x16, 0xba, 0x6f, 0xc1, 0x3e, 0xf2, 0xfd, 0x04, 0x19, 0x2b, 0xea, 0x76, 0x61, 0x7b,
+	0xa5, 0x5e, 0x1e, 0x8b, 0x95, 0xa8, 0xca, 0xd7, 0x55, 0xb2, 0xd0, 0xfd, 0x03, 0x9a, 0x7a, 0xa5,
+	0x93, 0xf0, 0x3a, 0x22, 0x2f, 0xa1, 0xc1, 0x38, 0xe5, 0x0b, 0x26, 0x97, 0xe8, 0xbc, 0x6a, 0x1e,
+	0xd0, 0x38, 0x38, 0x38, 0x97, 0x90, 0xa7, 0x5f, 0xdd, 0xbf, 0x1a, 0xb1, 0xa1, 0x3e, 0x67, 0x13,
+	0xb9, 0x5b, 0xd3, 0x13, 0x8f, 0xee, 0x5f, 0x06, 0x58, 0xc7, 0x41, 0x3c, 0xc5, 0xe4, 0x23, 0x77,
+	0x2a, 0xde, 0xcc, 0x91, 0x31, 0x3a, 0xc1, 0x6c, 0x1a, 0x3a, 0x14, 0x63, 0x8c, 0x17, 0x57, 0xb3,
+	0x60, 0x7c, 0xf9, 0x1e, 0x53, 0x67, 0x53, 0xbe, 0x34, 0x15, 0x32, 0xc0, 0xd4, 0xfd, 0x1d, 0x40,
+	0xed, 0x60, 0x84, 0xb7, 0xbc, 0x5a, 0x87, 0x04, 0x36, 0x7d, 0xca, 0xa9, 0xdc, 0x42, 0xcb, 0x93,
+	0xcf, 0x6b, 0x7a, 0xb3, 0xa0, 0xfd, 0x6b, 0xc0, 0x8a, 0xa3, 0x77, 0xaf, 0xf2, 0x61, 0x0a, 0xbc,
+	0x5a, 0xa9, 0x5d, 0x30, 0x75, 0x87, 0x28, 0x5a, 0xae, 0x0b, 0x09, 0xe4, 0xc0, 0x9a, 0xa2, 0xd7,
+	0x60, 0xf5, 0x6f, 0xcb, 0x8a, 0x7b, 0xdc, 0x3c, 0x9f, 0x03, 0xe0, 0x6d, 0x2e, 0x3e, 0x55, 0xc1,
+	0x54, 0xc8, 0x90, 0xf9, 0xee, 0x15, 0x58, 0x03, 0x4c, 0x19, 0x8f, 0x12, 0x3c, 0x56, 0xaa, 0xab,
+	0x2c, 0x8e, 0x4c, 0xb4, 0xb5, 0x92, 0x68, 0xd7, 0xf4, 0xc2, 0x60, 0x27, 0xbb, 0x0b, 0x45, 0x37,
+	0x4b, 0x3b, 0x36, 0xca, 0x3b, 0x7e, 0x01, 0xad, 0x68, 0xe6, 0x17, 0xb7, 0x4a, 0x35, 0xd4, 0x8c,
+	0x66, 0x7e, 0x7e, 0xa3, 0x5e, 0x40, 0x2b, 0xc4, 0x9b, 0x22, 0x45, 0x15, 0x6b, 0x86, 0x78, 0x93,
+	0xa5, 0xb8, 0xd7, 0x40, 0xce, 0x83, 0x49, 0x48, 0xf9, 0x22, 0xc1, 0x2a, 0x55, 0x97, 0x74, 0x57,
+	0x2b, 0xeb, 0x6e, 0x17, 0x4c, 0x96, 0xad, 0xa4, 0x35, 0x59, 0x00, 0xee, 0xbf, 0x06, 0xec, 0xbc,
+	0x0b, 0x71, 0x1e, 0x85, 0xc1, 0xb8, 0xda, 0x59, 0x75, 0x61, 0x7b, 0xae, 0x09, 0xba, 0xb7, 0x3c,
+	0x26, 0x9f, 0x03, 0x88, 0xbc, 0x78, 0x9a, 0x50, 0x86, 0xba, 0xad, 0x25, 0x44, 0x28, 0x36, 0xa6,
+	0x7c, 0x2a, 0xd5, 0x6f, 0x7a, 0xf2, 0x99, 0x3c, 0x83, 0xad, 0x20, 0xf4, 0xf1, 0xd6, 0xd9, 0xda,
+	0x33, 0xf6, 0xdb, 0x9e, 0x0a, 0x04, 0x3a, 0x8e, 0x16, 0x21, 0x77, 0x1a, 0x0a, 0x95, 0x81, 0xa8,
+	0xcd, 0x78, 0x82, 0xe1, 0x84, 0x4f, 0x9d, 0x27, 0x7b, 0xc6, 0xfe, 0x96, 0x97, 0xc7, 0xee, 0x9f,
+	0xd0, 0xca, 0x1a, 0xa9, 0x6e, 0x12, 0xff, 0xd7, 0x4c, 0x49, 0xf3, 0xf5, 0x7b, 0x34, 0xbf, 0x59,
+	0xe8, 0xe4, 0x6f, 0x03, 0x3a, 0x1e, 0x0e, 0x30, 0xfd, 0x58, 0xcd, 0xef, 0x82, 0xe9, 0xe3, 0x0c,
+	0x27, 0x94, 0x63, 0x36, 0xc4, 0x02, 0x20, 0x2f, 0xa1, 0x9d, 0x07, 0x4b, 0x56, 0xd2, 0xca, 0xc1,
+	0x01, 0xa6, 0x5f, 0xbe, 0x81, 0x86, 0xea, 0x94, 0x34, 0xa0, 0x76, 0x36, 0xb0, 0x37, 0x88, 0x09,
+	0x5b, 0x7d, 0xcf, 0x3b, 0xf3, 0x6c, 0x83, 0x3c, 0x05, 0xeb, 0xe2, 0xf4, 0xe8, 0x62, 0xf4, 0xb6,
+	0x7f, 0x3a, 0x3a, 0x39, 0x3e, 0x1a, 0xf5, 0x7b, 0x76, 0x8d, 0xb4, 0xc1, 0x3c, 0x3d, 0x1b, 0x5d,
+	0xfe, 0x7c, 0x76, 0x71, 0xda, 0xb3, 0xeb, 0xaf, 0xfe, 0xd9, 0x06, 0x18, 0x60, 0x7a, 0x8e, 0xc9,
+	0x87, 0x60, 0x8c, 0xe4, 0x10, 0xac, 0x5f, 0x30, 0xc4, 0x84, 0x72, 0xd4, 0xde, 0x41, 0x3e, 0x91,
+	0xf3, 0x5c, 0x35, 0xf8, 0xae, 0xbd, 0x0c, 0x8b, 0x83, 0x70, 0x37, 0xc8, 0x8f, 0xd0, 0xfe, 0x0d,
+	0x93, 0xe0, 0x3a, 0x7d, 0x04, 0xf7, 0x07, 0xe8, 0xe8, 0x9b, 0xdd, 0x0f, 0xc7, 0x49, 0x1a, 0x73,
+	0xf2, 0x4c, 0x66, 0xad, 0xd8, 0x75, 0xd7, 0x5a, 0x42, 0x85, 0x85, 0x96, 0xa8, 0x3d, 0x7c, 0x20,
+	0xf5, 0x6b, 0x30, 0xf3, 0xeb, 0x57, 0x9d, 0xf5, 0x13, 0x58, 0xfa, 0x4b, 0xf9, 0x5e, 0x9b, 0x92,
+	0xe6, 0xae, 0x7c, 0x7d, 0xd7, 0x36, 0xfa, 0x9d, 0xf2, 0xe9, 0xa3, 0x5c, 0x61, 0x44, 0x26, 0x95,
+	0xbc, 0xbb, 0x4c, 0x14, 0xaf, 0xdc, 0x0d, 0xf2, 0x1a, 0x3a, 0xca, 0x6b, 0x07, 0xe5, 0xa2, 0x2b,
+	0x06, 0xdc, 0x55, 0xe8, 0x8a, 0x5d, 0xba, 0x1b, 0xe4, 0x10, 0x3a, 0xc7, 0x53, 0x1a, 0x4e, 0x30,
+	0xf7, 0xa7, 0x4f, 0x65, 0xe6, 0x1d, 0xd3, 0xbb, 0xef, 0x6c, 0x7b, 0x38, 0xc3, 0x47, 0xe9, 0xe2,
+	0x5b, 0x68, 0x8a, 0x29, 0xbf, 0xd3, 0x4e, 0x55, 0x79, 0xce, 0xdf, 0x43, 0x5b, 0xf0, 0x46, 0x69,
+	0x8c, 0x7e, 0x4f, 0x7c, 0xf5, 0x2a, 0x33, 0x0f, 0x33, 0x25, 0x66, 0x35, 0x3f, 0x53, 0xae, 0x70,
+	0xc7, 0x6a, 0xd7, 0xee, 0xf7, 0x35, 0x58, 0x8a, 0x5d, 0x54, 0x7e, 0x10, 0xff, 0x0d, 0xd8, 0xd9,
+	0x2d, 0xca, 0xac, 0x4a, 0xcf, 0xfa, 0x8e, 0x05, 0x77, 0x77, 0x4a, 0x78, 0xb1, 0x81, 0x1e, 0x26,
+	0xc1, 0x07, 0x2c, 0x54, 0xf2, 0x20, 0xfe, 0x37, 0x60, 0x0e, 0xb3, 0x3f, 0x8e, 0xfb, 0x0e, 0x6a,
+	0xed, 0x45, 0xb2, 0x3c, 0xd4, 0xd7, 0x2f, 0x88, 0x42, 0x41, 0x7e, 0x2a, 0xb3, 0xca, 0x76, 0xb7,
+	0x86, 0x7a, 0xd5, 0x90, 0xbf, 0xa1, 0x5f, 0xfd, 0x37, 0x00, 0x5e, 0xd8, 0x53, 0x81, 0x94, 0x0a,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// KeyServiceClient is the client API for KeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type KeyServiceClient interface {
+	//Generate address
+	GenerateAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//Proof address
+	VerifyAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//Content encryption
+	ContentEncrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
+	//Content decryption
+	ContentDecrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
+	//Info Signature
+	Signature(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
+	//Input keystore file content
+	ImportKeystore(ctx context.Context, in *ImportParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//List addresses
+	ListAddresses(ctx context.Context, in *ListParameter, opts ...grpc.CallOption) (*AddressList, error)
+	//Export keystore file content
+	ExportKeystore(ctx context.Context, in *ExportParameter, opts ...grpc.CallOption) (*KeystoreContent, error)
+	//Change password
+	ChangePassword(ctx context.Context, in *PasswordParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//Delete address
+	DeleteAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//Sign EIP-191 personal message
+	SignMessage(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
+	//Sign EIP-712 typed data, message is the typed data in json
+	SignTypedData(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
+	//Recover signer of EIP-191 personal message
+	VerifyMessage(ctx context.Context, in *SignatureParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//Recover signer of EIP-712 typed data
+	VerifyTypedData(ctx context.Context, in *SignatureParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+	//Generate mnemonic and the addresses derived from it
+	GenerateMnemonic(ctx context.Context, in *MnemonicParameter, opts ...grpc.CallOption) (*MnemonicInfo, error)
+	//Derive addresses from mnemonic, for recovery and more addresses of the mnemonic
+	DeriveAddresses(ctx context.Context, in *MnemonicParameter, opts ...grpc.CallOption) (*MnemonicInfo, error)
+	//Public key of address, uncompressed
+	PublicKey(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*CipherText, error)
+	//Issue proxy re-encryption key from address to delegatee
+	ReEncryptionKey(ctx context.Context, in *ReKeyParameter, opts ...grpc.CallOption) (*CipherText, error)
+}
+
+type keyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewKeyServiceClient(cc *grpc.ClientConn) KeyServiceClient {
+	return &keyServiceClient{cc}
+}
+
+func (c *keyServiceClient) GenerateAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/GenerateAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) VerifyAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/VerifyAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) ContentEncrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
+	out := new(CipherText)
+	err := c.cc.Invoke(ctx, "/api.KeyService/ContentEncrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) ContentDecrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
+	out := new(CipherText)
+	err := c.cc.Invoke(ctx, "/api.KeyService/ContentDecrypt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) Signature(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
+	out := new(CipherText)
+	err := c.cc.Invoke(ctx, "/api.KeyService/Signature", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) ImportKeystore(ctx context.Context, in *ImportParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/import_keystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyServiceClient) ListAddresses(ctx context.Context, in *ListParameter, opts ...grpc.CallOption) (*AddressList, error) {
+	out := new(AddressList)
+	err := c.cc.Invoke(ctx, "/api.KeyService/ListAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func init() { proto.RegisterFile("auth.proto", fileDescriptor_8bbd6f3875b0e874) }
-
-var fileDescriptor_8bbd6f3875b0e874 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcf, 0x6f, 0xda, 0x30,
-	0x14, 0x26, 0xb0, 0x65, 0xcb, 0x83, 0x91, 0xc8, 0xda, 0xa4, 0x88, 0x69, 0x12, 0xca, 0x2e, 0x6c,
-	0x07, 0x0e, 0x6c, 0x97, 0xfd, 0xb8, 0x4c, 0x0c, 0x6d, 0x88, 0x03, 0xc8, 0x54, 0xed, 0xad, 0xc8,
-	0x25, 0x0f, 0x88, 0xaa, 0x24, 0x96, 0xed, 0xb4, 0xcd, 0x5f, 0xdd, 0x7f, 0xa1, 0x8a, 0x63, 0x28,
-	0x4d, 0x5b, 0x89, 0xb6, 0x37, 0xbf, 0xcf, 0xef, 0xfb, 0xbe, 0xe7, 0xcf, 0x36, 0x00, 0xcb, 0xd4,
-	0xa6, 0xcf, 0x45, 0xaa, 0x52, 0xd2, 0x60, 0x3c, 0x0a, 0x32, 0x70, 0xc7, 0x31, 0x4f, 0x85, 0x9a,
-	0x31, 0xc1, 0x62, 0x54, 0x28, 0xc8, 0x17, 0xf0, 0x96, 0x69, 0xa2, 0x30, 0x51, 0x0b, 0xce, 0xa4,
-	0xbc, 0x4c, 0x45, 0xe8, 0x5b, 0x5d, 0xab, 0xe7, 0x50, 0xd7, 0xe0, 0x33, 0x03, 0x93, 0x4f, 0x00,
-	0x91, 0x66, 0x2f, 0xb8, 0x0c, 0xfd, 0xba, 0x6e, 0x72, 0x4a, 0x64, 0x26, 0x43, 0xe2, 0xc3, 0x1b,
-	0xc3, 0xf0, 0x1b, 0x5d, 0xab, 0xd7, 0xa2, 0xdb, 0x32, 0xf8, 0x0f, 0xde, 0x9f, 0x30, 0x14, 0x28,
-	0xe5, 0xad, 0x6f, 0x07, 0xde, 0x56, 0xfc, 0x76, 0x75, 0xa1, 0xc4, 0xca, 0x7e, 0xe3, 0xb2, 0x2d,
-	0x83, 0x53, 0x68, 0x1a, 0xa5, 0x71, 0xb2, 0x4a, 0xc9, 0x67, 0xb0, 0xa5, 0x62, 0x2a, 0x93, 0x5a,
-	0xa2, 0x3d, 0x68, 0xf6, 0x19, 0x8f, 0xfa, 0x73, 0x0d, 0x51, 0xb3, 0xf5, 0xb8, 0x1a, 0xf1, 0xa0,
-	0x11, 0xcb, 0xb5, 0x9e, 0xd6, 0xa1, 0xc5, 0x32, 0x60, 0xe0, 0x0e, 0x23, 0xbe, 0x41, 0xf1, 0xc2,
-	0x41, 0x8b, 0x9d, 0x18, 0xa5, 0x64, 0x6b, 0xdc, 0x86, 0x61, 0xca, 0xe0, 0x04, 0xa0, 0xb4, 0x38,
-	0xc2, 0x2b, 0x75, 0xd8, 0x09, 0x08, 0xbc, 0x0a, 0x99, 0x62, 0xda, 0xa3, 0x45, 0xf5, 0xfa, 0xfe,
-	0xec, 0x5f, 0x3f, 0x82, 0x5d, 0xf2, 0x88, 0x0d, 0xf5, 0xe9, 0xc4, 0xab, 0x11, 0x07, 0x5e, 0x8f,
-	0x28, 0x9d, 0x52, 0xcf, 0x1a, 0x5c, 0xd7, 0x01, 0x26, 0x98, 0xcf, 0x51, 0x5c, 0x44, 0x4b, 0x24,
-	0xbf, 0xc1, 0xfd, 0x87, 0x09, 0x0a, 0xa6, 0xd0, 0xe4, 0x49, 0x3e, 0x68, 0xe7, 0xea, 0x3d, 0x75,
-	0xbc, 0x7d, 0xb8, 0x08, 0x3d, 0xa8, 0x91, 0x9f, 0xf0, 0xee, 0x18, 0x45, 0xb4, 0xca, 0x9f, 0xc1,
-	0xfd, 0x01, 0xed, 0x61, 0xf9, 0x2c, 0x46, 0xc9, 0x52, 0xe4, 0x5c, 0x91, 0xf7, 0xba, 0xab, 0x12,
-	0x7b, 0xc7, 0xdd, 0x43, 0x8b, 0xa4, 0xee, 0x50, 0xff, 0xe2, 0x13, 0xa9, 0xdf, 0xc1, 0x99, 0x47,
-	0xeb, 0x84, 0xa9, 0x4c, 0xe0, 0xe1, 0xac, 0x5f, 0xe0, 0x9a, 0x07, 0x7f, 0x8e, 0xb9, 0x54, 0xe9,
-	0x8e, 0x5b, 0xf9, 0x44, 0x0f, 0x1d, 0xf4, 0xcc, 0xd6, 0xff, 0xee, 0xdb, 0x4d, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xe2, 0x0d, 0x78, 0x35, 0x85, 0x03, 0x00, 0x00,
+func (c *keyServiceClient) ExportKeystore(ctx context.Context, in *ExportParameter, opts ...grpc.CallOption) (*KeystoreContent, error) {
+	out := new(KeystoreContent)
+	err := c.cc.Invoke(ctx, "/api.KeyService/ExportKeystore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (c *keyServiceClient) ChangePassword(ctx context.Context, in *PasswordParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-// KeyServiceClient is the client API for KeyService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type KeyServiceClient interface {
-	//Generate address
-	GenerateAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error)
-	//Proof address
-	VerifyAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error)
-	//Content encryption
-	ContentEncrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
-	//Content decryption
-	ContentDecrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
-	//Info Signature
-	Signature(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error)
-	//Input keystore file content
-	ImportKeystore(ctx context.Context, in *ImportParameter, opts ...grpc.CallOption) (*AddressInfo, error)
+func (c *keyServiceClient) DeleteAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+	out := new(AddressInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/DeleteAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type keyServiceClient struct {
-	cc *grpc.ClientConn
+func (c *keyServiceClient) SignMessage(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
+	out := new(CipherText)
+	err := c.cc.Invoke(ctx, "/api.KeyService/SignMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewKeyServiceClient(cc *grpc.ClientConn) KeyServiceClient {
-	return &keyServiceClient{cc}
+func (c *keyServiceClient) SignTypedData(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
+	out := new(CipherText)
+	err := c.cc.Invoke(ctx, "/api.KeyService/SignTypedData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *keyServiceClient) GenerateAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+func (c *keyServiceClient) VerifyMessage(ctx context.Context, in *SignatureParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
 	out := new(AddressInfo)
-	err := c.cc.Invoke(ctx, "/api.KeyService/GenerateAddress", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.KeyService/VerifyMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keyServiceClient) VerifyAddress(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
+func (c *keyServiceClient) VerifyTypedData(ctx context.Context, in *SignatureParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
 	out := new(AddressInfo)
-	err := c.cc.Invoke(ctx, "/api.KeyService/VerifyAddress", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.KeyService/VerifyTypedData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keyServiceClient) ContentEncrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
-	out := new(CipherText)
-	err := c.cc.Invoke(ctx, "/api.KeyService/ContentEncrypt", in, out, opts...)
+func (c *keyServiceClient) GenerateMnemonic(ctx context.Context, in *MnemonicParameter, opts ...grpc.CallOption) (*MnemonicInfo, error) {
+	out := new(MnemonicInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/GenerateMnemonic", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keyServiceClient) ContentDecrypt(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
-	out := new(CipherText)
-	err := c.cc.Invoke(ctx, "/api.KeyService/ContentDecrypt", in, out, opts...)
+func (c *keyServiceClient) DeriveAddresses(ctx context.Context, in *MnemonicParameter, opts ...grpc.CallOption) (*MnemonicInfo, error) {
+	out := new(MnemonicInfo)
+	err := c.cc.Invoke(ctx, "/api.KeyService/DeriveAddresses", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keyServiceClient) Signature(ctx context.Context, in *CipherParameter, opts ...grpc.CallOption) (*CipherText, error) {
+func (c *keyServiceClient) PublicKey(ctx context.Context, in *AddressParameter, opts ...grpc.CallOption) (*CipherText, error) {
 	out := new(CipherText)
-	err := c.cc.Invoke(ctx, "/api.KeyService/Signature", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.KeyService/PublicKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *keyServiceClient) ImportKeystore(ctx context.Context, in *ImportParameter, opts ...grpc.CallOption) (*AddressInfo, error) {
-	out := new(AddressInfo)
-	err := c.cc.Invoke(ctx, "/api.KeyService/import_keystore", in, out, opts...)
+func (c *keyServiceClient) ReEncryptionKey(ctx context.Context, in *ReKeyParameter, opts ...grpc.CallOption) (*CipherText, error) {
+	out := new(CipherText)
+	err := c.cc.Invoke(ctx, "/api.KeyService/ReEncryptionKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -456,6 +1165,30 @@ type KeyServiceServer interface {
 	Signature(context.Context, *CipherParameter) (*CipherText, error)
 	//Input keystore file content
 	ImportKeystore(context.Context, *ImportParameter) (*AddressInfo, error)
+	//List addresses
+	ListAddresses(context.Context, *ListParameter) (*AddressList, error)
+	//Export keystore file content
+	ExportKeystore(context.Context, *ExportParameter) (*KeystoreContent, error)
+	//Change password
+	ChangePassword(context.Context, *PasswordParameter) (*AddressInfo, error)
+	//Delete address
+	DeleteAddress(context.Context, *AddressParameter) (*AddressInfo, error)
+	//Sign EIP-191 personal message
+	SignMessage(context.Context, *CipherParameter) (*CipherText, error)
+	//Sign EIP-712 typed data, message is the typed data in json
+	SignTypedData(context.Context, *CipherParameter) (*CipherText, error)
+	//Recover signer of EIP-191 personal message
+	VerifyMessage(context.Context, *SignatureParameter) (*AddressInfo, error)
+	//Recover signer of EIP-712 typed data
+	VerifyTypedData(context.Context, *SignatureParameter) (*AddressInfo, error)
+	//Generate mnemonic and the addresses derived from it
+	GenerateMnemonic(context.Context, *MnemonicParameter) (*MnemonicInfo, error)
+	//Derive addresses from mnemonic, for recovery and more addresses of the mnemonic
+	DeriveAddresses(context.Context, *MnemonicParameter) (*MnemonicInfo, error)
+	//Public key of address, uncompressed
+	PublicKey(context.Context, *AddressParameter) (*CipherText, error)
+	//Issue proxy re-encryption key from address to delegatee
+	ReEncryptionKey(context.Context, *ReKeyParameter) (*CipherText, error)
 }
 
 func RegisterKeyServiceServer(s *grpc.Server, srv KeyServiceServer) {
@@ -570,6 +1303,222 @@ func _KeyService_ImportKeystore_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyService_ListAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).ListAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/ListAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).ListAddresses(ctx, req.(*ListParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_ExportKeystore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).ExportKeystore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/ExportKeystore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).ExportKeystore(ctx, req.(*ExportParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PasswordParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).ChangePassword(ctx, req.(*PasswordParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/DeleteAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).DeleteAddress(ctx, req.(*AddressParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CipherParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).SignMessage(ctx, req.(*CipherParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_SignTypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CipherParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).SignTypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/SignTypedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).SignTypedData(ctx, req.(*CipherParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignatureParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).VerifyMessage(ctx, req.(*SignatureParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_VerifyTypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignatureParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).VerifyTypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/VerifyTypedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).VerifyTypedData(ctx, req.(*SignatureParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_GenerateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MnemonicParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).GenerateMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/GenerateMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).GenerateMnemonic(ctx, req.(*MnemonicParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_DeriveAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MnemonicParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).DeriveAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/DeriveAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).DeriveAddresses(ctx, req.(*MnemonicParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_PublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).PublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/PublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).PublicKey(ctx, req.(*AddressParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyService_ReEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReKeyParameter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyServiceServer).ReEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.KeyService/ReEncryptionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyServiceServer).ReEncryptionKey(ctx, req.(*ReKeyParameter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _KeyService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.KeyService",
 	HandlerType: (*KeyServiceServer)(nil),
@@ -598,6 +1547,54 @@ var _KeyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "import_keystore",
 			Handler:    _KeyService_ImportKeystore_Handler,
 		},
+		{
+			MethodName: "ListAddresses",
+			Handler:    _KeyService_ListAddresses_Handler,
+		},
+		{
+			MethodName: "ExportKeystore",
+			Handler:    _KeyService_ExportKeystore_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _KeyService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteAddress",
+			Handler:    _KeyService_DeleteAddress_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _KeyService_SignMessage_Handler,
+		},
+		{
+			MethodName: "SignTypedData",
+			Handler:    _KeyService_SignTypedData_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _KeyService_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "VerifyTypedData",
+			Handler:    _KeyService_VerifyTypedData_Handler,
+		},
+		{
+			MethodName: "GenerateMnemonic",
+			Handler:    _KeyService_GenerateMnemonic_Handler,
+		},
+		{
+			MethodName: "DeriveAddresses",
+			Handler:    _KeyService_DeriveAddresses_Handler,
+		},
+		{
+			MethodName: "PublicKey",
+			Handler:    _KeyService_PublicKey_Handler,
+		},
+		{
+			MethodName: "ReEncryptionKey",
+			Handler:    _KeyService_ReEncryptionKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
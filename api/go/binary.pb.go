@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	math "math"
 )
@@ -108,11 +109,311 @@ func (m *AccountResult) GetAccountId() string {
 	return ""
 }
 
+type ImportAccountParams struct {
+	Keystore             []byte   `protobuf:"bytes,1,opt,name=keystore,proto3" json:"keystore,omitempty"`
+	KeystorePassword     string   `protobuf:"bytes,2,opt,name=keystorePassword,proto3" json:"keystorePassword,omitempty"`
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportAccountParams) Reset()         { *m = ImportAccountParams{} }
+func (m *ImportAccountParams) String() string { return proto.CompactTextString(m) }
+func (*ImportAccountParams) ProtoMessage()    {}
+func (*ImportAccountParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{2}
+}
+
+func (m *ImportAccountParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportAccountParams.Unmarshal(m, b)
+}
+func (m *ImportAccountParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportAccountParams.Marshal(b, m, deterministic)
+}
+func (m *ImportAccountParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportAccountParams.Merge(m, src)
+}
+func (m *ImportAccountParams) XXX_Size() int {
+	return xxx_messageInfo_ImportAccountParams.Size(m)
+}
+func (m *ImportAccountParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportAccountParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportAccountParams proto.InternalMessageInfo
+
+func (m *ImportAccountParams) GetKeystore() []byte {
+	if m != nil {
+		return m.Keystore
+	}
+	return nil
+}
+
+func (m *ImportAccountParams) GetKeystorePassword() string {
+	if m != nil {
+		return m.KeystorePassword
+	}
+	return ""
+}
+
+func (m *ImportAccountParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ListAccountsParams struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAccountsParams) Reset()         { *m = ListAccountsParams{} }
+func (m *ListAccountsParams) String() string { return proto.CompactTextString(m) }
+func (*ListAccountsParams) ProtoMessage()    {}
+func (*ListAccountsParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{3}
+}
+
+func (m *ListAccountsParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAccountsParams.Unmarshal(m, b)
+}
+func (m *ListAccountsParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAccountsParams.Marshal(b, m, deterministic)
+}
+func (m *ListAccountsParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAccountsParams.Merge(m, src)
+}
+func (m *ListAccountsParams) XXX_Size() int {
+	return xxx_messageInfo_ListAccountsParams.Size(m)
+}
+func (m *ListAccountsParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAccountsParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAccountsParams proto.InternalMessageInfo
+
+func (m *ListAccountsParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type AccountListResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	AccountIds           []string `protobuf:"bytes,2,rep,name=accountIds,proto3" json:"accountIds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountListResult) Reset()         { *m = AccountListResult{} }
+func (m *AccountListResult) String() string { return proto.CompactTextString(m) }
+func (*AccountListResult) ProtoMessage()    {}
+func (*AccountListResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{4}
+}
+
+func (m *AccountListResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountListResult.Unmarshal(m, b)
+}
+func (m *AccountListResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountListResult.Marshal(b, m, deterministic)
+}
+func (m *AccountListResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountListResult.Merge(m, src)
+}
+func (m *AccountListResult) XXX_Size() int {
+	return xxx_messageInfo_AccountListResult.Size(m)
+}
+func (m *AccountListResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountListResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountListResult proto.InternalMessageInfo
+
+func (m *AccountListResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *AccountListResult) GetAccountIds() []string {
+	if m != nil {
+		return m.AccountIds
+	}
+	return nil
+}
+
+type ExportAccountParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ExportPassword       string   `protobuf:"bytes,3,opt,name=exportPassword,proto3" json:"exportPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountParams) Reset()         { *m = ExportAccountParams{} }
+func (m *ExportAccountParams) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountParams) ProtoMessage()    {}
+func (*ExportAccountParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{5}
+}
+
+func (m *ExportAccountParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountParams.Unmarshal(m, b)
+}
+func (m *ExportAccountParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountParams.Marshal(b, m, deterministic)
+}
+func (m *ExportAccountParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountParams.Merge(m, src)
+}
+func (m *ExportAccountParams) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountParams.Size(m)
+}
+func (m *ExportAccountParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountParams proto.InternalMessageInfo
+
+func (m *ExportAccountParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExportAccountParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *ExportAccountParams) GetExportPassword() string {
+	if m != nil {
+		return m.ExportPassword
+	}
+	return ""
+}
+
+type ExportAccountResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	KeyJson              []byte   `protobuf:"bytes,2,opt,name=keyJson,proto3" json:"keyJson,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportAccountResult) Reset()         { *m = ExportAccountResult{} }
+func (m *ExportAccountResult) String() string { return proto.CompactTextString(m) }
+func (*ExportAccountResult) ProtoMessage()    {}
+func (*ExportAccountResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{6}
+}
+
+func (m *ExportAccountResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportAccountResult.Unmarshal(m, b)
+}
+func (m *ExportAccountResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportAccountResult.Marshal(b, m, deterministic)
+}
+func (m *ExportAccountResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportAccountResult.Merge(m, src)
+}
+func (m *ExportAccountResult) XXX_Size() int {
+	return xxx_messageInfo_ExportAccountResult.Size(m)
+}
+func (m *ExportAccountResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportAccountResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportAccountResult proto.InternalMessageInfo
+
+func (m *ExportAccountResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *ExportAccountResult) GetKeyJson() []byte {
+	if m != nil {
+		return m.KeyJson
+	}
+	return nil
+}
+
+type ChangePasswordParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	OldPassword          string   `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePasswordParams) Reset()         { *m = ChangePasswordParams{} }
+func (m *ChangePasswordParams) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordParams) ProtoMessage()    {}
+func (*ChangePasswordParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{7}
+}
+
+func (m *ChangePasswordParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordParams.Unmarshal(m, b)
+}
+func (m *ChangePasswordParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordParams.Marshal(b, m, deterministic)
+}
+func (m *ChangePasswordParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordParams.Merge(m, src)
+}
+func (m *ChangePasswordParams) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordParams.Size(m)
+}
+func (m *ChangePasswordParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordParams proto.InternalMessageInfo
+
+func (m *ChangePasswordParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ChangePasswordParams) GetOldPassword() string {
+	if m != nil {
+		return m.OldPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordParams) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
 type TransferEthParams struct {
 	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Value                int64    `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Value                int64    `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	Token                string   `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	ValueDecimal         string   `protobuf:"bytes,6,opt,name=valueDecimal,proto3" json:"valueDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -122,7 +423,7 @@ func (m *TransferEthParams) Reset()         { *m = TransferEthParams{} }
 func (m *TransferEthParams) String() string { return proto.CompactTextString(m) }
 func (*TransferEthParams) ProtoMessage()    {}
 func (*TransferEthParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{2}
+	return fileDescriptor_3aeef8c45497084a, []int{8}
 }
 
 func (m *TransferEthParams) XXX_Unmarshal(b []byte) error {
@@ -164,6 +465,7 @@ func (m *TransferEthParams) GetTo() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *TransferEthParams) GetValue() int64 {
 	if m != nil {
 		return m.Value
@@ -171,6 +473,20 @@ func (m *TransferEthParams) GetValue() int64 {
 	return 0
 }
 
+func (m *TransferEthParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TransferEthParams) GetValueDecimal() string {
+	if m != nil {
+		return m.ValueDecimal
+	}
+	return ""
+}
+
 type EthBalanceParams struct {
 	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -182,7 +498,7 @@ func (m *EthBalanceParams) Reset()         { *m = EthBalanceParams{} }
 func (m *EthBalanceParams) String() string { return proto.CompactTextString(m) }
 func (*EthBalanceParams) ProtoMessage()    {}
 func (*EthBalanceParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{3}
+	return fileDescriptor_3aeef8c45497084a, []int{9}
 }
 
 func (m *EthBalanceParams) XXX_Unmarshal(b []byte) error {
@@ -212,7 +528,8 @@ func (m *EthBalanceParams) GetOwner() string {
 
 type EthBalanceResult struct {
 	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	Balance              int64    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Balance              int64    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"` // Deprecated: Do not use.
+	BalanceDecimal       string   `protobuf:"bytes,3,opt,name=balanceDecimal,proto3" json:"balanceDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -222,7 +539,7 @@ func (m *EthBalanceResult) Reset()         { *m = EthBalanceResult{} }
 func (m *EthBalanceResult) String() string { return proto.CompactTextString(m) }
 func (*EthBalanceResult) ProtoMessage()    {}
 func (*EthBalanceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{4}
+	return fileDescriptor_3aeef8c45497084a, []int{10}
 }
 
 func (m *EthBalanceResult) XXX_Unmarshal(b []byte) error {
@@ -250,6 +567,7 @@ func (m *EthBalanceResult) GetResult() *Result {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *EthBalanceResult) GetBalance() int64 {
 	if m != nil {
 		return m.Balance
@@ -257,19 +575,28 @@ func (m *EthBalanceResult) GetBalance() int64 {
 	return 0
 }
 
+func (m *EthBalanceResult) GetBalanceDecimal() string {
+	if m != nil {
+		return m.BalanceDecimal
+	}
+	return ""
+}
+
 type ClientInfo struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address              string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string       `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	TypedEvents          bool         `protobuf:"varint,3,opt,name=typedEvents,proto3" json:"typedEvents,omitempty"`
+	Cursor               *EventCursor `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *ClientInfo) Reset()         { *m = ClientInfo{} }
 func (m *ClientInfo) String() string { return proto.CompactTextString(m) }
 func (*ClientInfo) ProtoMessage()    {}
 func (*ClientInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{5}
+	return fileDescriptor_3aeef8c45497084a, []int{11}
 }
 
 func (m *ClientInfo) XXX_Unmarshal(b []byte) error {
@@ -304,140 +631,1992 @@ func (m *ClientInfo) GetPassword() string {
 	return ""
 }
 
-type Event struct {
-	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
-	JsonData             string   `protobuf:"bytes,2,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+func (m *ClientInfo) GetTypedEvents() bool {
+	if m != nil {
+		return m.TypedEvents
+	}
+	return false
+}
+
+func (m *ClientInfo) GetCursor() *EventCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+// position of an event on chain
+type EventCursor struct {
+	BlockNumber          uint64   `protobuf:"varint,1,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	LogIndex             uint32   `protobuf:"varint,2,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{6}
+func (m *EventCursor) Reset()         { *m = EventCursor{} }
+func (m *EventCursor) String() string { return proto.CompactTextString(m) }
+func (*EventCursor) ProtoMessage()    {}
+func (*EventCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{12}
 }
 
-func (m *Event) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Event.Unmarshal(m, b)
+func (m *EventCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCursor.Unmarshal(m, b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+func (m *EventCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCursor.Marshal(b, m, deterministic)
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *EventCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCursor.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
-	return xxx_messageInfo_Event.Size(m)
+func (m *EventCursor) XXX_Size() int {
+	return xxx_messageInfo_EventCursor.Size(m)
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
+func (m *EventCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCursor.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event proto.InternalMessageInfo
+var xxx_messageInfo_EventCursor proto.InternalMessageInfo
 
-func (m *Event) GetTime() int64 {
+func (m *EventCursor) GetBlockNumber() uint64 {
 	if m != nil {
-		return m.Time
+		return m.BlockNumber
 	}
 	return 0
 }
 
-func (m *Event) GetJsonData() string {
+func (m *EventCursor) GetLogIndex() uint32 {
 	if m != nil {
-		return m.JsonData
+		return m.LogIndex
 	}
-	return ""
+	return 0
 }
 
-type TxParams struct {
-	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
-	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
-	GasPrice             int64    `protobuf:"varint,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
-	GasLimit             uint64   `protobuf:"varint,6,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AckEventsParams struct {
+	Address              string       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Cursor               *EventCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Token                string       `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *TxParams) Reset()         { *m = TxParams{} }
-func (m *TxParams) String() string { return proto.CompactTextString(m) }
-func (*TxParams) ProtoMessage()    {}
-func (*TxParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{7}
+func (m *AckEventsParams) Reset()         { *m = AckEventsParams{} }
+func (m *AckEventsParams) String() string { return proto.CompactTextString(m) }
+func (*AckEventsParams) ProtoMessage()    {}
+func (*AckEventsParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{13}
 }
 
-func (m *TxParams) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TxParams.Unmarshal(m, b)
+func (m *AckEventsParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AckEventsParams.Unmarshal(m, b)
 }
-func (m *TxParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TxParams.Marshal(b, m, deterministic)
+func (m *AckEventsParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AckEventsParams.Marshal(b, m, deterministic)
 }
-func (m *TxParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TxParams.Merge(m, src)
+func (m *AckEventsParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AckEventsParams.Merge(m, src)
 }
-func (m *TxParams) XXX_Size() int {
-	return xxx_messageInfo_TxParams.Size(m)
+func (m *AckEventsParams) XXX_Size() int {
+	return xxx_messageInfo_AckEventsParams.Size(m)
 }
-func (m *TxParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_TxParams.DiscardUnknown(m)
+func (m *AckEventsParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_AckEventsParams.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TxParams proto.InternalMessageInfo
+var xxx_messageInfo_AckEventsParams proto.InternalMessageInfo
 
-func (m *TxParams) GetFrom() string {
+func (m *AckEventsParams) GetAddress() string {
 	if m != nil {
-		return m.From
+		return m.Address
 	}
 	return ""
 }
 
-func (m *TxParams) GetPassword() string {
+func (m *AckEventsParams) GetCursor() *EventCursor {
 	if m != nil {
-		return m.Password
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *AckEventsParams) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
 	return ""
 }
 
-func (m *TxParams) GetValue() int64 {
+type Event struct {
+	Time            int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	JsonData        string   `protobuf:"bytes,2,opt,name=jsonData,proto3" json:"jsonData,omitempty"`
+	Name            string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	BlockNumber     uint64   `protobuf:"varint,4,opt,name=blockNumber,proto3" json:"blockNumber,omitempty"`
+	TxHash          string   `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	LogIndex        uint32   `protobuf:"varint,6,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	ContractAddress string   `protobuf:"bytes,7,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Users           []string `protobuf:"bytes,8,rep,name=users,proto3" json:"users,omitempty"`
+	// Types that are valid to be assigned to Payload:
+	//	*Event_ChannelCreated
+	//	*Event_DataPublish
+	//	*Event_TransactionCreate
+	//	*Event_RegisterVerifier
+	//	*Event_VerifiersChosen
+	//	*Event_Vote
+	//	*Event_Buy
+	//	*Event_ReadyForDownload
+	//	*Event_TransactionClose
+	//	*Event_VerifierDisable
+	//	*Event_ArbitrationBegin
+	//	*Event_ArbitrationResult
+	//	*Event_Approval
+	Payload              isEvent_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{14}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetTime() int64 {
 	if m != nil {
-		return m.Value
+		return m.Time
 	}
 	return 0
 }
 
-func (m *TxParams) GetPending() bool {
+func (m *Event) GetJsonData() string {
 	if m != nil {
-		return m.Pending
+		return m.JsonData
+	}
+	return ""
+}
+
+func (m *Event) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Event) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *Event) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *Event) GetLogIndex() uint32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *Event) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Event) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type isEvent_Payload interface {
+	isEvent_Payload()
+}
+
+type Event_ChannelCreated struct {
+	ChannelCreated *ChannelCreatedEvent `protobuf:"bytes,10,opt,name=channelCreated,proto3,oneof"`
+}
+
+type Event_DataPublish struct {
+	DataPublish *DataPublishEvent `protobuf:"bytes,11,opt,name=dataPublish,proto3,oneof"`
+}
+
+type Event_TransactionCreate struct {
+	TransactionCreate *TransactionCreateEvent `protobuf:"bytes,12,opt,name=transactionCreate,proto3,oneof"`
+}
+
+type Event_RegisterVerifier struct {
+	RegisterVerifier *RegisterVerifierEvent `protobuf:"bytes,13,opt,name=registerVerifier,proto3,oneof"`
+}
+
+type Event_VerifiersChosen struct {
+	VerifiersChosen *VerifiersChosenEvent `protobuf:"bytes,14,opt,name=verifiersChosen,proto3,oneof"`
+}
+
+type Event_Vote struct {
+	Vote *VoteEvent `protobuf:"bytes,15,opt,name=vote,proto3,oneof"`
+}
+
+type Event_Buy struct {
+	Buy *BuyEvent `protobuf:"bytes,16,opt,name=buy,proto3,oneof"`
+}
+
+type Event_ReadyForDownload struct {
+	ReadyForDownload *ReadyForDownloadEvent `protobuf:"bytes,17,opt,name=readyForDownload,proto3,oneof"`
+}
+
+type Event_TransactionClose struct {
+	TransactionClose *TransactionCloseEvent `protobuf:"bytes,18,opt,name=transactionClose,proto3,oneof"`
+}
+
+type Event_VerifierDisable struct {
+	VerifierDisable *VerifierDisableEvent `protobuf:"bytes,19,opt,name=verifierDisable,proto3,oneof"`
+}
+
+type Event_ArbitrationBegin struct {
+	ArbitrationBegin *ArbitrationBeginEvent `protobuf:"bytes,20,opt,name=arbitrationBegin,proto3,oneof"`
+}
+
+type Event_ArbitrationResult struct {
+	ArbitrationResult *ArbitrationResultEvent `protobuf:"bytes,21,opt,name=arbitrationResult,proto3,oneof"`
+}
+
+type Event_Approval struct {
+	Approval *ApprovalEvent `protobuf:"bytes,22,opt,name=approval,proto3,oneof"`
+}
+
+func (*Event_ChannelCreated) isEvent_Payload() {}
+
+func (*Event_DataPublish) isEvent_Payload() {}
+
+func (*Event_TransactionCreate) isEvent_Payload() {}
+
+func (*Event_RegisterVerifier) isEvent_Payload() {}
+
+func (*Event_VerifiersChosen) isEvent_Payload() {}
+
+func (*Event_Vote) isEvent_Payload() {}
+
+func (*Event_Buy) isEvent_Payload() {}
+
+func (*Event_ReadyForDownload) isEvent_Payload() {}
+
+func (*Event_TransactionClose) isEvent_Payload() {}
+
+func (*Event_VerifierDisable) isEvent_Payload() {}
+
+func (*Event_ArbitrationBegin) isEvent_Payload() {}
+
+func (*Event_ArbitrationResult) isEvent_Payload() {}
+
+func (*Event_Approval) isEvent_Payload() {}
+
+func (m *Event) GetPayload() isEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *Event) GetChannelCreated() *ChannelCreatedEvent {
+	if x, ok := m.GetPayload().(*Event_ChannelCreated); ok {
+		return x.ChannelCreated
+	}
+	return nil
+}
+
+func (m *Event) GetDataPublish() *DataPublishEvent {
+	if x, ok := m.GetPayload().(*Event_DataPublish); ok {
+		return x.DataPublish
+	}
+	return nil
+}
+
+func (m *Event) GetTransactionCreate() *TransactionCreateEvent {
+	if x, ok := m.GetPayload().(*Event_TransactionCreate); ok {
+		return x.TransactionCreate
+	}
+	return nil
+}
+
+func (m *Event) GetRegisterVerifier() *RegisterVerifierEvent {
+	if x, ok := m.GetPayload().(*Event_RegisterVerifier); ok {
+		return x.RegisterVerifier
+	}
+	return nil
+}
+
+func (m *Event) GetVerifiersChosen() *VerifiersChosenEvent {
+	if x, ok := m.GetPayload().(*Event_VerifiersChosen); ok {
+		return x.VerifiersChosen
+	}
+	return nil
+}
+
+func (m *Event) GetVote() *VoteEvent {
+	if x, ok := m.GetPayload().(*Event_Vote); ok {
+		return x.Vote
+	}
+	return nil
+}
+
+func (m *Event) GetBuy() *BuyEvent {
+	if x, ok := m.GetPayload().(*Event_Buy); ok {
+		return x.Buy
+	}
+	return nil
+}
+
+func (m *Event) GetReadyForDownload() *ReadyForDownloadEvent {
+	if x, ok := m.GetPayload().(*Event_ReadyForDownload); ok {
+		return x.ReadyForDownload
+	}
+	return nil
+}
+
+func (m *Event) GetTransactionClose() *TransactionCloseEvent {
+	if x, ok := m.GetPayload().(*Event_TransactionClose); ok {
+		return x.TransactionClose
+	}
+	return nil
+}
+
+func (m *Event) GetVerifierDisable() *VerifierDisableEvent {
+	if x, ok := m.GetPayload().(*Event_VerifierDisable); ok {
+		return x.VerifierDisable
+	}
+	return nil
+}
+
+func (m *Event) GetArbitrationBegin() *ArbitrationBeginEvent {
+	if x, ok := m.GetPayload().(*Event_ArbitrationBegin); ok {
+		return x.ArbitrationBegin
+	}
+	return nil
+}
+
+func (m *Event) GetArbitrationResult() *ArbitrationResultEvent {
+	if x, ok := m.GetPayload().(*Event_ArbitrationResult); ok {
+		return x.ArbitrationResult
+	}
+	return nil
+}
+
+func (m *Event) GetApproval() *ApprovalEvent {
+	if x, ok := m.GetPayload().(*Event_Approval); ok {
+		return x.Approval
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Event) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Event_ChannelCreated)(nil),
+		(*Event_DataPublish)(nil),
+		(*Event_TransactionCreate)(nil),
+		(*Event_RegisterVerifier)(nil),
+		(*Event_VerifiersChosen)(nil),
+		(*Event_Vote)(nil),
+		(*Event_Buy)(nil),
+		(*Event_ReadyForDownload)(nil),
+		(*Event_TransactionClose)(nil),
+		(*Event_VerifierDisable)(nil),
+		(*Event_ArbitrationBegin)(nil),
+		(*Event_ArbitrationResult)(nil),
+		(*Event_Approval)(nil),
+	}
+}
+
+type ChannelCreatedEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelCreatedEvent) Reset()         { *m = ChannelCreatedEvent{} }
+func (m *ChannelCreatedEvent) String() string { return proto.CompactTextString(m) }
+func (*ChannelCreatedEvent) ProtoMessage()    {}
+func (*ChannelCreatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{15}
+}
+
+func (m *ChannelCreatedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCreatedEvent.Unmarshal(m, b)
+}
+func (m *ChannelCreatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCreatedEvent.Marshal(b, m, deterministic)
+}
+func (m *ChannelCreatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCreatedEvent.Merge(m, src)
+}
+func (m *ChannelCreatedEvent) XXX_Size() int {
+	return xxx_messageInfo_ChannelCreatedEvent.Size(m)
+}
+func (m *ChannelCreatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCreatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCreatedEvent proto.InternalMessageInfo
+
+type DataPublishEvent struct {
+	PublishId            string   `protobuf:"bytes,1,opt,name=publishId,proto3" json:"publishId,omitempty"`
+	Price                string   `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	DespDataId           string   `protobuf:"bytes,3,opt,name=despDataId,proto3" json:"despDataId,omitempty"`
+	SupportVerify        bool     `protobuf:"varint,4,opt,name=supportVerify,proto3" json:"supportVerify,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DataPublishEvent) Reset()         { *m = DataPublishEvent{} }
+func (m *DataPublishEvent) String() string { return proto.CompactTextString(m) }
+func (*DataPublishEvent) ProtoMessage()    {}
+func (*DataPublishEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{16}
+}
+
+func (m *DataPublishEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DataPublishEvent.Unmarshal(m, b)
+}
+func (m *DataPublishEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DataPublishEvent.Marshal(b, m, deterministic)
+}
+func (m *DataPublishEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataPublishEvent.Merge(m, src)
+}
+func (m *DataPublishEvent) XXX_Size() int {
+	return xxx_messageInfo_DataPublishEvent.Size(m)
+}
+func (m *DataPublishEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataPublishEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataPublishEvent proto.InternalMessageInfo
+
+func (m *DataPublishEvent) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *DataPublishEvent) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *DataPublishEvent) GetDespDataId() string {
+	if m != nil {
+		return m.DespDataId
+	}
+	return ""
+}
+
+func (m *DataPublishEvent) GetSupportVerify() bool {
+	if m != nil {
+		return m.SupportVerify
 	}
 	return false
 }
 
-func (m *TxParams) GetGasPrice() int64 {
+type TransactionCreateEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	PublishId            string   `protobuf:"bytes,2,opt,name=publishId,proto3" json:"publishId,omitempty"`
+	ProofIds             []string `protobuf:"bytes,3,rep,name=proofIds,proto3" json:"proofIds,omitempty"`
+	NeedVerify           bool     `protobuf:"varint,4,opt,name=needVerify,proto3" json:"needVerify,omitempty"`
+	State                uint32   `protobuf:"varint,5,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionCreateEvent) Reset()         { *m = TransactionCreateEvent{} }
+func (m *TransactionCreateEvent) String() string { return proto.CompactTextString(m) }
+func (*TransactionCreateEvent) ProtoMessage()    {}
+func (*TransactionCreateEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{17}
+}
+
+func (m *TransactionCreateEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionCreateEvent.Unmarshal(m, b)
+}
+func (m *TransactionCreateEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionCreateEvent.Marshal(b, m, deterministic)
+}
+func (m *TransactionCreateEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionCreateEvent.Merge(m, src)
+}
+func (m *TransactionCreateEvent) XXX_Size() int {
+	return xxx_messageInfo_TransactionCreateEvent.Size(m)
+}
+func (m *TransactionCreateEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionCreateEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionCreateEvent proto.InternalMessageInfo
+
+func (m *TransactionCreateEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *TransactionCreateEvent) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *TransactionCreateEvent) GetProofIds() []string {
+	if m != nil {
+		return m.ProofIds
+	}
+	return nil
+}
+
+func (m *TransactionCreateEvent) GetNeedVerify() bool {
+	if m != nil {
+		return m.NeedVerify
+	}
+	return false
+}
+
+func (m *TransactionCreateEvent) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+type RegisterVerifierEvent struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterVerifierEvent) Reset()         { *m = RegisterVerifierEvent{} }
+func (m *RegisterVerifierEvent) String() string { return proto.CompactTextString(m) }
+func (*RegisterVerifierEvent) ProtoMessage()    {}
+func (*RegisterVerifierEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{18}
+}
+
+func (m *RegisterVerifierEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterVerifierEvent.Unmarshal(m, b)
+}
+func (m *RegisterVerifierEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterVerifierEvent.Marshal(b, m, deterministic)
+}
+func (m *RegisterVerifierEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterVerifierEvent.Merge(m, src)
+}
+func (m *RegisterVerifierEvent) XXX_Size() int {
+	return xxx_messageInfo_RegisterVerifierEvent.Size(m)
+}
+func (m *RegisterVerifierEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterVerifierEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterVerifierEvent proto.InternalMessageInfo
+
+type VerifiersChosenEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	PublishId            string   `protobuf:"bytes,2,opt,name=publishId,proto3" json:"publishId,omitempty"`
+	ProofIds             []string `protobuf:"bytes,3,rep,name=proofIds,proto3" json:"proofIds,omitempty"`
+	State                uint32   `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifiersChosenEvent) Reset()         { *m = VerifiersChosenEvent{} }
+func (m *VerifiersChosenEvent) String() string { return proto.CompactTextString(m) }
+func (*VerifiersChosenEvent) ProtoMessage()    {}
+func (*VerifiersChosenEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{19}
+}
+
+func (m *VerifiersChosenEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifiersChosenEvent.Unmarshal(m, b)
+}
+func (m *VerifiersChosenEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifiersChosenEvent.Marshal(b, m, deterministic)
+}
+func (m *VerifiersChosenEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifiersChosenEvent.Merge(m, src)
+}
+func (m *VerifiersChosenEvent) XXX_Size() int {
+	return xxx_messageInfo_VerifiersChosenEvent.Size(m)
+}
+func (m *VerifiersChosenEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifiersChosenEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifiersChosenEvent proto.InternalMessageInfo
+
+func (m *VerifiersChosenEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *VerifiersChosenEvent) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *VerifiersChosenEvent) GetProofIds() []string {
+	if m != nil {
+		return m.ProofIds
+	}
+	return nil
+}
+
+func (m *VerifiersChosenEvent) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+type VoteEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	Judge                bool     `protobuf:"varint,2,opt,name=judge,proto3" json:"judge,omitempty"`
+	Comments             string   `protobuf:"bytes,3,opt,name=comments,proto3" json:"comments,omitempty"`
+	State                uint32   `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	Index                uint32   `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteEvent) Reset()         { *m = VoteEvent{} }
+func (m *VoteEvent) String() string { return proto.CompactTextString(m) }
+func (*VoteEvent) ProtoMessage()    {}
+func (*VoteEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{20}
+}
+
+func (m *VoteEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteEvent.Unmarshal(m, b)
+}
+func (m *VoteEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteEvent.Marshal(b, m, deterministic)
+}
+func (m *VoteEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteEvent.Merge(m, src)
+}
+func (m *VoteEvent) XXX_Size() int {
+	return xxx_messageInfo_VoteEvent.Size(m)
+}
+func (m *VoteEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteEvent proto.InternalMessageInfo
+
+func (m *VoteEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *VoteEvent) GetJudge() bool {
+	if m != nil {
+		return m.Judge
+	}
+	return false
+}
+
+func (m *VoteEvent) GetComments() string {
+	if m != nil {
+		return m.Comments
+	}
+	return ""
+}
+
+func (m *VoteEvent) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *VoteEvent) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type BuyEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	PublishId            string   `protobuf:"bytes,2,opt,name=publishId,proto3" json:"publishId,omitempty"`
+	MetaDataIdEncSeller  []byte   `protobuf:"bytes,3,opt,name=metaDataIdEncSeller,proto3" json:"metaDataIdEncSeller,omitempty"`
+	State                uint32   `protobuf:"varint,4,opt,name=state,proto3" json:"state,omitempty"`
+	Index                uint32   `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuyEvent) Reset()         { *m = BuyEvent{} }
+func (m *BuyEvent) String() string { return proto.CompactTextString(m) }
+func (*BuyEvent) ProtoMessage()    {}
+func (*BuyEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{21}
+}
+
+func (m *BuyEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuyEvent.Unmarshal(m, b)
+}
+func (m *BuyEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuyEvent.Marshal(b, m, deterministic)
+}
+func (m *BuyEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyEvent.Merge(m, src)
+}
+func (m *BuyEvent) XXX_Size() int {
+	return xxx_messageInfo_BuyEvent.Size(m)
+}
+func (m *BuyEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyEvent proto.InternalMessageInfo
+
+func (m *BuyEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *BuyEvent) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *BuyEvent) GetMetaDataIdEncSeller() []byte {
+	if m != nil {
+		return m.MetaDataIdEncSeller
+	}
+	return nil
+}
+
+func (m *BuyEvent) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *BuyEvent) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReadyForDownloadEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	MetaDataIdEncBuyer   []byte   `protobuf:"bytes,2,opt,name=metaDataIdEncBuyer,proto3" json:"metaDataIdEncBuyer,omitempty"`
+	State                uint32   `protobuf:"varint,3,opt,name=state,proto3" json:"state,omitempty"`
+	Index                uint32   `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadyForDownloadEvent) Reset()         { *m = ReadyForDownloadEvent{} }
+func (m *ReadyForDownloadEvent) String() string { return proto.CompactTextString(m) }
+func (*ReadyForDownloadEvent) ProtoMessage()    {}
+func (*ReadyForDownloadEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{22}
+}
+
+func (m *ReadyForDownloadEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadyForDownloadEvent.Unmarshal(m, b)
+}
+func (m *ReadyForDownloadEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadyForDownloadEvent.Marshal(b, m, deterministic)
+}
+func (m *ReadyForDownloadEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadyForDownloadEvent.Merge(m, src)
+}
+func (m *ReadyForDownloadEvent) XXX_Size() int {
+	return xxx_messageInfo_ReadyForDownloadEvent.Size(m)
+}
+func (m *ReadyForDownloadEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadyForDownloadEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadyForDownloadEvent proto.InternalMessageInfo
+
+func (m *ReadyForDownloadEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *ReadyForDownloadEvent) GetMetaDataIdEncBuyer() []byte {
+	if m != nil {
+		return m.MetaDataIdEncBuyer
+	}
+	return nil
+}
+
+func (m *ReadyForDownloadEvent) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *ReadyForDownloadEvent) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type TransactionCloseEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	State                uint32   `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransactionCloseEvent) Reset()         { *m = TransactionCloseEvent{} }
+func (m *TransactionCloseEvent) String() string { return proto.CompactTextString(m) }
+func (*TransactionCloseEvent) ProtoMessage()    {}
+func (*TransactionCloseEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{23}
+}
+
+func (m *TransactionCloseEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransactionCloseEvent.Unmarshal(m, b)
+}
+func (m *TransactionCloseEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransactionCloseEvent.Marshal(b, m, deterministic)
+}
+func (m *TransactionCloseEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionCloseEvent.Merge(m, src)
+}
+func (m *TransactionCloseEvent) XXX_Size() int {
+	return xxx_messageInfo_TransactionCloseEvent.Size(m)
+}
+func (m *TransactionCloseEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionCloseEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionCloseEvent proto.InternalMessageInfo
+
+func (m *TransactionCloseEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *TransactionCloseEvent) GetState() uint32 {
+	if m != nil {
+		return m.State
+	}
+	return 0
+}
+
+func (m *TransactionCloseEvent) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type VerifierDisableEvent struct {
+	Verifier             string   `protobuf:"bytes,1,opt,name=verifier,proto3" json:"verifier,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifierDisableEvent) Reset()         { *m = VerifierDisableEvent{} }
+func (m *VerifierDisableEvent) String() string { return proto.CompactTextString(m) }
+func (*VerifierDisableEvent) ProtoMessage()    {}
+func (*VerifierDisableEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{24}
+}
+
+func (m *VerifierDisableEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifierDisableEvent.Unmarshal(m, b)
+}
+func (m *VerifierDisableEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifierDisableEvent.Marshal(b, m, deterministic)
+}
+func (m *VerifierDisableEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifierDisableEvent.Merge(m, src)
+}
+func (m *VerifierDisableEvent) XXX_Size() int {
+	return xxx_messageInfo_VerifierDisableEvent.Size(m)
+}
+func (m *VerifierDisableEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifierDisableEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifierDisableEvent proto.InternalMessageInfo
+
+func (m *VerifierDisableEvent) GetVerifier() string {
+	if m != nil {
+		return m.Verifier
+	}
+	return ""
+}
+
+type ArbitrationBeginEvent struct {
+	TransactionId           uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	PublishId               string   `protobuf:"bytes,2,opt,name=publishId,proto3" json:"publishId,omitempty"`
+	ProofIds                []string `protobuf:"bytes,3,rep,name=proofIds,proto3" json:"proofIds,omitempty"`
+	MetaDataIdEncArbitrator []byte   `protobuf:"bytes,4,opt,name=metaDataIdEncArbitrator,proto3" json:"metaDataIdEncArbitrator,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *ArbitrationBeginEvent) Reset()         { *m = ArbitrationBeginEvent{} }
+func (m *ArbitrationBeginEvent) String() string { return proto.CompactTextString(m) }
+func (*ArbitrationBeginEvent) ProtoMessage()    {}
+func (*ArbitrationBeginEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{25}
+}
+
+func (m *ArbitrationBeginEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrationBeginEvent.Unmarshal(m, b)
+}
+func (m *ArbitrationBeginEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrationBeginEvent.Marshal(b, m, deterministic)
+}
+func (m *ArbitrationBeginEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrationBeginEvent.Merge(m, src)
+}
+func (m *ArbitrationBeginEvent) XXX_Size() int {
+	return xxx_messageInfo_ArbitrationBeginEvent.Size(m)
+}
+func (m *ArbitrationBeginEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrationBeginEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbitrationBeginEvent proto.InternalMessageInfo
+
+func (m *ArbitrationBeginEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *ArbitrationBeginEvent) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *ArbitrationBeginEvent) GetProofIds() []string {
+	if m != nil {
+		return m.ProofIds
+	}
+	return nil
+}
+
+func (m *ArbitrationBeginEvent) GetMetaDataIdEncArbitrator() []byte {
+	if m != nil {
+		return m.MetaDataIdEncArbitrator
+	}
+	return nil
+}
+
+type ArbitrationResultEvent struct {
+	TransactionId        uint64   `protobuf:"varint,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	Judge                bool     `protobuf:"varint,2,opt,name=judge,proto3" json:"judge,omitempty"`
+	Identify             uint32   `protobuf:"varint,3,opt,name=identify,proto3" json:"identify,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArbitrationResultEvent) Reset()         { *m = ArbitrationResultEvent{} }
+func (m *ArbitrationResultEvent) String() string { return proto.CompactTextString(m) }
+func (*ArbitrationResultEvent) ProtoMessage()    {}
+func (*ArbitrationResultEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{26}
+}
+
+func (m *ArbitrationResultEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrationResultEvent.Unmarshal(m, b)
+}
+func (m *ArbitrationResultEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrationResultEvent.Marshal(b, m, deterministic)
+}
+func (m *ArbitrationResultEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrationResultEvent.Merge(m, src)
+}
+func (m *ArbitrationResultEvent) XXX_Size() int {
+	return xxx_messageInfo_ArbitrationResultEvent.Size(m)
+}
+func (m *ArbitrationResultEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrationResultEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbitrationResultEvent proto.InternalMessageInfo
+
+func (m *ArbitrationResultEvent) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *ArbitrationResultEvent) GetJudge() bool {
+	if m != nil {
+		return m.Judge
+	}
+	return false
+}
+
+func (m *ArbitrationResultEvent) GetIdentify() uint32 {
+	if m != nil {
+		return m.Identify
+	}
+	return 0
+}
+
+type ApprovalEvent struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Spender              string   `protobuf:"bytes,2,opt,name=spender,proto3" json:"spender,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApprovalEvent) Reset()         { *m = ApprovalEvent{} }
+func (m *ApprovalEvent) String() string { return proto.CompactTextString(m) }
+func (*ApprovalEvent) ProtoMessage()    {}
+func (*ApprovalEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{27}
+}
+
+func (m *ApprovalEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApprovalEvent.Unmarshal(m, b)
+}
+func (m *ApprovalEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApprovalEvent.Marshal(b, m, deterministic)
+}
+func (m *ApprovalEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApprovalEvent.Merge(m, src)
+}
+func (m *ApprovalEvent) XXX_Size() int {
+	return xxx_messageInfo_ApprovalEvent.Size(m)
+}
+func (m *ApprovalEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApprovalEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApprovalEvent proto.InternalMessageInfo
+
+func (m *ApprovalEvent) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ApprovalEvent) GetSpender() string {
+	if m != nil {
+		return m.Spender
+	}
+	return ""
+}
+
+func (m *ApprovalEvent) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type TxParams struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	GasPrice             int64    `protobuf:"varint,5,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"` // Deprecated: Do not use.
+	GasLimit             uint64   `protobuf:"varint,6,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	Token                string   `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	ValueDecimal         string   `protobuf:"bytes,8,opt,name=valueDecimal,proto3" json:"valueDecimal,omitempty"`
+	GasPriceDecimal      string   `protobuf:"bytes,9,opt,name=gasPriceDecimal,proto3" json:"gasPriceDecimal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxParams) Reset()         { *m = TxParams{} }
+func (m *TxParams) String() string { return proto.CompactTextString(m) }
+func (*TxParams) ProtoMessage()    {}
+func (*TxParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{28}
+}
+
+func (m *TxParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxParams.Unmarshal(m, b)
+}
+func (m *TxParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxParams.Marshal(b, m, deterministic)
+}
+func (m *TxParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxParams.Merge(m, src)
+}
+func (m *TxParams) XXX_Size() int {
+	return xxx_messageInfo_TxParams.Size(m)
+}
+func (m *TxParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxParams proto.InternalMessageInfo
+
+func (m *TxParams) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TxParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (m *TxParams) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *TxParams) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+// Deprecated: Do not use.
+func (m *TxParams) GetGasPrice() int64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *TxParams) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *TxParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TxParams) GetValueDecimal() string {
+	if m != nil {
+		return m.ValueDecimal
+	}
+	return ""
+}
+
+func (m *TxParams) GetGasPriceDecimal() string {
+	if m != nil {
+		return m.GasPriceDecimal
+	}
+	return ""
+}
+
+type UnlockParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Operations           []string `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Ttl                  int64    `protobuf:"varint,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockParams) Reset()         { *m = UnlockParams{} }
+func (m *UnlockParams) String() string { return proto.CompactTextString(m) }
+func (*UnlockParams) ProtoMessage()    {}
+func (*UnlockParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{29}
+}
+
+func (m *UnlockParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockParams.Unmarshal(m, b)
+}
+func (m *UnlockParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockParams.Marshal(b, m, deterministic)
+}
+func (m *UnlockParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockParams.Merge(m, src)
+}
+func (m *UnlockParams) XXX_Size() int {
+	return xxx_messageInfo_UnlockParams.Size(m)
+}
+func (m *UnlockParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockParams proto.InternalMessageInfo
+
+func (m *UnlockParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnlockParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *UnlockParams) GetOperations() []string {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *UnlockParams) GetTtl() int64 {
+	if m != nil {
+		return m.Ttl
+	}
+	return 0
+}
+
+type UnlockResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Expires              int64    `protobuf:"varint,3,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockResult) Reset()         { *m = UnlockResult{} }
+func (m *UnlockResult) String() string { return proto.CompactTextString(m) }
+func (*UnlockResult) ProtoMessage()    {}
+func (*UnlockResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{30}
+}
+
+func (m *UnlockResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockResult.Unmarshal(m, b)
+}
+func (m *UnlockResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockResult.Marshal(b, m, deterministic)
+}
+func (m *UnlockResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockResult.Merge(m, src)
+}
+func (m *UnlockResult) XXX_Size() int {
+	return xxx_messageInfo_UnlockResult.Size(m)
+}
+func (m *UnlockResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockResult proto.InternalMessageInfo
+
+func (m *UnlockResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *UnlockResult) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *UnlockResult) GetExpires() int64 {
+	if m != nil {
+		return m.Expires
+	}
+	return 0
+}
+
+type LockParams struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LockParams) Reset()         { *m = LockParams{} }
+func (m *LockParams) String() string { return proto.CompactTextString(m) }
+func (*LockParams) ProtoMessage()    {}
+func (*LockParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{31}
+}
+
+func (m *LockParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockParams.Unmarshal(m, b)
+}
+func (m *LockParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockParams.Marshal(b, m, deterministic)
+}
+func (m *LockParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockParams.Merge(m, src)
+}
+func (m *LockParams) XXX_Size() int {
+	return xxx_messageInfo_LockParams.Size(m)
+}
+func (m *LockParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockParams proto.InternalMessageInfo
+
+func (m *LockParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type SignMessageParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Message              []byte   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignMessageParams) Reset()         { *m = SignMessageParams{} }
+func (m *SignMessageParams) String() string { return proto.CompactTextString(m) }
+func (*SignMessageParams) ProtoMessage()    {}
+func (*SignMessageParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{32}
+}
+
+func (m *SignMessageParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignMessageParams.Unmarshal(m, b)
+}
+func (m *SignMessageParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignMessageParams.Marshal(b, m, deterministic)
+}
+func (m *SignMessageParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignMessageParams.Merge(m, src)
+}
+func (m *SignMessageParams) XXX_Size() int {
+	return xxx_messageInfo_SignMessageParams.Size(m)
+}
+func (m *SignMessageParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignMessageParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignMessageParams proto.InternalMessageInfo
+
+func (m *SignMessageParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignMessageParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *SignMessageParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *SignMessageParams) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+type SignTypedDataParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	TypedData            string   `protobuf:"bytes,4,opt,name=typedData,proto3" json:"typedData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignTypedDataParams) Reset()         { *m = SignTypedDataParams{} }
+func (m *SignTypedDataParams) String() string { return proto.CompactTextString(m) }
+func (*SignTypedDataParams) ProtoMessage()    {}
+func (*SignTypedDataParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{33}
+}
+
+func (m *SignTypedDataParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignTypedDataParams.Unmarshal(m, b)
+}
+func (m *SignTypedDataParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignTypedDataParams.Marshal(b, m, deterministic)
+}
+func (m *SignTypedDataParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignTypedDataParams.Merge(m, src)
+}
+func (m *SignTypedDataParams) XXX_Size() int {
+	return xxx_messageInfo_SignTypedDataParams.Size(m)
+}
+func (m *SignTypedDataParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignTypedDataParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignTypedDataParams proto.InternalMessageInfo
+
+func (m *SignTypedDataParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SignTypedDataParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *SignTypedDataParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *SignTypedDataParams) GetTypedData() string {
+	if m != nil {
+		return m.TypedData
+	}
+	return ""
+}
+
+type SignatureResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignatureResult) Reset()         { *m = SignatureResult{} }
+func (m *SignatureResult) String() string { return proto.CompactTextString(m) }
+func (*SignatureResult) ProtoMessage()    {}
+func (*SignatureResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{34}
+}
+
+func (m *SignatureResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignatureResult.Unmarshal(m, b)
+}
+func (m *SignatureResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignatureResult.Marshal(b, m, deterministic)
+}
+func (m *SignatureResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignatureResult.Merge(m, src)
+}
+func (m *SignatureResult) XXX_Size() int {
+	return xxx_messageInfo_SignatureResult.Size(m)
+}
+func (m *SignatureResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignatureResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignatureResult proto.InternalMessageInfo
+
+func (m *SignatureResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *SignatureResult) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type DecryptParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	CipherText           []byte   `protobuf:"bytes,4,opt,name=cipherText,proto3" json:"cipherText,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecryptParams) Reset()         { *m = DecryptParams{} }
+func (m *DecryptParams) String() string { return proto.CompactTextString(m) }
+func (*DecryptParams) ProtoMessage()    {}
+func (*DecryptParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{35}
+}
+
+func (m *DecryptParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecryptParams.Unmarshal(m, b)
+}
+func (m *DecryptParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecryptParams.Marshal(b, m, deterministic)
+}
+func (m *DecryptParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptParams.Merge(m, src)
+}
+func (m *DecryptParams) XXX_Size() int {
+	return xxx_messageInfo_DecryptParams.Size(m)
+}
+func (m *DecryptParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecryptParams proto.InternalMessageInfo
+
+func (m *DecryptParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DecryptParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *DecryptParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *DecryptParams) GetCipherText() []byte {
+	if m != nil {
+		return m.CipherText
+	}
+	return nil
+}
+
+type DecryptResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	PlainText            []byte   `protobuf:"bytes,2,opt,name=plainText,proto3" json:"plainText,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DecryptResult) Reset()         { *m = DecryptResult{} }
+func (m *DecryptResult) String() string { return proto.CompactTextString(m) }
+func (*DecryptResult) ProtoMessage()    {}
+func (*DecryptResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{36}
+}
+
+func (m *DecryptResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DecryptResult.Unmarshal(m, b)
+}
+func (m *DecryptResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DecryptResult.Marshal(b, m, deterministic)
+}
+func (m *DecryptResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DecryptResult.Merge(m, src)
+}
+func (m *DecryptResult) XXX_Size() int {
+	return xxx_messageInfo_DecryptResult.Size(m)
+}
+func (m *DecryptResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_DecryptResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DecryptResult proto.InternalMessageInfo
+
+func (m *DecryptResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *DecryptResult) GetPlainText() []byte {
+	if m != nil {
+		return m.PlainText
+	}
+	return nil
+}
+
+type PurchasedMetaDataParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	MetaDataIdEncBuyer   []byte   `protobuf:"bytes,4,opt,name=metaDataIdEncBuyer,proto3" json:"metaDataIdEncBuyer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchasedMetaDataParams) Reset()         { *m = PurchasedMetaDataParams{} }
+func (m *PurchasedMetaDataParams) String() string { return proto.CompactTextString(m) }
+func (*PurchasedMetaDataParams) ProtoMessage()    {}
+func (*PurchasedMetaDataParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{37}
+}
+
+func (m *PurchasedMetaDataParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchasedMetaDataParams.Unmarshal(m, b)
+}
+func (m *PurchasedMetaDataParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchasedMetaDataParams.Marshal(b, m, deterministic)
+}
+func (m *PurchasedMetaDataParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchasedMetaDataParams.Merge(m, src)
+}
+func (m *PurchasedMetaDataParams) XXX_Size() int {
+	return xxx_messageInfo_PurchasedMetaDataParams.Size(m)
+}
+func (m *PurchasedMetaDataParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchasedMetaDataParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchasedMetaDataParams proto.InternalMessageInfo
+
+func (m *PurchasedMetaDataParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PurchasedMetaDataParams) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *PurchasedMetaDataParams) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *PurchasedMetaDataParams) GetMetaDataIdEncBuyer() []byte {
+	if m != nil {
+		return m.MetaDataIdEncBuyer
+	}
+	return nil
+}
+
+type PurchasedMetaDataResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	MetaDataId           string   `protobuf:"bytes,2,opt,name=metaDataId,proto3" json:"metaDataId,omitempty"`
+	Content              []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PurchasedMetaDataResult) Reset()         { *m = PurchasedMetaDataResult{} }
+func (m *PurchasedMetaDataResult) String() string { return proto.CompactTextString(m) }
+func (*PurchasedMetaDataResult) ProtoMessage()    {}
+func (*PurchasedMetaDataResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{38}
+}
+
+func (m *PurchasedMetaDataResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PurchasedMetaDataResult.Unmarshal(m, b)
+}
+func (m *PurchasedMetaDataResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PurchasedMetaDataResult.Marshal(b, m, deterministic)
+}
+func (m *PurchasedMetaDataResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PurchasedMetaDataResult.Merge(m, src)
+}
+func (m *PurchasedMetaDataResult) XXX_Size() int {
+	return xxx_messageInfo_PurchasedMetaDataResult.Size(m)
+}
+func (m *PurchasedMetaDataResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PurchasedMetaDataResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PurchasedMetaDataResult proto.InternalMessageInfo
+
+func (m *PurchasedMetaDataResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *PurchasedMetaDataResult) GetMetaDataId() string {
+	if m != nil {
+		return m.MetaDataId
+	}
+	return ""
+}
+
+func (m *PurchasedMetaDataResult) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+type VerifyMessageParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message              []byte   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyMessageParams) Reset()         { *m = VerifyMessageParams{} }
+func (m *VerifyMessageParams) String() string { return proto.CompactTextString(m) }
+func (*VerifyMessageParams) ProtoMessage()    {}
+func (*VerifyMessageParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{39}
+}
+
+func (m *VerifyMessageParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyMessageParams.Unmarshal(m, b)
+}
+func (m *VerifyMessageParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyMessageParams.Marshal(b, m, deterministic)
+}
+func (m *VerifyMessageParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyMessageParams.Merge(m, src)
+}
+func (m *VerifyMessageParams) XXX_Size() int {
+	return xxx_messageInfo_VerifyMessageParams.Size(m)
+}
+func (m *VerifyMessageParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyMessageParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyMessageParams proto.InternalMessageInfo
+
+func (m *VerifyMessageParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyMessageParams) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *VerifyMessageParams) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type VerifyTypedDataParams struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TypedData            string   `protobuf:"bytes,2,opt,name=typedData,proto3" json:"typedData,omitempty"`
+	Signature            []byte   `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyTypedDataParams) Reset()         { *m = VerifyTypedDataParams{} }
+func (m *VerifyTypedDataParams) String() string { return proto.CompactTextString(m) }
+func (*VerifyTypedDataParams) ProtoMessage()    {}
+func (*VerifyTypedDataParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{40}
+}
+
+func (m *VerifyTypedDataParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTypedDataParams.Unmarshal(m, b)
+}
+func (m *VerifyTypedDataParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTypedDataParams.Marshal(b, m, deterministic)
+}
+func (m *VerifyTypedDataParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTypedDataParams.Merge(m, src)
+}
+func (m *VerifyTypedDataParams) XXX_Size() int {
+	return xxx_messageInfo_VerifyTypedDataParams.Size(m)
+}
+func (m *VerifyTypedDataParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTypedDataParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTypedDataParams proto.InternalMessageInfo
+
+func (m *VerifyTypedDataParams) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerifyTypedDataParams) GetTypedData() string {
+	if m != nil {
+		return m.TypedData
+	}
+	return ""
+}
+
+func (m *VerifyTypedDataParams) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type VerifySignatureResult struct {
+	Result               *Result  `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Signer               string   `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySignatureResult) Reset()         { *m = VerifySignatureResult{} }
+func (m *VerifySignatureResult) String() string { return proto.CompactTextString(m) }
+func (*VerifySignatureResult) ProtoMessage()    {}
+func (*VerifySignatureResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{41}
+}
+
+func (m *VerifySignatureResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySignatureResult.Unmarshal(m, b)
+}
+func (m *VerifySignatureResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySignatureResult.Marshal(b, m, deterministic)
+}
+func (m *VerifySignatureResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySignatureResult.Merge(m, src)
+}
+func (m *VerifySignatureResult) XXX_Size() int {
+	return xxx_messageInfo_VerifySignatureResult.Size(m)
+}
+func (m *VerifySignatureResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifySignatureResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifySignatureResult proto.InternalMessageInfo
+
+func (m *VerifySignatureResult) GetResult() *Result {
 	if m != nil {
-		return m.GasPrice
+		return m.Result
 	}
-	return 0
+	return nil
 }
 
-func (m *TxParams) GetGasLimit() uint64 {
+func (m *VerifySignatureResult) GetSigner() string {
 	if m != nil {
-		return m.GasLimit
+		return m.Signer
 	}
-	return 0
+	return ""
 }
 
 type PublishParams struct {
 	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
-	Price                int64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Price                int64     `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Deprecated: Do not use.
 	MetaDataID           []byte    `protobuf:"bytes,3,opt,name=metaDataID,proto3" json:"metaDataID,omitempty"`
 	ProofDataIDs         []string  `protobuf:"bytes,4,rep,name=proofDataIDs,proto3" json:"proofDataIDs,omitempty"`
 	ProofNum             int32     `protobuf:"varint,5,opt,name=proofNum,proto3" json:"proofNum,omitempty"`
 	DetailsID            string    `protobuf:"bytes,6,opt,name=detailsID,proto3" json:"detailsID,omitempty"`
 	SupportVerify        bool      `protobuf:"varint,7,opt,name=supportVerify,proto3" json:"supportVerify,omitempty"`
+	PriceDecimal         string    `protobuf:"bytes,8,opt,name=priceDecimal,proto3" json:"priceDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -447,7 +2626,7 @@ func (m *PublishParams) Reset()         { *m = PublishParams{} }
 func (m *PublishParams) String() string { return proto.CompactTextString(m) }
 func (*PublishParams) ProtoMessage()    {}
 func (*PublishParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{8}
+	return fileDescriptor_3aeef8c45497084a, []int{42}
 }
 
 func (m *PublishParams) XXX_Unmarshal(b []byte) error {
@@ -475,6 +2654,7 @@ func (m *PublishParams) GetTxParam() *TxParams {
 	return nil
 }
 
+// Deprecated: Do not use.
 func (m *PublishParams) GetPrice() int64 {
 	if m != nil {
 		return m.Price
@@ -517,6 +2697,13 @@ func (m *PublishParams) GetSupportVerify() bool {
 	return false
 }
 
+func (m *PublishParams) GetPriceDecimal() string {
+	if m != nil {
+		return m.PriceDecimal
+	}
+	return ""
+}
+
 type PublishResult struct {
 	PublishId            string   `protobuf:"bytes,1,opt,name=publishId,proto3" json:"publishId,omitempty"`
 	Result               *Result  `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
@@ -529,7 +2716,7 @@ func (m *PublishResult) Reset()         { *m = PublishResult{} }
 func (m *PublishResult) String() string { return proto.CompactTextString(m) }
 func (*PublishResult) ProtoMessage()    {}
 func (*PublishResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{9}
+	return fileDescriptor_3aeef8c45497084a, []int{43}
 }
 
 func (m *PublishResult) XXX_Unmarshal(b []byte) error {
@@ -544,126 +2731,506 @@ func (m *PublishResult) XXX_Merge(src proto.Message) {
 func (m *PublishResult) XXX_Size() int {
 	return xxx_messageInfo_PublishResult.Size(m)
 }
-func (m *PublishResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_PublishResult.DiscardUnknown(m)
+func (m *PublishResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishResult proto.InternalMessageInfo
+
+func (m *PublishResult) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *PublishResult) GetResult() *Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type PrepareParams struct {
+	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
+	PublishId            string    `protobuf:"bytes,2,opt,name=publishId,proto3" json:"publishId,omitempty"`
+	StartVerify          bool      `protobuf:"varint,3,opt,name=startVerify,proto3" json:"startVerify,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *PrepareParams) Reset()         { *m = PrepareParams{} }
+func (m *PrepareParams) String() string { return proto.CompactTextString(m) }
+func (*PrepareParams) ProtoMessage()    {}
+func (*PrepareParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{44}
+}
+
+func (m *PrepareParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrepareParams.Unmarshal(m, b)
+}
+func (m *PrepareParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrepareParams.Marshal(b, m, deterministic)
+}
+func (m *PrepareParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrepareParams.Merge(m, src)
+}
+func (m *PrepareParams) XXX_Size() int {
+	return xxx_messageInfo_PrepareParams.Size(m)
+}
+func (m *PrepareParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrepareParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrepareParams proto.InternalMessageInfo
+
+func (m *PrepareParams) GetTxParam() *TxParams {
+	if m != nil {
+		return m.TxParam
+	}
+	return nil
+}
+
+func (m *PrepareParams) GetPublishId() string {
+	if m != nil {
+		return m.PublishId
+	}
+	return ""
+}
+
+func (m *PrepareParams) GetStartVerify() bool {
+	if m != nil {
+		return m.StartVerify
+	}
+	return false
+}
+
+type Result struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Result) Reset()         { *m = Result{} }
+func (m *Result) String() string { return proto.CompactTextString(m) }
+func (*Result) ProtoMessage()    {}
+func (*Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{45}
+}
+
+func (m *Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Result.Unmarshal(m, b)
+}
+func (m *Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Result.Marshal(b, m, deterministic)
+}
+func (m *Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Result.Merge(m, src)
+}
+func (m *Result) XXX_Size() int {
+	return xxx_messageInfo_Result.Size(m)
+}
+func (m *Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Result proto.InternalMessageInfo
+
+func (m *Result) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *Result) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+// attached to the status of failed calls, kind is one of the error kinds, such as INSUFFICIENT_BALANCE
+type ErrorDetail struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ErrorDetail) Reset()         { *m = ErrorDetail{} }
+func (m *ErrorDetail) String() string { return proto.CompactTextString(m) }
+func (*ErrorDetail) ProtoMessage()    {}
+func (*ErrorDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{46}
+}
+
+func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ErrorDetail.Unmarshal(m, b)
+}
+func (m *ErrorDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ErrorDetail.Marshal(b, m, deterministic)
+}
+func (m *ErrorDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorDetail.Merge(m, src)
+}
+func (m *ErrorDetail) XXX_Size() int {
+	return xxx_messageInfo_ErrorDetail.Size(m)
+}
+func (m *ErrorDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorDetail proto.InternalMessageInfo
+
+func (m *ErrorDetail) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ErrorDetail) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type StatusParams struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StatusParams) Reset()         { *m = StatusParams{} }
+func (m *StatusParams) String() string { return proto.CompactTextString(m) }
+func (*StatusParams) ProtoMessage()    {}
+func (*StatusParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{47}
+}
+
+func (m *StatusParams) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusParams.Unmarshal(m, b)
+}
+func (m *StatusParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusParams.Marshal(b, m, deterministic)
+}
+func (m *StatusParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusParams.Merge(m, src)
+}
+func (m *StatusParams) XXX_Size() int {
+	return xxx_messageInfo_StatusParams.Size(m)
+}
+func (m *StatusParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusParams proto.InternalMessageInfo
+
+type DependencyStatus struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy              bool     `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DependencyStatus) Reset()         { *m = DependencyStatus{} }
+func (m *DependencyStatus) String() string { return proto.CompactTextString(m) }
+func (*DependencyStatus) ProtoMessage()    {}
+func (*DependencyStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{48}
+}
+
+func (m *DependencyStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DependencyStatus.Unmarshal(m, b)
+}
+func (m *DependencyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DependencyStatus.Marshal(b, m, deterministic)
+}
+func (m *DependencyStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DependencyStatus.Merge(m, src)
+}
+func (m *DependencyStatus) XXX_Size() int {
+	return xxx_messageInfo_DependencyStatus.Size(m)
+}
+func (m *DependencyStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DependencyStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DependencyStatus proto.InternalMessageInfo
+
+func (m *DependencyStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DependencyStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *DependencyStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EventSubscribers struct {
+	Event                string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventSubscribers) Reset()         { *m = EventSubscribers{} }
+func (m *EventSubscribers) String() string { return proto.CompactTextString(m) }
+func (*EventSubscribers) ProtoMessage()    {}
+func (*EventSubscribers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{49}
+}
+
+func (m *EventSubscribers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSubscribers.Unmarshal(m, b)
+}
+func (m *EventSubscribers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSubscribers.Marshal(b, m, deterministic)
+}
+func (m *EventSubscribers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSubscribers.Merge(m, src)
+}
+func (m *EventSubscribers) XXX_Size() int {
+	return xxx_messageInfo_EventSubscribers.Size(m)
+}
+func (m *EventSubscribers) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSubscribers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSubscribers proto.InternalMessageInfo
+
+func (m *EventSubscribers) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *EventSubscribers) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// counts since the cache is created
+type CacheStatus struct {
+	Hits                 int64    `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses               int64    `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions            int64    `protobuf:"varint,3,opt,name=evictions,proto3" json:"evictions,omitempty"`
+	Corruptions          int64    `protobuf:"varint,4,opt,name=corruptions,proto3" json:"corruptions,omitempty"`
+	PassThroughs         int64    `protobuf:"varint,5,opt,name=passThroughs,proto3" json:"passThroughs,omitempty"`
+	Entries              uint32   `protobuf:"varint,6,opt,name=entries,proto3" json:"entries,omitempty"`
+	Size                 int64    `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	MaxSize              int64    `protobuf:"varint,8,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CacheStatus) Reset()         { *m = CacheStatus{} }
+func (m *CacheStatus) String() string { return proto.CompactTextString(m) }
+func (*CacheStatus) ProtoMessage()    {}
+func (*CacheStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{50}
+}
+
+func (m *CacheStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CacheStatus.Unmarshal(m, b)
+}
+func (m *CacheStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CacheStatus.Marshal(b, m, deterministic)
+}
+func (m *CacheStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CacheStatus.Merge(m, src)
+}
+func (m *CacheStatus) XXX_Size() int {
+	return xxx_messageInfo_CacheStatus.Size(m)
+}
+func (m *CacheStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_CacheStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CacheStatus proto.InternalMessageInfo
+
+func (m *CacheStatus) GetHits() int64 {
+	if m != nil {
+		return m.Hits
+	}
+	return 0
+}
+
+func (m *CacheStatus) GetMisses() int64 {
+	if m != nil {
+		return m.Misses
+	}
+	return 0
+}
+
+func (m *CacheStatus) GetEvictions() int64 {
+	if m != nil {
+		return m.Evictions
+	}
+	return 0
+}
+
+func (m *CacheStatus) GetCorruptions() int64 {
+	if m != nil {
+		return m.Corruptions
+	}
+	return 0
+}
+
+func (m *CacheStatus) GetPassThroughs() int64 {
+	if m != nil {
+		return m.PassThroughs
+	}
+	return 0
 }
 
-var xxx_messageInfo_PublishResult proto.InternalMessageInfo
+func (m *CacheStatus) GetEntries() uint32 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
 
-func (m *PublishResult) GetPublishId() string {
+func (m *CacheStatus) GetSize() int64 {
 	if m != nil {
-		return m.PublishId
+		return m.Size
 	}
-	return ""
+	return 0
 }
 
-func (m *PublishResult) GetResult() *Result {
+func (m *CacheStatus) GetMaxSize() int64 {
 	if m != nil {
-		return m.Result
+		return m.MaxSize
 	}
-	return nil
+	return 0
 }
 
-type PrepareParams struct {
-	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
-	PublishId            string    `protobuf:"bytes,2,opt,name=publishId,proto3" json:"publishId,omitempty"`
-	StartVerify          bool      `protobuf:"varint,3,opt,name=startVerify,proto3" json:"startVerify,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type StatusResult struct {
+	Result               *Result             `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Ready                bool                `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	Dependencies         []*DependencyStatus `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	ScannedBlock         uint64              `protobuf:"varint,4,opt,name=scannedBlock,proto3" json:"scannedBlock,omitempty"`
+	HeadBlock            uint64              `protobuf:"varint,5,opt,name=headBlock,proto3" json:"headBlock,omitempty"`
+	QueuedEvents         uint32              `protobuf:"varint,6,opt,name=queuedEvents,proto3" json:"queuedEvents,omitempty"`
+	Subscribers          []*EventSubscribers `protobuf:"bytes,7,rep,name=subscribers,proto3" json:"subscribers,omitempty"`
+	Streams              uint32              `protobuf:"varint,8,opt,name=streams,proto3" json:"streams,omitempty"`
+	Cache                *CacheStatus        `protobuf:"bytes,9,opt,name=cache,proto3" json:"cache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *PrepareParams) Reset()         { *m = PrepareParams{} }
-func (m *PrepareParams) String() string { return proto.CompactTextString(m) }
-func (*PrepareParams) ProtoMessage()    {}
-func (*PrepareParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{10}
+func (m *StatusResult) Reset()         { *m = StatusResult{} }
+func (m *StatusResult) String() string { return proto.CompactTextString(m) }
+func (*StatusResult) ProtoMessage()    {}
+func (*StatusResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aeef8c45497084a, []int{51}
 }
 
-func (m *PrepareParams) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PrepareParams.Unmarshal(m, b)
+func (m *StatusResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatusResult.Unmarshal(m, b)
 }
-func (m *PrepareParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PrepareParams.Marshal(b, m, deterministic)
+func (m *StatusResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatusResult.Marshal(b, m, deterministic)
 }
-func (m *PrepareParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PrepareParams.Merge(m, src)
+func (m *StatusResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusResult.Merge(m, src)
 }
-func (m *PrepareParams) XXX_Size() int {
-	return xxx_messageInfo_PrepareParams.Size(m)
+func (m *StatusResult) XXX_Size() int {
+	return xxx_messageInfo_StatusResult.Size(m)
 }
-func (m *PrepareParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_PrepareParams.DiscardUnknown(m)
+func (m *StatusResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PrepareParams proto.InternalMessageInfo
+var xxx_messageInfo_StatusResult proto.InternalMessageInfo
 
-func (m *PrepareParams) GetTxParam() *TxParams {
+func (m *StatusResult) GetResult() *Result {
 	if m != nil {
-		return m.TxParam
+		return m.Result
 	}
 	return nil
 }
 
-func (m *PrepareParams) GetPublishId() string {
+func (m *StatusResult) GetReady() bool {
 	if m != nil {
-		return m.PublishId
+		return m.Ready
 	}
-	return ""
+	return false
 }
 
-func (m *PrepareParams) GetStartVerify() bool {
+func (m *StatusResult) GetDependencies() []*DependencyStatus {
 	if m != nil {
-		return m.StartVerify
+		return m.Dependencies
 	}
-	return false
+	return nil
 }
 
-type Result struct {
-	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *StatusResult) GetScannedBlock() uint64 {
+	if m != nil {
+		return m.ScannedBlock
+	}
+	return 0
 }
 
-func (m *Result) Reset()         { *m = Result{} }
-func (m *Result) String() string { return proto.CompactTextString(m) }
-func (*Result) ProtoMessage()    {}
-func (*Result) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{11}
+func (m *StatusResult) GetHeadBlock() uint64 {
+	if m != nil {
+		return m.HeadBlock
+	}
+	return 0
 }
 
-func (m *Result) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Result.Unmarshal(m, b)
-}
-func (m *Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Result.Marshal(b, m, deterministic)
-}
-func (m *Result) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Result.Merge(m, src)
-}
-func (m *Result) XXX_Size() int {
-	return xxx_messageInfo_Result.Size(m)
-}
-func (m *Result) XXX_DiscardUnknown() {
-	xxx_messageInfo_Result.DiscardUnknown(m)
+func (m *StatusResult) GetQueuedEvents() uint32 {
+	if m != nil {
+		return m.QueuedEvents
+	}
+	return 0
 }
 
-var xxx_messageInfo_Result proto.InternalMessageInfo
+func (m *StatusResult) GetSubscribers() []*EventSubscribers {
+	if m != nil {
+		return m.Subscribers
+	}
+	return nil
+}
 
-func (m *Result) GetSuccess() bool {
+func (m *StatusResult) GetStreams() uint32 {
 	if m != nil {
-		return m.Success
+		return m.Streams
 	}
-	return false
+	return 0
 }
 
-func (m *Result) GetErrMsg() string {
+func (m *StatusResult) GetCache() *CacheStatus {
 	if m != nil {
-		return m.ErrMsg
+		return m.Cache
 	}
-	return ""
+	return nil
 }
 
 type BuyParams struct {
@@ -678,7 +3245,7 @@ func (m *BuyParams) Reset()         { *m = BuyParams{} }
 func (m *BuyParams) String() string { return proto.CompactTextString(m) }
 func (*BuyParams) ProtoMessage()    {}
 func (*BuyParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{12}
+	return fileDescriptor_3aeef8c45497084a, []int{52}
 }
 
 func (m *BuyParams) XXX_Unmarshal(b []byte) error {
@@ -725,7 +3292,7 @@ func (m *CancelTxParams) Reset()         { *m = CancelTxParams{} }
 func (m *CancelTxParams) String() string { return proto.CompactTextString(m) }
 func (*CancelTxParams) ProtoMessage()    {}
 func (*CancelTxParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{13}
+	return fileDescriptor_3aeef8c45497084a, []int{53}
 }
 
 func (m *CancelTxParams) XXX_Unmarshal(b []byte) error {
@@ -773,7 +3340,7 @@ func (m *ReEncryptDataParams) Reset()         { *m = ReEncryptDataParams{} }
 func (m *ReEncryptDataParams) String() string { return proto.CompactTextString(m) }
 func (*ReEncryptDataParams) ProtoMessage()    {}
 func (*ReEncryptDataParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{14}
+	return fileDescriptor_3aeef8c45497084a, []int{54}
 }
 
 func (m *ReEncryptDataParams) XXX_Unmarshal(b []byte) error {
@@ -828,7 +3395,7 @@ func (m *DataConfirmParams) Reset()         { *m = DataConfirmParams{} }
 func (m *DataConfirmParams) String() string { return proto.CompactTextString(m) }
 func (*DataConfirmParams) ProtoMessage()    {}
 func (*DataConfirmParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{15}
+	return fileDescriptor_3aeef8c45497084a, []int{55}
 }
 
 func (m *DataConfirmParams) XXX_Unmarshal(b []byte) error {
@@ -873,7 +3440,8 @@ func (m *DataConfirmParams) GetTruth() bool {
 type ApproveTransferParams struct {
 	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
 	SpenderAddr          string    `protobuf:"bytes,2,opt,name=spenderAddr,proto3" json:"spenderAddr,omitempty"`
-	Value                int64     `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Value                int64     `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	ValueDecimal         string    `protobuf:"bytes,4,opt,name=valueDecimal,proto3" json:"valueDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -883,7 +3451,7 @@ func (m *ApproveTransferParams) Reset()         { *m = ApproveTransferParams{} }
 func (m *ApproveTransferParams) String() string { return proto.CompactTextString(m) }
 func (*ApproveTransferParams) ProtoMessage()    {}
 func (*ApproveTransferParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{16}
+	return fileDescriptor_3aeef8c45497084a, []int{56}
 }
 
 func (m *ApproveTransferParams) XXX_Unmarshal(b []byte) error {
@@ -918,6 +3486,7 @@ func (m *ApproveTransferParams) GetSpenderAddr() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *ApproveTransferParams) GetValue() int64 {
 	if m != nil {
 		return m.Value
@@ -925,6 +3494,13 @@ func (m *ApproveTransferParams) GetValue() int64 {
 	return 0
 }
 
+func (m *ApproveTransferParams) GetValueDecimal() string {
+	if m != nil {
+		return m.ValueDecimal
+	}
+	return ""
+}
+
 type VoteParams struct {
 	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
 	TxId                 int64     `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
@@ -939,7 +3515,7 @@ func (m *VoteParams) Reset()         { *m = VoteParams{} }
 func (m *VoteParams) String() string { return proto.CompactTextString(m) }
 func (*VoteParams) ProtoMessage()    {}
 func (*VoteParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{17}
+	return fileDescriptor_3aeef8c45497084a, []int{57}
 }
 
 func (m *VoteParams) XXX_Unmarshal(b []byte) error {
@@ -999,7 +3575,7 @@ func (m *RegisterVerifierParams) Reset()         { *m = RegisterVerifierParams{}
 func (m *RegisterVerifierParams) String() string { return proto.CompactTextString(m) }
 func (*RegisterVerifierParams) ProtoMessage()    {}
 func (*RegisterVerifierParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{18}
+	return fileDescriptor_3aeef8c45497084a, []int{58}
 }
 
 func (m *RegisterVerifierParams) XXX_Unmarshal(b []byte) error {
@@ -1041,7 +3617,7 @@ func (m *CreditVerifierParams) Reset()         { *m = CreditVerifierParams{} }
 func (m *CreditVerifierParams) String() string { return proto.CompactTextString(m) }
 func (*CreditVerifierParams) ProtoMessage()    {}
 func (*CreditVerifierParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{19}
+	return fileDescriptor_3aeef8c45497084a, []int{59}
 }
 
 func (m *CreditVerifierParams) XXX_Unmarshal(b []byte) error {
@@ -1093,7 +3669,8 @@ func (m *CreditVerifierParams) GetCredit() uint32 {
 type TransferTokenParams struct {
 	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
 	To                   string    `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Value                int64     `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
+	Value                int64     `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	ValueDecimal         string    `protobuf:"bytes,4,opt,name=valueDecimal,proto3" json:"valueDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
 	XXX_unrecognized     []byte    `json:"-"`
 	XXX_sizecache        int32     `json:"-"`
@@ -1103,7 +3680,7 @@ func (m *TransferTokenParams) Reset()         { *m = TransferTokenParams{} }
 func (m *TransferTokenParams) String() string { return proto.CompactTextString(m) }
 func (*TransferTokenParams) ProtoMessage()    {}
 func (*TransferTokenParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{20}
+	return fileDescriptor_3aeef8c45497084a, []int{60}
 }
 
 func (m *TransferTokenParams) XXX_Unmarshal(b []byte) error {
@@ -1138,6 +3715,7 @@ func (m *TransferTokenParams) GetTo() string {
 	return ""
 }
 
+// Deprecated: Do not use.
 func (m *TransferTokenParams) GetValue() int64 {
 	if m != nil {
 		return m.Value
@@ -1145,6 +3723,13 @@ func (m *TransferTokenParams) GetValue() int64 {
 	return 0
 }
 
+func (m *TransferTokenParams) GetValueDecimal() string {
+	if m != nil {
+		return m.ValueDecimal
+	}
+	return ""
+}
+
 type TokenBalanceParams struct {
 	TxParam              *TxParams `protobuf:"bytes,1,opt,name=txParam,proto3" json:"txParam,omitempty"`
 	Owner                string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -1157,7 +3742,7 @@ func (m *TokenBalanceParams) Reset()         { *m = TokenBalanceParams{} }
 func (m *TokenBalanceParams) String() string { return proto.CompactTextString(m) }
 func (*TokenBalanceParams) ProtoMessage()    {}
 func (*TokenBalanceParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{21}
+	return fileDescriptor_3aeef8c45497084a, []int{61}
 }
 
 func (m *TokenBalanceParams) XXX_Unmarshal(b []byte) error {
@@ -1193,8 +3778,9 @@ func (m *TokenBalanceParams) GetOwner() string {
 }
 
 type TokenBalanceResult struct {
-	Balance              int64    `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Balance              int64    `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"` // Deprecated: Do not use.
 	Result               *Result  `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	BalanceDecimal       string   `protobuf:"bytes,3,opt,name=balanceDecimal,proto3" json:"balanceDecimal,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1204,7 +3790,7 @@ func (m *TokenBalanceResult) Reset()         { *m = TokenBalanceResult{} }
 func (m *TokenBalanceResult) String() string { return proto.CompactTextString(m) }
 func (*TokenBalanceResult) ProtoMessage()    {}
 func (*TokenBalanceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{22}
+	return fileDescriptor_3aeef8c45497084a, []int{62}
 }
 
 func (m *TokenBalanceResult) XXX_Unmarshal(b []byte) error {
@@ -1225,6 +3811,7 @@ func (m *TokenBalanceResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TokenBalanceResult proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *TokenBalanceResult) GetBalance() int64 {
 	if m != nil {
 		return m.Balance
@@ -1239,6 +3826,13 @@ func (m *TokenBalanceResult) GetResult() *Result {
 	return nil
 }
 
+func (m *TokenBalanceResult) GetBalanceDecimal() string {
+	if m != nil {
+		return m.BalanceDecimal
+	}
+	return ""
+}
+
 type SubscribeInfo struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Event                []string `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
@@ -1251,7 +3845,7 @@ func (m *SubscribeInfo) Reset()         { *m = SubscribeInfo{} }
 func (m *SubscribeInfo) String() string { return proto.CompactTextString(m) }
 func (*SubscribeInfo) ProtoMessage()    {}
 func (*SubscribeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aeef8c45497084a, []int{23}
+	return fileDescriptor_3aeef8c45497084a, []int{63}
 }
 
 func (m *SubscribeInfo) XXX_Unmarshal(b []byte) error {
@@ -1289,16 +3883,56 @@ func (m *SubscribeInfo) GetEvent() []string {
 func init() {
 	proto.RegisterType((*CreateAccountParams)(nil), "api.CreateAccountParams")
 	proto.RegisterType((*AccountResult)(nil), "api.AccountResult")
+	proto.RegisterType((*ImportAccountParams)(nil), "api.ImportAccountParams")
+	proto.RegisterType((*ListAccountsParams)(nil), "api.ListAccountsParams")
+	proto.RegisterType((*AccountListResult)(nil), "api.AccountListResult")
+	proto.RegisterType((*ExportAccountParams)(nil), "api.ExportAccountParams")
+	proto.RegisterType((*ExportAccountResult)(nil), "api.ExportAccountResult")
+	proto.RegisterType((*ChangePasswordParams)(nil), "api.ChangePasswordParams")
 	proto.RegisterType((*TransferEthParams)(nil), "api.TransferEthParams")
 	proto.RegisterType((*EthBalanceParams)(nil), "api.EthBalanceParams")
 	proto.RegisterType((*EthBalanceResult)(nil), "api.EthBalanceResult")
 	proto.RegisterType((*ClientInfo)(nil), "api.ClientInfo")
+	proto.RegisterType((*EventCursor)(nil), "api.EventCursor")
+	proto.RegisterType((*AckEventsParams)(nil), "api.AckEventsParams")
 	proto.RegisterType((*Event)(nil), "api.Event")
+	proto.RegisterType((*ChannelCreatedEvent)(nil), "api.ChannelCreatedEvent")
+	proto.RegisterType((*DataPublishEvent)(nil), "api.DataPublishEvent")
+	proto.RegisterType((*TransactionCreateEvent)(nil), "api.TransactionCreateEvent")
+	proto.RegisterType((*RegisterVerifierEvent)(nil), "api.RegisterVerifierEvent")
+	proto.RegisterType((*VerifiersChosenEvent)(nil), "api.VerifiersChosenEvent")
+	proto.RegisterType((*VoteEvent)(nil), "api.VoteEvent")
+	proto.RegisterType((*BuyEvent)(nil), "api.BuyEvent")
+	proto.RegisterType((*ReadyForDownloadEvent)(nil), "api.ReadyForDownloadEvent")
+	proto.RegisterType((*TransactionCloseEvent)(nil), "api.TransactionCloseEvent")
+	proto.RegisterType((*VerifierDisableEvent)(nil), "api.VerifierDisableEvent")
+	proto.RegisterType((*ArbitrationBeginEvent)(nil), "api.ArbitrationBeginEvent")
+	proto.RegisterType((*ArbitrationResultEvent)(nil), "api.ArbitrationResultEvent")
+	proto.RegisterType((*ApprovalEvent)(nil), "api.ApprovalEvent")
 	proto.RegisterType((*TxParams)(nil), "api.TxParams")
+	proto.RegisterType((*UnlockParams)(nil), "api.UnlockParams")
+	proto.RegisterType((*UnlockResult)(nil), "api.UnlockResult")
+	proto.RegisterType((*LockParams)(nil), "api.LockParams")
+	proto.RegisterType((*SignMessageParams)(nil), "api.SignMessageParams")
+	proto.RegisterType((*SignTypedDataParams)(nil), "api.SignTypedDataParams")
+	proto.RegisterType((*SignatureResult)(nil), "api.SignatureResult")
+	proto.RegisterType((*DecryptParams)(nil), "api.DecryptParams")
+	proto.RegisterType((*DecryptResult)(nil), "api.DecryptResult")
+	proto.RegisterType((*PurchasedMetaDataParams)(nil), "api.PurchasedMetaDataParams")
+	proto.RegisterType((*PurchasedMetaDataResult)(nil), "api.PurchasedMetaDataResult")
+	proto.RegisterType((*VerifyMessageParams)(nil), "api.VerifyMessageParams")
+	proto.RegisterType((*VerifyTypedDataParams)(nil), "api.VerifyTypedDataParams")
+	proto.RegisterType((*VerifySignatureResult)(nil), "api.VerifySignatureResult")
 	proto.RegisterType((*PublishParams)(nil), "api.PublishParams")
 	proto.RegisterType((*PublishResult)(nil), "api.PublishResult")
 	proto.RegisterType((*PrepareParams)(nil), "api.PrepareParams")
 	proto.RegisterType((*Result)(nil), "api.Result")
+	proto.RegisterType((*ErrorDetail)(nil), "api.ErrorDetail")
+	proto.RegisterType((*StatusParams)(nil), "api.StatusParams")
+	proto.RegisterType((*DependencyStatus)(nil), "api.DependencyStatus")
+	proto.RegisterType((*EventSubscribers)(nil), "api.EventSubscribers")
+	proto.RegisterType((*CacheStatus)(nil), "api.CacheStatus")
+	proto.RegisterType((*StatusResult)(nil), "api.StatusResult")
 	proto.RegisterType((*BuyParams)(nil), "api.BuyParams")
 	proto.RegisterType((*CancelTxParams)(nil), "api.CancelTxParams")
 	proto.RegisterType((*ReEncryptDataParams)(nil), "api.ReEncryptDataParams")
@@ -1316,78 +3950,212 @@ func init() {
 func init() { proto.RegisterFile("binary.proto", fileDescriptor_3aeef8c45497084a) }
 
 var fileDescriptor_3aeef8c45497084a = []byte{
-	// 1127 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0x23, 0xb5,
-	0x17, 0xdf, 0x49, 0x9a, 0xb6, 0x39, 0x6d, 0xba, 0xad, 0xfb, 0xf1, 0x9f, 0x7f, 0x40, 0x28, 0x32,
-	0x08, 0x72, 0x43, 0xc5, 0x6e, 0x17, 0xad, 0x40, 0x40, 0x49, 0xda, 0x6a, 0xa9, 0x44, 0x51, 0x71,
-	0xc3, 0x72, 0xed, 0xcc, 0x38, 0xad, 0x97, 0x64, 0x3c, 0xb2, 0x3d, 0xdd, 0x44, 0x5c, 0x71, 0xc7,
-	0x73, 0xf0, 0x26, 0xbc, 0x12, 0x4f, 0x80, 0xec, 0xf1, 0x4c, 0x66, 0x26, 0x59, 0xd8, 0x2c, 0xbd,
-	0xcb, 0xef, 0xf8, 0x7c, 0x8f, 0xcf, 0xef, 0xc4, 0xb0, 0x3d, 0xe4, 0x11, 0x95, 0xb3, 0xe3, 0x58,
-	0x0a, 0x2d, 0x50, 0x9d, 0xc6, 0x1c, 0x3f, 0x81, 0xfd, 0x33, 0xc9, 0xa8, 0x66, 0xbd, 0x20, 0x10,
-	0x49, 0xa4, 0xaf, 0xa9, 0xa4, 0x13, 0x85, 0xda, 0xb0, 0x19, 0x53, 0xa5, 0x5e, 0x0b, 0x19, 0xfa,
-	0x5e, 0xc7, 0xeb, 0x36, 0x49, 0x8e, 0x31, 0x81, 0x96, 0x53, 0x26, 0x4c, 0x25, 0x63, 0x8d, 0x3e,
-	0x84, 0x75, 0x69, 0x7f, 0xf9, 0xb5, 0x8e, 0xd7, 0xdd, 0x7a, 0xba, 0x75, 0x4c, 0x63, 0x7e, 0x9c,
-	0x1e, 0x12, 0x77, 0x84, 0xde, 0x87, 0x26, 0x4d, 0xad, 0x2e, 0x33, 0x97, 0x73, 0x01, 0xe6, 0xb0,
-	0x37, 0x90, 0x34, 0x52, 0x23, 0x26, 0x2f, 0xf4, 0x9d, 0x4b, 0x02, 0xc1, 0xda, 0x48, 0x8a, 0x89,
-	0xd3, 0xb6, 0xbf, 0x4b, 0x89, 0xd5, 0xca, 0x89, 0xa1, 0x1d, 0xa8, 0x69, 0xe1, 0xd7, 0xad, 0xb4,
-	0xa6, 0x05, 0x3a, 0x80, 0xc6, 0x3d, 0x1d, 0x27, 0xcc, 0x5f, 0xeb, 0x78, 0xdd, 0x3a, 0x49, 0x01,
-	0xee, 0xc2, 0xee, 0x85, 0xbe, 0xeb, 0xd3, 0x31, 0x8d, 0x02, 0xe6, 0x22, 0x1d, 0x40, 0x43, 0xbc,
-	0x8e, 0x98, 0x74, 0xa1, 0x52, 0x80, 0x7f, 0x2c, 0x6a, 0x2e, 0xd4, 0xea, 0xbd, 0xb9, 0x56, 0x1f,
-	0x36, 0x86, 0xa9, 0x95, 0xcd, 0xb1, 0x4e, 0x32, 0x88, 0xfb, 0x00, 0x67, 0x63, 0xce, 0x22, 0x7d,
-	0x19, 0x8d, 0x84, 0xd1, 0xa3, 0x61, 0x28, 0x99, 0x52, 0x2e, 0x70, 0x06, 0xff, 0xa9, 0x4c, 0xfc,
-	0x1c, 0x1a, 0x17, 0xf7, 0x2c, 0xd2, 0xa6, 0x3f, 0x9a, 0x4f, 0x98, 0xb5, 0xad, 0x13, 0xfb, 0xdb,
-	0x18, 0xbe, 0x52, 0x22, 0x3a, 0xa7, 0x9a, 0x66, 0x86, 0x19, 0xc6, 0x7f, 0x78, 0xb0, 0x39, 0x98,
-	0xbe, 0x63, 0x73, 0xf3, 0x66, 0xd6, 0x0b, 0xcd, 0x34, 0x15, 0xc4, 0x2c, 0x0a, 0x79, 0x74, 0x6b,
-	0x9b, 0xbc, 0x49, 0x32, 0x68, 0x7c, 0xdd, 0x52, 0x75, 0x2d, 0x79, 0xc0, 0xfc, 0x86, 0x35, 0xc9,
-	0xb1, 0x3b, 0xfb, 0x9e, 0x4f, 0xb8, 0xf6, 0xd7, 0x3b, 0x5e, 0x77, 0x8d, 0xe4, 0x18, 0xff, 0xe5,
-	0x41, 0xeb, 0x3a, 0x19, 0x8e, 0xb9, 0xca, 0xae, 0xc1, 0x27, 0xb0, 0xa1, 0xd3, 0xac, 0x5d, 0xcf,
-	0x5b, 0xb6, 0xe7, 0x59, 0x25, 0x24, 0x3b, 0x35, 0x29, 0xc6, 0x36, 0x5e, 0xda, 0xf4, 0x14, 0xa0,
-	0x0f, 0x00, 0x26, 0x4c, 0x53, 0xd3, 0x81, 0xcb, 0x73, 0x9b, 0xfd, 0x36, 0x29, 0x48, 0x10, 0x86,
-	0xed, 0x58, 0x0a, 0x31, 0x4a, 0xa1, 0xf2, 0xd7, 0x3a, 0xf5, 0x6e, 0x93, 0x94, 0x64, 0xb6, 0x31,
-	0x06, 0xff, 0x90, 0x4c, 0x6c, 0x31, 0x0d, 0x92, 0x63, 0x73, 0xb1, 0x43, 0xa6, 0x29, 0x1f, 0xab,
-	0xcb, 0x73, 0x5b, 0x4d, 0x93, 0xcc, 0x05, 0xe8, 0x23, 0x68, 0xa9, 0x24, 0x8e, 0x85, 0xd4, 0x2f,
-	0x99, 0xe4, 0xa3, 0x99, 0xbf, 0x61, 0xdb, 0x54, 0x16, 0x9a, 0x91, 0x72, 0x35, 0x93, 0x7c, 0x5a,
-	0xe2, 0x54, 0x30, 0x9f, 0x96, 0x5c, 0xf0, 0x56, 0x03, 0x87, 0xa7, 0xd0, 0xba, 0x96, 0x2c, 0xa6,
-	0x92, 0xad, 0xda, 0xc7, 0x52, 0xf0, 0x5a, 0x35, 0x78, 0x07, 0xb6, 0x94, 0xa6, 0x79, 0x3d, 0x75,
-	0x5b, 0x4f, 0x51, 0x84, 0xbf, 0x84, 0x75, 0x92, 0x0f, 0x82, 0x4a, 0x82, 0x20, 0xbb, 0xe0, 0x9b,
-	0x24, 0x83, 0xe8, 0x08, 0xd6, 0x99, 0x94, 0x57, 0xea, 0xd6, 0x05, 0x70, 0x08, 0x7f, 0x07, 0xcd,
-	0x7e, 0x32, 0x5b, 0x35, 0x63, 0x33, 0x09, 0x53, 0x97, 0xac, 0x99, 0x84, 0xe9, 0x65, 0x88, 0xaf,
-	0x60, 0xe7, 0xcc, 0xcc, 0xdc, 0x38, 0xbf, 0xf2, 0xff, 0xc9, 0xdd, 0xef, 0x1e, 0xec, 0x13, 0x76,
-	0x11, 0x05, 0x72, 0x16, 0x6b, 0x73, 0x2f, 0x1e, 0xc0, 0x29, 0x7a, 0x06, 0x87, 0x2c, 0x0a, 0x44,
-	0xc8, 0x42, 0xe3, 0xf1, 0x67, 0xae, 0xef, 0x6e, 0xd8, 0x78, 0xcc, 0xa4, 0xbb, 0xa6, 0xcb, 0x0f,
-	0xf1, 0x08, 0xf6, 0x8c, 0xe4, 0x4c, 0x44, 0x23, 0x2e, 0x27, 0x0f, 0x91, 0xc7, 0x01, 0x34, 0xb4,
-	0x4c, 0xf4, 0x9d, 0xfb, 0x9a, 0x29, 0xc0, 0x53, 0x38, 0xec, 0xc5, 0xb1, 0x14, 0xf7, 0x2c, 0xe3,
-	0xe6, 0x55, 0x63, 0x99, 0xbb, 0x62, 0x08, 0x81, 0xc9, 0x5e, 0x18, 0x4a, 0xf7, 0xa9, 0x8b, 0xa2,
-	0xe5, 0xb4, 0x82, 0x7f, 0x05, 0x78, 0x29, 0x34, 0x7b, 0xa0, 0xd2, 0x5e, 0x25, 0xe1, 0x2d, 0xcb,
-	0x4a, 0xb3, 0xc0, 0x0c, 0x74, 0x20, 0x26, 0x13, 0x16, 0x69, 0x65, 0x89, 0xab, 0x49, 0x72, 0x8c,
-	0x7b, 0x70, 0x44, 0xd8, 0x2d, 0x57, 0x9a, 0x49, 0x7b, 0xa1, 0xf9, 0xca, 0x75, 0xe3, 0xdf, 0x3c,
-	0x38, 0x38, 0x93, 0x2c, 0xe4, 0xfa, 0x1d, 0x3d, 0xbc, 0xa9, 0x14, 0x1e, 0x85, 0x6c, 0x6a, 0x4b,
-	0x69, 0x91, 0x14, 0x98, 0x49, 0x0a, 0x6c, 0x28, 0x5b, 0x48, 0x8b, 0x38, 0x84, 0x43, 0xd8, 0xcf,
-	0x3e, 0xdb, 0x40, 0xfc, 0xc2, 0xa2, 0x55, 0x33, 0x48, 0xb7, 0x69, 0x6d, 0x71, 0x9b, 0x96, 0xbe,
-	0xd4, 0x0d, 0x20, 0xeb, 0xbd, 0xbc, 0x4f, 0x57, 0xa1, 0xec, 0x74, 0xf1, 0xd6, 0x8a, 0x8b, 0xb7,
-	0xe2, 0x94, 0x2c, 0x6c, 0x55, 0xaf, 0xb4, 0x55, 0xdf, 0x8e, 0x0f, 0x4f, 0xa1, 0x75, 0x93, 0x0c,
-	0x55, 0x20, 0xf9, 0x90, 0xfd, 0xcb, 0xf6, 0x3d, 0x80, 0x06, 0x33, 0x1b, 0xd6, 0xaf, 0xd9, 0x5d,
-	0x90, 0x82, 0xa7, 0x7f, 0x6e, 0x42, 0xab, 0x6f, 0xff, 0x40, 0xdd, 0x30, 0x79, 0x6f, 0x56, 0xcb,
-	0x09, 0xec, 0xe4, 0x2e, 0xdd, 0x4a, 0xb6, 0x91, 0x4b, 0x71, 0xda, 0xc5, 0x6c, 0xf0, 0x23, 0xf4,
-	0x39, 0xec, 0xfe, 0x14, 0xad, 0x6e, 0xf6, 0x29, 0x00, 0x61, 0xc1, 0xbd, 0xd5, 0x57, 0xe8, 0xb1,
-	0x3d, 0x9c, 0xff, 0x95, 0x68, 0x83, 0x15, 0xd8, 0x53, 0xfc, 0xe8, 0x33, 0x0f, 0x9d, 0xc0, 0x86,
-	0xdb, 0x28, 0xce, 0x79, 0x69, 0xa7, 0xb6, 0x4b, 0xb2, 0x3c, 0xc6, 0x13, 0xd8, 0x76, 0x2b, 0x63,
-	0x20, 0xfa, 0xc9, 0x2c, 0xb3, 0x2c, 0x6e, 0x91, 0x6a, 0x5a, 0x5d, 0xd8, 0xe8, 0x27, 0x33, 0x43,
-	0x47, 0x68, 0xc7, 0x9e, 0xe4, 0xec, 0x5d, 0xd5, 0x7c, 0x0e, 0x7b, 0x8e, 0x8f, 0xcd, 0xad, 0xa4,
-	0x81, 0xe6, 0x22, 0x42, 0xfb, 0x69, 0x1d, 0x25, 0x9e, 0xae, 0x1a, 0x7e, 0x53, 0x20, 0xde, 0xab,
-	0x6c, 0x6f, 0x87, 0xc8, 0x77, 0x5a, 0x0b, 0x94, 0x5c, 0xb5, 0xff, 0x02, 0x76, 0x1d, 0x55, 0x1a,
-	0x9d, 0x81, 0xa1, 0x36, 0x74, 0x64, 0x55, 0x16, 0x58, 0xb4, 0x6a, 0xfa, 0x15, 0x3c, 0xae, 0x30,
-	0x20, 0x6a, 0x5b, 0x8d, 0xa5, 0xbc, 0x58, 0xb5, 0xfe, 0x18, 0xd6, 0x0c, 0x8b, 0xb9, 0x8f, 0x35,
-	0x27, 0xb4, 0xaa, 0xde, 0xb7, 0x80, 0x32, 0xc2, 0xe9, 0xa9, 0x8c, 0x30, 0xd0, 0x7b, 0x4e, 0x69,
-	0x19, 0x13, 0x55, 0x3d, 0x7c, 0x0d, 0x7b, 0x29, 0xdd, 0xa8, 0x81, 0xc8, 0x1d, 0xfc, 0x3f, 0xed,
-	0xed, 0x12, 0x1a, 0x5a, 0xec, 0xd0, 0x4e, 0x89, 0x2a, 0x94, 0x6b, 0xee, 0x12, 0xfe, 0xa8, 0x9a,
-	0x5e, 0xc0, 0xe3, 0x17, 0x4c, 0x17, 0xa7, 0x15, 0xfd, 0x2f, 0xb5, 0x5d, 0x60, 0x85, 0xf6, 0xe2,
-	0x41, 0xee, 0xe6, 0x14, 0x5a, 0xa5, 0x67, 0x88, 0x4b, 0x60, 0xc9, 0xd3, 0xc4, 0x5d, 0xdd, 0xd2,
-	0x0b, 0x04, 0x3f, 0x42, 0xc7, 0xb0, 0xdd, 0x4b, 0xf4, 0x1d, 0x8b, 0x34, 0x0f, 0x68, 0xde, 0xf3,
-	0xc2, 0x80, 0x54, 0xf2, 0x7e, 0x06, 0x5b, 0x85, 0x07, 0x87, 0xbb, 0x0f, 0x0b, 0x4f, 0x90, 0xaa,
-	0xd5, 0x29, 0xb4, 0x5e, 0x30, 0x3d, 0x7f, 0x14, 0xa0, 0xc3, 0x74, 0xec, 0x2a, 0xef, 0x89, 0x76,
-	0x55, 0x9c, 0x39, 0x18, 0xae, 0xdb, 0xa7, 0xd7, 0xc9, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7b,
-	0xb5, 0x1e, 0x72, 0x8a, 0x0d, 0x00, 0x00,
+	// 3267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3a, 0x4b, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xdf, 0xee, 0x92, 0x5c, 0x6e, 0x91, 0xcb, 0xc7, 0xf0, 0xa1, 0xd1, 0x8a, 0x96, 0xe9, 0xb6,
+	0x3e, 0x9b, 0x11, 0xb0, 0x92, 0xad, 0x04, 0x48, 0xac, 0x00, 0x49, 0xf8, 0xb2, 0xc5, 0x58, 0x32,
+	0x88, 0xe1, 0x4a, 0xf1, 0x21, 0x88, 0xd1, 0x3b, 0xd3, 0xdc, 0x1d, 0x73, 0x77, 0x66, 0x33, 0xdd,
+	0x43, 0x71, 0x63, 0x04, 0x46, 0x72, 0xca, 0x21, 0xb0, 0x0f, 0x41, 0x0e, 0xc9, 0x29, 0xb7, 0x5c,
+	0x82, 0x00, 0xb9, 0xe4, 0x8f, 0xe4, 0x2f, 0xc4, 0xff, 0x23, 0xe8, 0xd7, 0x4c, 0xf7, 0xec, 0x90,
+	0xe2, 0xea, 0x71, 0x9b, 0xaa, 0xee, 0xae, 0x57, 0x57, 0x57, 0x55, 0x57, 0x0f, 0x2c, 0x76, 0xc3,
+	0x08, 0x27, 0xe3, 0x7b, 0xa3, 0x24, 0x66, 0xb1, 0x53, 0xc3, 0xa3, 0xb0, 0xb5, 0xd5, 0x8b, 0xe3,
+	0xde, 0x80, 0xdc, 0xc7, 0xa3, 0xf0, 0x3e, 0x8e, 0xa2, 0x98, 0x61, 0x16, 0xc6, 0x11, 0x95, 0x53,
+	0xd0, 0x87, 0xb0, 0xb6, 0x9f, 0x10, 0xcc, 0xc8, 0xae, 0xef, 0xc7, 0x69, 0xc4, 0x8e, 0x71, 0x82,
+	0x87, 0xd4, 0x69, 0xc1, 0xfc, 0x08, 0x53, 0xfa, 0x3c, 0x4e, 0x02, 0xb7, 0xb2, 0x5d, 0xd9, 0x69,
+	0x78, 0x19, 0x8c, 0x3c, 0x68, 0xaa, 0xc9, 0x1e, 0xa1, 0xe9, 0x80, 0x39, 0xef, 0xc2, 0x5c, 0x22,
+	0xbe, 0xdc, 0xea, 0x76, 0x65, 0x67, 0xe1, 0xc1, 0xc2, 0x3d, 0x3c, 0x0a, 0xef, 0xc9, 0x41, 0x4f,
+	0x0d, 0x39, 0x5b, 0xd0, 0xc0, 0x72, 0xd5, 0x91, 0x26, 0x99, 0x23, 0xd0, 0x18, 0xd6, 0x8e, 0x86,
+	0xa3, 0x38, 0x61, 0x13, 0x62, 0x9c, 0x91, 0x31, 0x65, 0x71, 0x42, 0xc4, 0x9a, 0x45, 0x2f, 0x83,
+	0x9d, 0xbb, 0xb0, 0xa2, 0xbf, 0x8f, 0xb5, 0xa8, 0x55, 0x41, 0x77, 0x02, 0x6f, 0xa9, 0x53, 0x2b,
+	0xa8, 0x73, 0x17, 0x9c, 0xc7, 0x21, 0xd5, 0x8c, 0xa9, 0xe2, 0xbc, 0x0e, 0xb3, 0x2c, 0x3e, 0x23,
+	0x91, 0x12, 0x55, 0x02, 0xe8, 0x73, 0x58, 0x55, 0xf3, 0xf8, 0x92, 0x09, 0xf5, 0x2b, 0x97, 0xab,
+	0x7f, 0x1b, 0x20, 0xd3, 0x96, 0xba, 0xd5, 0xed, 0xda, 0x4e, 0xc3, 0x33, 0x30, 0x88, 0xc2, 0xda,
+	0xe1, 0xc5, 0xa4, 0x01, 0x5c, 0xa8, 0xe3, 0x20, 0x48, 0x08, 0xa5, 0x4a, 0x10, 0x0d, 0x5a, 0x2a,
+	0x55, 0x6d, 0x95, 0x9c, 0xf7, 0x60, 0x89, 0x08, 0x62, 0xc7, 0xb6, 0xd2, 0x05, 0x2c, 0xea, 0x14,
+	0x98, 0x4e, 0xa3, 0x90, 0x0b, 0xf5, 0x33, 0x32, 0xfe, 0x39, 0x8d, 0x23, 0xc1, 0x7e, 0xd1, 0xd3,
+	0x20, 0x62, 0xb0, 0xbe, 0xdf, 0xc7, 0x51, 0x2f, 0x33, 0xff, 0x0b, 0x75, 0xd9, 0x86, 0x85, 0x78,
+	0x10, 0x14, 0x76, 0xd1, 0x44, 0xf1, 0x19, 0x11, 0x79, 0x5e, 0x50, 0xc7, 0x44, 0xa1, 0xbf, 0x57,
+	0x60, 0xb5, 0x93, 0xe0, 0x88, 0x9e, 0x92, 0xe4, 0x90, 0xf5, 0x15, 0x4f, 0x07, 0x66, 0x4e, 0x93,
+	0x78, 0xa8, 0x18, 0x8a, 0xef, 0x2b, 0x2d, 0xb7, 0x04, 0x55, 0x16, 0x2b, 0xf2, 0x55, 0x16, 0x3b,
+	0x2e, 0xcc, 0x9e, 0xe3, 0x41, 0x4a, 0xdc, 0x99, 0xed, 0xca, 0x4e, 0x6d, 0xaf, 0xea, 0x56, 0x3c,
+	0x89, 0xc8, 0x1d, 0x64, 0xd6, 0x70, 0x10, 0x07, 0xc1, 0xa2, 0x18, 0x3e, 0x20, 0x7e, 0x38, 0xc4,
+	0x03, 0x77, 0x4e, 0x0c, 0x5a, 0x38, 0xb4, 0x03, 0x2b, 0x87, 0xac, 0xbf, 0x87, 0x07, 0x38, 0xf2,
+	0x49, 0xee, 0x6e, 0xf1, 0xf3, 0x88, 0x24, 0xda, 0xdd, 0x04, 0x80, 0x7e, 0x6b, 0xce, 0x9c, 0x66,
+	0x73, 0xb6, 0xa0, 0xde, 0x95, 0xab, 0xdc, 0x6a, 0x26, 0xb8, 0x46, 0x71, 0xf7, 0x50, 0x9f, 0x5a,
+	0x4c, 0xe5, 0x1e, 0x36, 0x16, 0x7d, 0x53, 0x01, 0xd8, 0x1f, 0x84, 0x24, 0x62, 0x47, 0xd1, 0x69,
+	0xfc, 0x92, 0xbe, 0xb8, 0x0d, 0x0b, 0x6c, 0x3c, 0x22, 0xc1, 0xe1, 0x39, 0x89, 0x18, 0x15, 0x9c,
+	0xe6, 0x3d, 0x13, 0xe5, 0xec, 0xc0, 0x9c, 0x9f, 0x26, 0x34, 0x4e, 0x84, 0x91, 0x17, 0x1e, 0xac,
+	0x08, 0x8d, 0xc4, 0xe0, 0xbe, 0xc0, 0x7b, 0x6a, 0x1c, 0x7d, 0x0a, 0x0b, 0x06, 0x9a, 0x93, 0xee,
+	0x0e, 0x62, 0xff, 0xec, 0xb3, 0x74, 0xd8, 0x55, 0xa6, 0x9b, 0xf1, 0x4c, 0x14, 0x17, 0x6c, 0x10,
+	0xf7, 0x8e, 0xa2, 0x80, 0x5c, 0x08, 0xc1, 0x9a, 0x5e, 0x06, 0xa3, 0x33, 0x58, 0xde, 0xf5, 0xcf,
+	0xa4, 0x0c, 0x2f, 0xf4, 0xd0, 0x5c, 0xc6, 0xea, 0xd5, 0x32, 0xe6, 0x7e, 0x51, 0x33, 0x03, 0xc7,
+	0xbf, 0xe7, 0x61, 0x56, 0xcc, 0xe6, 0x1e, 0xc9, 0xc2, 0xa1, 0x0c, 0x67, 0x35, 0x4f, 0x7c, 0x73,
+	0x31, 0xbf, 0xa4, 0x71, 0x74, 0x80, 0x19, 0xd6, 0xf6, 0xd3, 0x30, 0x9f, 0x1f, 0xe1, 0x21, 0x51,
+	0xe4, 0xc4, 0x77, 0x51, 0xf1, 0x99, 0x49, 0xc5, 0x37, 0x61, 0x8e, 0x5d, 0x3c, 0xc2, 0xb4, 0xaf,
+	0xdc, 0x53, 0x41, 0x96, 0x41, 0xe6, 0x6c, 0x83, 0x38, 0x3b, 0xb0, 0xec, 0xc7, 0x11, 0x4b, 0xb0,
+	0xcf, 0x76, 0x95, 0x15, 0xea, 0x62, 0x71, 0x11, 0xcd, 0x75, 0x4c, 0x29, 0x49, 0xa8, 0x3b, 0x2f,
+	0xe2, 0x98, 0x04, 0x9c, 0x3d, 0x58, 0xf2, 0xfb, 0x38, 0x8a, 0xc8, 0x40, 0x66, 0x94, 0xc0, 0x05,
+	0x61, 0x2b, 0x57, 0xd8, 0x6a, 0xdf, 0x1a, 0x12, 0xb6, 0x78, 0xf4, 0x7f, 0x5e, 0x61, 0x85, 0xf3,
+	0x11, 0x2c, 0x04, 0x98, 0xe1, 0xe3, 0xb4, 0x3b, 0x08, 0x69, 0xdf, 0x5d, 0x10, 0x04, 0x36, 0x04,
+	0x81, 0x83, 0x1c, 0xaf, 0x57, 0x9b, 0x73, 0x9d, 0x4f, 0x61, 0x95, 0xf1, 0xf3, 0x8f, 0x7d, 0x9e,
+	0xdf, 0x24, 0x41, 0x77, 0x51, 0x10, 0xb8, 0x25, 0x08, 0x74, 0x8a, 0xa3, 0x9a, 0xcc, 0xe4, 0x3a,
+	0xe7, 0x11, 0xac, 0x24, 0xa4, 0x17, 0x52, 0x46, 0x92, 0x67, 0x24, 0x09, 0x4f, 0x43, 0x92, 0xb8,
+	0x4d, 0x41, 0xab, 0xa5, 0xce, 0x9b, 0x3d, 0xa8, 0x49, 0x4d, 0xac, 0x72, 0x0e, 0x61, 0xf9, 0x5c,
+	0x7d, 0xd3, 0xfd, 0x7e, 0x4c, 0x49, 0xe4, 0x2e, 0x09, 0x42, 0x37, 0x05, 0xa1, 0x67, 0xf6, 0x98,
+	0xa6, 0x53, 0x5c, 0xe3, 0xdc, 0x81, 0x99, 0xf3, 0x98, 0x11, 0x77, 0x59, 0xac, 0x5d, 0x92, 0x6b,
+	0xe3, 0x5c, 0x07, 0x31, 0xea, 0xbc, 0x03, 0xb5, 0x6e, 0x3a, 0x76, 0x57, 0xc4, 0xa4, 0xa6, 0x98,
+	0xb4, 0x97, 0x8e, 0xf5, 0x1c, 0x3e, 0x26, 0x35, 0xc3, 0xc1, 0xf8, 0xe3, 0x38, 0x39, 0x88, 0x9f,
+	0x47, 0x83, 0x18, 0x07, 0xee, 0xaa, 0xa5, 0x99, 0x3d, 0x68, 0x68, 0x66, 0x0f, 0x70, 0x4a, 0xa6,
+	0xe1, 0x06, 0x31, 0x25, 0xae, 0x63, 0x50, 0xea, 0x14, 0x06, 0x33, 0x4a, 0xc5, 0x55, 0xa6, 0x8d,
+	0x0e, 0x42, 0x8a, 0xbb, 0x03, 0xe2, 0xae, 0x95, 0xd8, 0x48, 0x8d, 0x4d, 0xd8, 0x48, 0xe1, 0xb9,
+	0x40, 0x38, 0xe9, 0x86, 0x2c, 0x11, 0x15, 0xce, 0x1e, 0xe9, 0x85, 0x91, 0xbb, 0x6e, 0x08, 0xb4,
+	0x5b, 0x18, 0xcc, 0x04, 0x2a, 0xae, 0xe2, 0xbe, 0x64, 0xe0, 0x64, 0x70, 0x75, 0x37, 0x0c, 0x5f,
+	0xda, 0x2d, 0x8e, 0x66, 0xbe, 0x34, 0xb1, 0xce, 0xf9, 0x00, 0xe6, 0xf1, 0x68, 0x94, 0xc4, 0xe7,
+	0x78, 0xe0, 0x6e, 0x0a, 0x1a, 0x8e, 0xa4, 0xa1, 0x90, 0x7a, 0x69, 0x36, 0x6b, 0xaf, 0x01, 0xf5,
+	0x11, 0x1e, 0x73, 0x23, 0xa3, 0x0d, 0x58, 0x2b, 0x39, 0x39, 0xe8, 0x8f, 0x15, 0x58, 0x29, 0x1e,
+	0x08, 0x5e, 0x62, 0x8d, 0x24, 0x9c, 0x97, 0x58, 0x19, 0x82, 0x1f, 0xda, 0x51, 0x12, 0xaa, 0x8c,
+	0xd0, 0xf0, 0x24, 0xc0, 0xeb, 0x92, 0x80, 0xd0, 0x11, 0xa7, 0x75, 0xa4, 0xf3, 0xaa, 0x81, 0x71,
+	0xee, 0x40, 0x93, 0xa6, 0x23, 0x5e, 0x23, 0x88, 0x5d, 0x18, 0x8b, 0x60, 0x33, 0xef, 0xd9, 0x48,
+	0xf4, 0xcf, 0x0a, 0x6c, 0x96, 0x1f, 0x2f, 0x4e, 0xc0, 0xd8, 0x6f, 0x25, 0xd8, 0x8c, 0x67, 0x23,
+	0x6d, 0xd1, 0xab, 0x45, 0xd1, 0x79, 0x7e, 0x49, 0xe2, 0xf8, 0x94, 0x97, 0x4e, 0x35, 0x11, 0x72,
+	0x32, 0x98, 0x2b, 0x10, 0x11, 0x12, 0x58, 0xd2, 0x19, 0x18, 0xae, 0x36, 0x65, 0x3c, 0x14, 0xcc,
+	0x8a, 0x70, 0x27, 0x01, 0x74, 0x03, 0x36, 0x4a, 0x8f, 0x30, 0x37, 0xec, 0x7a, 0xd9, 0x99, 0x7c,
+	0xe3, 0x7a, 0x64, 0x72, 0xce, 0x98, 0x72, 0x7e, 0x5b, 0x81, 0x46, 0x76, 0xcc, 0xaf, 0x29, 0xc3,
+	0x3a, 0xcc, 0x7e, 0x99, 0x06, 0x3d, 0xb9, 0xd1, 0xf3, 0x9e, 0x04, 0x38, 0x6f, 0x3f, 0x1e, 0x0e,
+	0xb3, 0x24, 0xdc, 0xf0, 0x32, 0xb8, 0x9c, 0x37, 0xc7, 0x86, 0x22, 0x51, 0x28, 0xcb, 0x09, 0x00,
+	0xfd, 0xa3, 0x02, 0xf3, 0x3a, 0xa6, 0xbc, 0x16, 0xa3, 0x7c, 0x00, 0x6b, 0x43, 0xc2, 0xb0, 0xf4,
+	0xb7, 0xc3, 0xc8, 0x3f, 0x21, 0x83, 0x01, 0x49, 0x84, 0x8c, 0x8b, 0x5e, 0xd9, 0xd0, 0x54, 0xe2,
+	0xfe, 0xa5, 0xc2, 0x77, 0xba, 0x24, 0xa4, 0x5d, 0x53, 0xf6, 0x7b, 0xe0, 0x58, 0x22, 0xec, 0xa5,
+	0x63, 0x92, 0xa8, 0x8a, 0xb7, 0x64, 0x24, 0x97, 0xad, 0x56, 0x2a, 0xdb, 0x8c, 0x29, 0x5b, 0x08,
+	0x1b, 0xa5, 0x31, 0xf2, 0xfa, 0xfb, 0x2c, 0x59, 0x55, 0x4b, 0x59, 0xd5, 0x4c, 0x56, 0x0f, 0x72,
+	0xaf, 0x36, 0xa3, 0x28, 0xf7, 0x0a, 0x1d, 0x45, 0xf5, 0x3d, 0x4f, 0xc3, 0xe8, 0x5f, 0x15, 0xd8,
+	0x28, 0x0d, 0x99, 0x6f, 0xfc, 0x2c, 0xfc, 0x08, 0x6e, 0x58, 0xa6, 0xd5, 0x52, 0xa8, 0x12, 0x71,
+	0xd1, 0xbb, 0x6c, 0x18, 0x8d, 0x60, 0xb3, 0x3c, 0x34, 0xbf, 0xea, 0xd9, 0x09, 0x03, 0x12, 0x31,
+	0x1e, 0x61, 0xa4, 0x59, 0x33, 0x18, 0x3d, 0x85, 0xa6, 0x15, 0xc8, 0xcb, 0x4b, 0x79, 0x5e, 0x5a,
+	0xd2, 0x11, 0x89, 0x02, 0xe5, 0x3c, 0x0d, 0x4f, 0x83, 0x7c, 0xbe, 0xbc, 0x62, 0xa8, 0x82, 0x51,
+	0x00, 0xe8, 0x9b, 0x2a, 0xcc, 0x77, 0x2e, 0x5e, 0xf2, 0x16, 0xe3, 0x9a, 0x24, 0xad, 0x5b, 0x8b,
+	0x0b, 0x75, 0xce, 0x36, 0x8c, 0x7a, 0x2a, 0x54, 0x6a, 0xd0, 0xb9, 0x0d, 0xf3, 0x3d, 0x4c, 0x8f,
+	0x45, 0x86, 0x98, 0xcd, 0x96, 0x65, 0x38, 0xce, 0xaf, 0x87, 0xe9, 0xe3, 0x70, 0x18, 0x32, 0x51,
+	0x39, 0xce, 0x78, 0x19, 0x9c, 0xd7, 0xbc, 0xf5, 0xab, 0xee, 0x42, 0xf3, 0x93, 0x77, 0x21, 0x5e,
+	0x73, 0x6a, 0x0e, 0x7a, 0x5a, 0x43, 0xd6, 0x9c, 0x05, 0x34, 0x3a, 0x87, 0xc5, 0xa7, 0x11, 0xaf,
+	0x70, 0x5f, 0xe9, 0x66, 0x7c, 0x1b, 0x20, 0x1e, 0x11, 0xe9, 0x1d, 0xda, 0xef, 0x0c, 0x8c, 0xb3,
+	0x02, 0x35, 0xc6, 0x06, 0xf2, 0xb6, 0xe7, 0xf1, 0x4f, 0xe4, 0x6b, 0xbe, 0xd3, 0xdc, 0xbf, 0x32,
+	0x83, 0x54, 0x4d, 0x83, 0xb8, 0x50, 0x27, 0x17, 0xa3, 0x30, 0x21, 0x32, 0x02, 0xd7, 0x3c, 0x0d,
+	0x22, 0x04, 0xf0, 0x38, 0x57, 0xad, 0xbc, 0xf7, 0x30, 0x86, 0xd5, 0x93, 0xb0, 0x17, 0x3d, 0x21,
+	0x94, 0xe2, 0x1e, 0x79, 0x25, 0x2b, 0x94, 0xde, 0x51, 0x38, 0xad, 0xa1, 0x24, 0xae, 0x4e, 0x99,
+	0x06, 0xd1, 0xd7, 0xb0, 0xc6, 0x59, 0x77, 0xf8, 0xa5, 0x4d, 0x54, 0x1d, 0xaf, 0x9f, 0xf9, 0x16,
+	0x34, 0x98, 0x26, 0x2f, 0xd8, 0x37, 0xbc, 0x1c, 0x81, 0x3a, 0xb0, 0xcc, 0x05, 0xc0, 0x2c, 0x4d,
+	0xa6, 0xbc, 0x07, 0x37, 0xa8, 0x5e, 0xa7, 0x82, 0x76, 0x8e, 0x40, 0x5f, 0x41, 0xf3, 0x80, 0xf8,
+	0xc9, 0x78, 0xc4, 0xde, 0x80, 0x42, 0xb7, 0x01, 0xfc, 0x70, 0xd4, 0x27, 0x49, 0x87, 0x5c, 0x30,
+	0x65, 0x50, 0x03, 0x83, 0xbc, 0x8c, 0xf9, 0x94, 0x0a, 0x8d, 0x06, 0x38, 0x8c, 0x04, 0x51, 0xa5,
+	0x50, 0x86, 0x40, 0x7f, 0xae, 0xc0, 0x8d, 0xe3, 0x34, 0xf1, 0xfb, 0x98, 0x92, 0xe0, 0x89, 0x0a,
+	0x91, 0x6f, 0x40, 0xb7, 0xf2, 0xa4, 0x38, 0x73, 0x59, 0x52, 0x44, 0x17, 0x25, 0x62, 0x4d, 0xd9,
+	0x3c, 0xcb, 0xa9, 0x2a, 0x19, 0x0d, 0x0c, 0xd7, 0x8d, 0x5f, 0x51, 0x49, 0xc4, 0x54, 0xd9, 0xa0,
+	0x41, 0xd4, 0x83, 0x35, 0x59, 0x07, 0x5e, 0xf7, 0xd8, 0x18, 0x87, 0xa0, 0x6a, 0x1d, 0x02, 0xdb,
+	0x97, 0x6a, 0x45, 0x5f, 0x1a, 0xc2, 0x86, 0x64, 0x74, 0xfd, 0x43, 0x62, 0xb9, 0x7c, 0xb5, 0xe0,
+	0xf2, 0x2f, 0x60, 0xd7, 0xd1, 0xec, 0x5e, 0xea, 0x58, 0x6c, 0xc2, 0x1c, 0x27, 0x95, 0xe5, 0x22,
+	0x05, 0xa1, 0xbf, 0x56, 0xa1, 0xa9, 0x6e, 0x14, 0x4a, 0xfa, 0xf7, 0xa1, 0xce, 0x64, 0x16, 0x72,
+	0x2b, 0xc6, 0xa5, 0x52, 0x67, 0x26, 0x4f, 0x8f, 0xf2, 0x94, 0x93, 0xdf, 0x2e, 0x54, 0xca, 0xc9,
+	0x6e, 0x18, 0xd9, 0x56, 0x1d, 0x28, 0x4d, 0x0c, 0x0c, 0x4f, 0x13, 0x22, 0xf1, 0x4b, 0x90, 0xba,
+	0x33, 0x22, 0x28, 0x5b, 0xb8, 0xac, 0x58, 0xf8, 0x2c, 0x1d, 0x8a, 0xe4, 0x34, 0xeb, 0x65, 0x30,
+	0x37, 0x54, 0x40, 0x18, 0x0e, 0x07, 0xf4, 0xe8, 0x40, 0xf5, 0xdb, 0x72, 0xc4, 0xe4, 0xfd, 0xa5,
+	0x5e, 0x72, 0x7f, 0x91, 0x32, 0x84, 0x7e, 0x31, 0x55, 0x99, 0x38, 0xe4, 0x65, 0xb6, 0xf1, 0xf2,
+	0xb3, 0x78, 0xf9, 0x75, 0xeb, 0x3a, 0x4d, 0x71, 0x74, 0x01, 0xcd, 0xe3, 0x84, 0x8c, 0x70, 0x42,
+	0xa6, 0xb5, 0xf7, 0xd5, 0xc5, 0xd5, 0x36, 0x2c, 0x50, 0x86, 0x33, 0x9d, 0x55, 0xd3, 0xcd, 0x40,
+	0xa1, 0x87, 0x30, 0xe7, 0x65, 0x8d, 0x5c, 0x9a, 0xfa, 0xbe, 0x76, 0xd0, 0x79, 0x4f, 0x83, 0xdc,
+	0x4d, 0x48, 0x92, 0x3c, 0xa1, 0x3d, 0xed, 0x26, 0x12, 0x42, 0x3f, 0x86, 0x85, 0xc3, 0x24, 0x89,
+	0x93, 0x03, 0x61, 0x65, 0x5e, 0x9d, 0x9c, 0x85, 0x91, 0x36, 0x81, 0xf8, 0x2e, 0x1e, 0xa3, 0x46,
+	0x9e, 0x4b, 0x96, 0x60, 0xf1, 0x84, 0x61, 0x96, 0xaa, 0x9e, 0x1b, 0x7a, 0x06, 0x2b, 0x07, 0x44,
+	0x94, 0x42, 0x91, 0x3f, 0x96, 0x23, 0x59, 0xcf, 0xab, 0x62, 0xf4, 0xbc, 0x5c, 0xa8, 0xf7, 0x09,
+	0x1e, 0xb0, 0xfe, 0x58, 0xd5, 0x66, 0x1a, 0xe4, 0x31, 0x8a, 0x70, 0x71, 0x74, 0x8c, 0x12, 0x00,
+	0xfa, 0x09, 0xac, 0x88, 0x7a, 0xec, 0x24, 0xed, 0x52, 0x3f, 0x09, 0xbb, 0x24, 0x11, 0x89, 0x95,
+	0x70, 0x9c, 0x4e, 0xac, 0x44, 0x17, 0x6c, 0xa2, 0xfb, 0xad, 0xeb, 0x68, 0x01, 0xa0, 0xef, 0x2a,
+	0xb0, 0xb0, 0x8f, 0xfd, 0x3e, 0xc9, 0x65, 0xea, 0x87, 0x8c, 0xea, 0xbe, 0x1d, 0xff, 0xe6, 0x06,
+	0x1a, 0x86, 0x94, 0x12, 0x2a, 0xbd, 0xde, 0x53, 0x10, 0xdf, 0x1c, 0x72, 0x1e, 0xfa, 0xba, 0xc8,
+	0xe0, 0x43, 0x39, 0x82, 0x6f, 0x8e, 0x1f, 0x27, 0x49, 0x3a, 0x92, 0xe3, 0xb2, 0xd6, 0x30, 0x51,
+	0xc2, 0x1d, 0x31, 0xa5, 0x9d, 0x7e, 0x12, 0xa7, 0xbd, 0x3e, 0x95, 0xf5, 0x98, 0x67, 0xe1, 0x44,
+	0x31, 0x11, 0xb1, 0x24, 0x24, 0x54, 0x35, 0xf2, 0x34, 0xc8, 0x25, 0xa5, 0xe1, 0x6f, 0x88, 0xf0,
+	0xf4, 0x9a, 0x27, 0xbe, 0xc5, 0x7e, 0xe0, 0x8b, 0x13, 0x8e, 0x9e, 0x17, 0x68, 0x0d, 0xa2, 0xef,
+	0xaa, 0x7a, 0x43, 0xa6, 0x2c, 0x70, 0x44, 0x3f, 0x48, 0xd7, 0xc9, 0x02, 0x70, 0x3e, 0x82, 0xc5,
+	0x40, 0xef, 0x65, 0x48, 0x64, 0x7d, 0x95, 0xb5, 0xef, 0x0a, 0x9b, 0xec, 0x59, 0x53, 0xb9, 0xca,
+	0xd4, 0xc7, 0x51, 0x44, 0x82, 0x3d, 0x5e, 0x6c, 0xa9, 0x9e, 0xa6, 0x85, 0xe3, 0x66, 0xed, 0x13,
+	0xac, 0x26, 0xcc, 0x8a, 0x09, 0x39, 0x82, 0x53, 0xf8, 0x75, 0x4a, 0xd2, 0xac, 0xd3, 0x2c, 0xad,
+	0x62, 0xe1, 0x9c, 0x1f, 0xc2, 0x02, 0xcd, 0xfd, 0xc1, 0xad, 0x1b, 0xf2, 0x15, 0x9d, 0xc5, 0x33,
+	0x67, 0x8a, 0x43, 0xc2, 0x12, 0x82, 0x87, 0x54, 0xd8, 0xaf, 0xe9, 0x69, 0xd0, 0x79, 0x0f, 0x66,
+	0x7d, 0xee, 0x26, 0x6e, 0xc3, 0x68, 0x0c, 0x1b, 0x8e, 0xe3, 0xc9, 0x61, 0xf4, 0x08, 0x1a, 0x7b,
+	0xe9, 0x78, 0xda, 0x63, 0xce, 0xbb, 0xc5, 0x17, 0xea, 0x84, 0xf3, 0x6e, 0xf1, 0xc5, 0x51, 0x80,
+	0x9e, 0xc0, 0xd2, 0x3e, 0x6f, 0xd3, 0x0f, 0x3a, 0x17, 0xaf, 0x83, 0xdc, 0x1f, 0x2a, 0xb0, 0xe6,
+	0x91, 0xc3, 0x48, 0xd4, 0x22, 0x46, 0xe2, 0x7a, 0x15, 0xa2, 0xce, 0x0f, 0x60, 0x83, 0x44, 0x7e,
+	0x1c, 0xc8, 0x64, 0xf6, 0x8b, 0x90, 0xf5, 0xad, 0x6b, 0x7d, 0xf9, 0x20, 0x3a, 0x85, 0x55, 0x8e,
+	0xd9, 0x8f, 0xa3, 0xd3, 0x30, 0x19, 0xbe, 0x0e, 0x39, 0x78, 0xfd, 0x92, 0xa4, 0xac, 0xaf, 0x42,
+	0xa0, 0x04, 0xd0, 0xdf, 0xf8, 0xcd, 0x56, 0x5c, 0xda, 0x88, 0x7e, 0x32, 0x9a, 0x96, 0x19, 0x8f,
+	0xb0, 0xf2, 0x02, 0xc7, 0x9b, 0xe2, 0xfa, 0xc9, 0xca, 0x40, 0x5d, 0x71, 0x09, 0x2b, 0x5e, 0x8c,
+	0x66, 0x4a, 0x1e, 0x89, 0xbe, 0x02, 0xe0, 0x7d, 0x9f, 0xd7, 0x64, 0x03, 0x79, 0xa7, 0xad, 0x5d,
+	0xd6, 0x0f, 0x9a, 0xb1, 0xfb, 0x41, 0x68, 0x17, 0x36, 0x8b, 0xdd, 0xb1, 0x29, 0x05, 0x41, 0xbf,
+	0xab, 0xc0, 0xfa, 0x7e, 0x42, 0x82, 0x90, 0xbd, 0x24, 0x85, 0xcb, 0x54, 0x99, 0x6c, 0x6e, 0xf0,
+	0x30, 0xec, 0x0b, 0x56, 0xaa, 0xbd, 0xa2, 0x20, 0xde, 0xcb, 0x5b, 0xd3, 0xfb, 0xdb, 0xe1, 0x85,
+	0xeb, 0xb4, 0x22, 0xc8, 0xd7, 0xc0, 0xea, 0xe4, 0x6b, 0xe0, 0x4b, 0x6d, 0xe9, 0x09, 0x38, 0x42,
+	0x0a, 0xfb, 0xe5, 0xef, 0xda, 0xc2, 0x64, 0x7d, 0x85, 0xaa, 0xf9, 0x44, 0xf8, 0xb5, 0x4d, 0xd4,
+	0x9b, 0x78, 0xff, 0xab, 0x4c, 0xbe, 0xff, 0x5d, 0xeb, 0xbd, 0xfe, 0xba, 0x8f, 0x84, 0x3f, 0x85,
+	0x66, 0x16, 0x35, 0x5f, 0xf0, 0x4c, 0x98, 0xa5, 0x5f, 0xf9, 0xfc, 0x2d, 0x81, 0x07, 0xdf, 0xde,
+	0x84, 0xe6, 0x9e, 0xf8, 0x6b, 0xe1, 0x84, 0x24, 0xe7, 0xbc, 0x62, 0xfc, 0x1c, 0x96, 0x32, 0x92,
+	0xea, 0xd1, 0x4c, 0x48, 0x68, 0xf1, 0x69, 0x99, 0x52, 0xa3, 0xff, 0xff, 0xfd, 0x7f, 0xfe, 0xfb,
+	0xa7, 0xea, 0xdb, 0xa8, 0x75, 0xff, 0xfc, 0xc3, 0xfb, 0xf2, 0x1f, 0x88, 0xfb, 0x59, 0xfc, 0x6e,
+	0x0b, 0x46, 0x0f, 0x2b, 0x77, 0x9d, 0x5f, 0xc2, 0xca, 0xd3, 0x68, 0x5a, 0xda, 0xef, 0x0b, 0xda,
+	0xef, 0xa0, 0x2d, 0x83, 0x76, 0x1a, 0x95, 0x50, 0xff, 0x0c, 0xc0, 0x23, 0xfe, 0xb9, 0xca, 0x35,
+	0xcb, 0x32, 0x13, 0x64, 0xef, 0xa7, 0x2d, 0xc8, 0xf3, 0x0c, 0x7a, 0x47, 0xd0, 0xbc, 0x85, 0x36,
+	0x0d, 0x9a, 0x09, 0xf1, 0xcf, 0x25, 0x31, 0xfa, 0xb0, 0x72, 0xf7, 0x83, 0x8a, 0x73, 0x0c, 0x8d,
+	0xec, 0x85, 0xd2, 0x59, 0x97, 0x6f, 0x06, 0xf6, 0x8b, 0xa5, 0x2d, 0xe8, 0xb6, 0x20, 0xda, 0x7a,
+	0x58, 0xb9, 0x8b, 0x36, 0x0c, 0xba, 0xd8, 0x3f, 0x53, 0x64, 0x9d, 0x63, 0xa8, 0xeb, 0xe7, 0x32,
+	0xa9, 0xb6, 0x55, 0xed, 0xb7, 0x2c, 0x9c, 0x22, 0xfa, 0x96, 0x20, 0x7a, 0x03, 0x39, 0x06, 0x45,
+	0x55, 0x68, 0x72, 0x9d, 0x9f, 0xc2, 0xa2, 0xaa, 0x60, 0x3b, 0xf1, 0x5e, 0x3a, 0xd6, 0x64, 0xcd,
+	0xa2, 0xd6, 0x16, 0xf2, 0x8e, 0xa0, 0x77, 0x1b, 0xdd, 0x34, 0xe9, 0xc9, 0xe9, 0x6d, 0x16, 0xb7,
+	0xbb, 0xe9, 0x98, 0x93, 0xfd, 0x18, 0xea, 0x7b, 0xe9, 0x58, 0x5c, 0x84, 0x96, 0xf4, 0x33, 0x56,
+	0x19, 0xb5, 0xdb, 0x82, 0x9a, 0x8b, 0xd6, 0x0c, 0x6a, 0xdd, 0x74, 0xdc, 0x0e, 0x30, 0xc3, 0x9c,
+	0xce, 0x17, 0xb0, 0xaa, 0x72, 0x65, 0xde, 0xe6, 0x73, 0xd6, 0x54, 0x8e, 0x36, 0x73, 0xa8, 0x4d,
+	0x76, 0x47, 0x90, 0x45, 0xe8, 0x2d, 0x83, 0xac, 0x2f, 0xe6, 0xb7, 0x8d, 0x7e, 0x21, 0x67, 0x70,
+	0x66, 0x24, 0xcf, 0x27, 0xc6, 0x8d, 0x54, 0x51, 0x9b, 0x48, 0xab, 0x36, 0x9f, 0xb6, 0xe0, 0xf3,
+	0x3e, 0x42, 0x96, 0x1b, 0xb4, 0x89, 0x5c, 0xd5, 0xe6, 0xb7, 0x24, 0xa1, 0x4a, 0x3b, 0x0c, 0x38,
+	0x33, 0x0c, 0x2b, 0x2a, 0x37, 0x72, 0x82, 0x1d, 0x9e, 0xcb, 0x9c, 0xcd, 0xec, 0x71, 0xd4, 0x4a,
+	0x9b, 0xd7, 0xd0, 0x47, 0x4e, 0x97, 0xf4, 0x45, 0x5e, 0xe4, 0x2c, 0xba, 0xb0, 0x5c, 0xc8, 0x8c,
+	0x4e, 0xcb, 0x78, 0xad, 0x2a, 0xe4, 0x4b, 0x9b, 0xcb, 0x7b, 0x82, 0xcb, 0x36, 0xba, 0x65, 0x3a,
+	0x9f, 0x5c, 0x26, 0xcd, 0x76, 0x4a, 0x12, 0xce, 0xe3, 0x67, 0x30, 0xc3, 0x73, 0x9b, 0x3a, 0x21,
+	0x79, 0x9a, 0xb3, 0xa9, 0xb5, 0x04, 0xb5, 0x75, 0xb4, 0x6c, 0x50, 0xe3, 0x8f, 0x9c, 0x9c, 0xc2,
+	0x97, 0xe0, 0xe8, 0x04, 0xb5, 0x4b, 0xb3, 0xa7, 0xd6, 0x5b, 0xa5, 0x4f, 0xb3, 0x65, 0xb4, 0xef,
+	0x0a, 0xda, 0x77, 0xd0, 0xdb, 0x96, 0xdd, 0xe5, 0xba, 0x36, 0xa6, 0x6d, 0xdd, 0x03, 0xe7, 0xbc,
+	0x4e, 0x61, 0x55, 0x26, 0x32, 0xda, 0x89, 0x33, 0x56, 0xf2, 0x61, 0xb2, 0x2c, 0xc1, 0xd9, 0x8c,
+	0xbe, 0x27, 0x18, 0xbd, 0x8b, 0x6e, 0x9b, 0x86, 0x97, 0xd4, 0xb8, 0xb7, 0x9b, 0x7c, 0x7e, 0x05,
+	0x4b, 0x56, 0xb2, 0xa2, 0xca, 0x89, 0x4a, 0x32, 0xd8, 0x8b, 0x63, 0x9f, 0x36, 0x77, 0x5b, 0x34,
+	0x6c, 0x78, 0x3c, 0x71, 0x06, 0xb0, 0xfc, 0x09, 0x61, 0x66, 0xb2, 0x70, 0x6e, 0x48, 0x06, 0x13,
+	0x49, 0xa9, 0x35, 0x39, 0x70, 0x45, 0x2c, 0xec, 0x11, 0x26, 0xd9, 0xb4, 0x55, 0x6a, 0xe0, 0xdc,
+	0x7c, 0x68, 0x5a, 0xff, 0x95, 0x29, 0x65, 0x4a, 0xfe, 0x35, 0x53, 0x51, 0xc7, 0xfa, 0x05, 0xa9,
+	0x34, 0x4a, 0xf8, 0x62, 0x6d, 0x5b, 0xfd, 0x34, 0xc5, 0x99, 0x1c, 0xc3, 0xe2, 0x6e, 0xca, 0xfa,
+	0x24, 0x62, 0xa1, 0x8f, 0x19, 0x99, 0x0c, 0xb9, 0x96, 0x9d, 0x90, 0xa0, 0xb9, 0x85, 0x6e, 0x98,
+	0xee, 0x69, 0x2c, 0xe7, 0x14, 0x9f, 0xc1, 0x82, 0xf1, 0x13, 0x91, 0x3a, 0x5c, 0x13, 0xbf, 0x15,
+	0xbd, 0x98, 0x6e, 0x66, 0x7f, 0x22, 0x8f, 0x55, 0x00, 0xcd, 0x4f, 0x08, 0xcb, 0x7f, 0xe6, 0x71,
+	0xd4, 0xa5, 0xa3, 0xf0, 0x1f, 0x50, 0xab, 0x88, 0xbe, 0x62, 0x8b, 0xb9, 0xd9, 0x09, 0xeb, 0x9b,
+	0x46, 0x0f, 0x60, 0xd1, 0xfc, 0x95, 0x4d, 0xed, 0xef, 0xe4, 0xdf, 0x6d, 0xad, 0x4d, 0xd3, 0xe4,
+	0xf9, 0xaf, 0x6c, 0xe8, 0x5d, 0xc1, 0xe7, 0x2d, 0x9e, 0x41, 0x5c, 0x83, 0xd5, 0x20, 0xa4, 0x4c,
+	0xdb, 0x9d, 0x3a, 0x21, 0x34, 0xad, 0xbf, 0xc6, 0xd4, 0xd6, 0x96, 0xfc, 0xbe, 0xd6, 0x2a, 0x19,
+	0xb9, 0x62, 0x83, 0xe5, 0xdf, 0x69, 0xe6, 0x06, 0x7f, 0x01, 0x4b, 0xf6, 0xaf, 0x64, 0xfa, 0xe0,
+	0x95, 0xfc, 0x5f, 0x56, 0x7a, 0x28, 0xb8, 0x26, 0xa6, 0xd1, 0x7c, 0xb1, 0xb0, 0x9d, 0xf5, 0x37,
+	0x4f, 0x78, 0x17, 0x76, 0x40, 0x72, 0x37, 0xbd, 0xda, 0x85, 0xca, 0xa4, 0x0e, 0xc4, 0x7a, 0x53,
+	0xea, 0xc7, 0x30, 0x27, 0x9f, 0x0c, 0x9c, 0x55, 0xb1, 0xd8, 0x7c, 0xb7, 0x68, 0x99, 0x28, 0x45,
+	0x75, 0x4b, 0x50, 0xdd, 0x44, 0xab, 0x56, 0x81, 0xc1, 0x27, 0xa8, 0x68, 0xc9, 0xdf, 0x06, 0x94,
+	0x64, 0xf9, 0x33, 0xc1, 0x8b, 0xa3, 0xa5, 0xa6, 0xf0, 0x05, 0x2c, 0x18, 0x2f, 0x07, 0xca, 0xa9,
+	0x27, 0xde, 0x12, 0x5a, 0xeb, 0x19, 0xde, 0x68, 0x28, 0x6a, 0xef, 0xe6, 0x86, 0x34, 0x1d, 0x9c,
+	0x77, 0x0c, 0xdb, 0xba, 0x35, 0x7a, 0x0a, 0x4d, 0xeb, 0x7d, 0x40, 0x79, 0x44, 0xc9, 0x9b, 0xc1,
+	0x25, 0x4c, 0x4a, 0xcb, 0x37, 0xce, 0x41, 0xf4, 0x43, 0xb3, 0x6c, 0x7e, 0x06, 0x4d, 0xab, 0x9b,
+	0xab, 0xf8, 0x94, 0x74, 0x78, 0x5b, 0x2d, 0x63, 0xa4, 0xc8, 0xad, 0x6c, 0x17, 0x45, 0x24, 0x1e,
+	0x6b, 0x8d, 0x38, 0xb3, 0x04, 0x96, 0x0b, 0x1d, 0x5d, 0xc7, 0x24, 0x5a, 0x54, 0xec, 0x2a, 0x86,
+	0x65, 0x51, 0x53, 0x31, 0xb4, 0x15, 0xf4, 0xa1, 0x69, 0xfd, 0x06, 0xab, 0x14, 0x2c, 0xf9, 0x35,
+	0xf6, 0xda, 0x51, 0x33, 0x1c, 0x16, 0x0f, 0xd5, 0x31, 0xd4, 0xd5, 0xcb, 0x83, 0xaa, 0xd6, 0xac,
+	0x47, 0x90, 0x96, 0x85, 0xbb, 0xa2, 0x08, 0x0c, 0xe4, 0x0c, 0x4e, 0x91, 0x5f, 0xf6, 0x3e, 0x21,
+	0x6c, 0xa2, 0xc7, 0xef, 0x6c, 0xa9, 0x82, 0xb2, 0xf4, 0x49, 0xa2, 0x75, 0xc9, 0xe8, 0x15, 0xb5,
+	0x11, 0x8f, 0x79, 0x23, 0x3d, 0x3f, 0x2f, 0x8f, 0xb8, 0x0c, 0x47, 0x30, 0xa7, 0x3a, 0x75, 0xf2,
+	0x84, 0x99, 0x4d, 0xc6, 0x96, 0x89, 0x52, 0xe4, 0x6f, 0x0a, 0xf2, 0x6b, 0x8e, 0x79, 0xe8, 0xa8,
+	0x98, 0xd0, 0x9d, 0x13, 0xbf, 0x46, 0x7f, 0xff, 0x7f, 0x03, 0x00, 0x5c, 0xe1, 0xac, 0x89, 0x4d,
+	0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1406,8 +4174,11 @@ type BinaryServiceClient interface {
 	SubscribeEvent(ctx context.Context, in *SubscribeInfo, opts ...grpc.CallOption) (*Result, error)
 	//unsubscribe event
 	UnSubscribeEvent(ctx context.Context, in *SubscribeInfo, opts ...grpc.CallOption) (*Result, error)
-	//receive events by creating a server stream channel
+	//receive events by creating a server stream channel, events after the cursor of ClientInfo are replayed first
 	RecvEvents(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (BinaryService_RecvEventsClient, error)
+	//acknowledge the events of address up to the cursor, they are no longer replayed, token is of a session of address
+	//unlocked for ackEvents
+	AckEvents(ctx context.Context, in *AckEventsParams, opts ...grpc.CallOption) (*Result, error)
 	//publish
 	Publish(ctx context.Context, in *PublishParams, opts ...grpc.CallOption) (*PublishResult, error)
 	//prepare to buy
@@ -1440,6 +4211,34 @@ type BinaryServiceClient interface {
 	TransferEth(ctx context.Context, in *TransferEthParams, opts ...grpc.CallOption) (*Result, error)
 	//get eth balance
 	GetEthBalance(ctx context.Context, in *EthBalanceParams, opts ...grpc.CallOption) (*EthBalanceResult, error)
+	//list accounts of all signers, token is of any session unlocked for listAccounts
+	ListAccounts(ctx context.Context, in *ListAccountsParams, opts ...grpc.CallOption) (*AccountListResult, error)
+	//export keystore of account
+	ExportAccount(ctx context.Context, in *ExportAccountParams, opts ...grpc.CallOption) (*ExportAccountResult, error)
+	//change password of account
+	ChangePassword(ctx context.Context, in *ChangePasswordParams, opts ...grpc.CallOption) (*Result, error)
+	//delete account
+	DeleteAccount(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*Result, error)
+	//unlock account for operations, the returned token is used instead of password in TxParams
+	Unlock(ctx context.Context, in *UnlockParams, opts ...grpc.CallOption) (*UnlockResult, error)
+	//lock the session of token
+	Lock(ctx context.Context, in *LockParams, opts ...grpc.CallOption) (*Result, error)
+	//sign an EIP-191 personal message
+	SignMessage(ctx context.Context, in *SignMessageParams, opts ...grpc.CallOption) (*SignatureResult, error)
+	//sign EIP-712 typed data
+	SignTypedData(ctx context.Context, in *SignTypedDataParams, opts ...grpc.CallOption) (*SignatureResult, error)
+	//recover the signer of an EIP-191 personal message
+	VerifyMessage(ctx context.Context, in *VerifyMessageParams, opts ...grpc.CallOption) (*VerifySignatureResult, error)
+	//recover the signer of EIP-712 typed data
+	VerifyTypedData(ctx context.Context, in *VerifyTypedDataParams, opts ...grpc.CallOption) (*VerifySignatureResult, error)
+	//import an account from the content of its keystore file, it is stored with password
+	ImportAccount(ctx context.Context, in *ImportAccountParams, opts ...grpc.CallOption) (*AccountResult, error)
+	//decrypt a cipher text of address, or its part of cipher texts addressed to several recipients
+	Decrypt(ctx context.Context, in *DecryptParams, opts ...grpc.CallOption) (*DecryptResult, error)
+	//decrypt the meta data id of ReadyForDownload, then fetch, verify and decrypt the meta data
+	GetPurchasedMetaData(ctx context.Context, in *PurchasedMetaDataParams, opts ...grpc.CallOption) (*PurchasedMetaDataResult, error)
+	//health of the dependencies, progress of the event listener and counts of subscribers
+	Status(ctx context.Context, in *StatusParams, opts ...grpc.CallOption) (*StatusResult, error)
 }
 
 type binaryServiceClient struct {
@@ -1500,6 +4299,15 @@ func (x *binaryServiceRecvEventsClient) Recv() (*Event, error) {
 	return m, nil
 }
 
+func (c *binaryServiceClient) AckEvents(ctx context.Context, in *AckEventsParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/AckEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *binaryServiceClient) Publish(ctx context.Context, in *PublishParams, opts ...grpc.CallOption) (*PublishResult, error) {
 	out := new(PublishResult)
 	err := c.cc.Invoke(ctx, "/api.BinaryService/Publish", in, out, opts...)
@@ -1545,99 +4353,225 @@ func (c *binaryServiceClient) ReEncryptMetaDataId(ctx context.Context, in *ReEnc
 	return out, nil
 }
 
-func (c *binaryServiceClient) ConfirmDataTruth(ctx context.Context, in *DataConfirmParams, opts ...grpc.CallOption) (*Result, error) {
+func (c *binaryServiceClient) ConfirmDataTruth(ctx context.Context, in *DataConfirmParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/ConfirmDataTruth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) ApproveTransfer(ctx context.Context, in *ApproveTransferParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/ApproveTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) Vote(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/Vote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) RegisterAsVerifier(ctx context.Context, in *RegisterVerifierParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/RegisterAsVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) CreditsToVerifier(ctx context.Context, in *CreditVerifierParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/CreditsToVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) TransferTokens(ctx context.Context, in *TransferTokenParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/TransferTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) GetTokenBalance(ctx context.Context, in *TokenBalanceParams, opts ...grpc.CallOption) (*TokenBalanceResult, error) {
+	out := new(TokenBalanceResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/GetTokenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) CreateAccount(ctx context.Context, in *CreateAccountParams, opts ...grpc.CallOption) (*AccountResult, error) {
+	out := new(AccountResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/CreateAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) Authenticate(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/Authenticate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) TransferEth(ctx context.Context, in *TransferEthParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/TransferEth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) GetEthBalance(ctx context.Context, in *EthBalanceParams, opts ...grpc.CallOption) (*EthBalanceResult, error) {
+	out := new(EthBalanceResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/GetEthBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) ListAccounts(ctx context.Context, in *ListAccountsParams, opts ...grpc.CallOption) (*AccountListResult, error) {
+	out := new(AccountListResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/ListAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) ExportAccount(ctx context.Context, in *ExportAccountParams, opts ...grpc.CallOption) (*ExportAccountResult, error) {
+	out := new(ExportAccountResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/ExportAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordParams, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryServiceClient) DeleteAccount(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*Result, error) {
 	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/ConfirmDataTruth", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/DeleteAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) ApproveTransfer(ctx context.Context, in *ApproveTransferParams, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/ApproveTransfer", in, out, opts...)
+func (c *binaryServiceClient) Unlock(ctx context.Context, in *UnlockParams, opts ...grpc.CallOption) (*UnlockResult, error) {
+	out := new(UnlockResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/Unlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) Vote(ctx context.Context, in *VoteParams, opts ...grpc.CallOption) (*Result, error) {
+func (c *binaryServiceClient) Lock(ctx context.Context, in *LockParams, opts ...grpc.CallOption) (*Result, error) {
 	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/Vote", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/Lock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) RegisterAsVerifier(ctx context.Context, in *RegisterVerifierParams, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/RegisterAsVerifier", in, out, opts...)
+func (c *binaryServiceClient) SignMessage(ctx context.Context, in *SignMessageParams, opts ...grpc.CallOption) (*SignatureResult, error) {
+	out := new(SignatureResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/SignMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) CreditsToVerifier(ctx context.Context, in *CreditVerifierParams, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/CreditsToVerifier", in, out, opts...)
+func (c *binaryServiceClient) SignTypedData(ctx context.Context, in *SignTypedDataParams, opts ...grpc.CallOption) (*SignatureResult, error) {
+	out := new(SignatureResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/SignTypedData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) TransferTokens(ctx context.Context, in *TransferTokenParams, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/TransferTokens", in, out, opts...)
+func (c *binaryServiceClient) VerifyMessage(ctx context.Context, in *VerifyMessageParams, opts ...grpc.CallOption) (*VerifySignatureResult, error) {
+	out := new(VerifySignatureResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/VerifyMessage", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) GetTokenBalance(ctx context.Context, in *TokenBalanceParams, opts ...grpc.CallOption) (*TokenBalanceResult, error) {
-	out := new(TokenBalanceResult)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/GetTokenBalance", in, out, opts...)
+func (c *binaryServiceClient) VerifyTypedData(ctx context.Context, in *VerifyTypedDataParams, opts ...grpc.CallOption) (*VerifySignatureResult, error) {
+	out := new(VerifySignatureResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/VerifyTypedData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) CreateAccount(ctx context.Context, in *CreateAccountParams, opts ...grpc.CallOption) (*AccountResult, error) {
+func (c *binaryServiceClient) ImportAccount(ctx context.Context, in *ImportAccountParams, opts ...grpc.CallOption) (*AccountResult, error) {
 	out := new(AccountResult)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/CreateAccount", in, out, opts...)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/ImportAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) Authenticate(ctx context.Context, in *ClientInfo, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/Authenticate", in, out, opts...)
+func (c *binaryServiceClient) Decrypt(ctx context.Context, in *DecryptParams, opts ...grpc.CallOption) (*DecryptResult, error) {
+	out := new(DecryptResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/Decrypt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) TransferEth(ctx context.Context, in *TransferEthParams, opts ...grpc.CallOption) (*Result, error) {
-	out := new(Result)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/TransferEth", in, out, opts...)
+func (c *binaryServiceClient) GetPurchasedMetaData(ctx context.Context, in *PurchasedMetaDataParams, opts ...grpc.CallOption) (*PurchasedMetaDataResult, error) {
+	out := new(PurchasedMetaDataResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/GetPurchasedMetaData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *binaryServiceClient) GetEthBalance(ctx context.Context, in *EthBalanceParams, opts ...grpc.CallOption) (*EthBalanceResult, error) {
-	out := new(EthBalanceResult)
-	err := c.cc.Invoke(ctx, "/api.BinaryService/GetEthBalance", in, out, opts...)
+func (c *binaryServiceClient) Status(ctx context.Context, in *StatusParams, opts ...grpc.CallOption) (*StatusResult, error) {
+	out := new(StatusResult)
+	err := c.cc.Invoke(ctx, "/api.BinaryService/Status", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1650,8 +4584,11 @@ type BinaryServiceServer interface {
 	SubscribeEvent(context.Context, *SubscribeInfo) (*Result, error)
 	//unsubscribe event
 	UnSubscribeEvent(context.Context, *SubscribeInfo) (*Result, error)
-	//receive events by creating a server stream channel
+	//receive events by creating a server stream channel, events after the cursor of ClientInfo are replayed first
 	RecvEvents(*ClientInfo, BinaryService_RecvEventsServer) error
+	//acknowledge the events of address up to the cursor, they are no longer replayed, token is of a session of address
+	//unlocked for ackEvents
+	AckEvents(context.Context, *AckEventsParams) (*Result, error)
 	//publish
 	Publish(context.Context, *PublishParams) (*PublishResult, error)
 	//prepare to buy
@@ -1684,6 +4621,34 @@ type BinaryServiceServer interface {
 	TransferEth(context.Context, *TransferEthParams) (*Result, error)
 	//get eth balance
 	GetEthBalance(context.Context, *EthBalanceParams) (*EthBalanceResult, error)
+	//list accounts of all signers, token is of any session unlocked for listAccounts
+	ListAccounts(context.Context, *ListAccountsParams) (*AccountListResult, error)
+	//export keystore of account
+	ExportAccount(context.Context, *ExportAccountParams) (*ExportAccountResult, error)
+	//change password of account
+	ChangePassword(context.Context, *ChangePasswordParams) (*Result, error)
+	//delete account
+	DeleteAccount(context.Context, *ClientInfo) (*Result, error)
+	//unlock account for operations, the returned token is used instead of password in TxParams
+	Unlock(context.Context, *UnlockParams) (*UnlockResult, error)
+	//lock the session of token
+	Lock(context.Context, *LockParams) (*Result, error)
+	//sign an EIP-191 personal message
+	SignMessage(context.Context, *SignMessageParams) (*SignatureResult, error)
+	//sign EIP-712 typed data
+	SignTypedData(context.Context, *SignTypedDataParams) (*SignatureResult, error)
+	//recover the signer of an EIP-191 personal message
+	VerifyMessage(context.Context, *VerifyMessageParams) (*VerifySignatureResult, error)
+	//recover the signer of EIP-712 typed data
+	VerifyTypedData(context.Context, *VerifyTypedDataParams) (*VerifySignatureResult, error)
+	//import an account from the content of its keystore file, it is stored with password
+	ImportAccount(context.Context, *ImportAccountParams) (*AccountResult, error)
+	//decrypt a cipher text of address, or its part of cipher texts addressed to several recipients
+	Decrypt(context.Context, *DecryptParams) (*DecryptResult, error)
+	//decrypt the meta data id of ReadyForDownload, then fetch, verify and decrypt the meta data
+	GetPurchasedMetaData(context.Context, *PurchasedMetaDataParams) (*PurchasedMetaDataResult, error)
+	//health of the dependencies, progress of the event listener and counts of subscribers
+	Status(context.Context, *StatusParams) (*StatusResult, error)
 }
 
 func RegisterBinaryServiceServer(s *grpc.Server, srv BinaryServiceServer) {
@@ -1747,6 +4712,24 @@ func (x *binaryServiceRecvEventsServer) Send(m *Event) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _BinaryService_AckEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEventsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).AckEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/AckEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).AckEvents(ctx, req.(*AckEventsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BinaryService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PublishParams)
 	if err := dec(in); err != nil {
@@ -2035,6 +5018,258 @@ func _BinaryService_GetEthBalance_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BinaryService_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountsParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).ListAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/ListAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).ListAccounts(ctx, req.(*ListAccountsParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_ExportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).ExportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/ExportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).ExportAccount(ctx, req.(*ExportAccountParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).ChangePassword(ctx, req.(*ChangePasswordParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).DeleteAccount(ctx, req.(*ClientInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).Unlock(ctx, req.(*UnlockParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).Lock(ctx, req.(*LockParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/SignMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).SignMessage(ctx, req.(*SignMessageParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_SignTypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignTypedDataParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).SignTypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/SignTypedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).SignTypedData(ctx, req.(*SignTypedDataParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/VerifyMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).VerifyMessage(ctx, req.(*VerifyMessageParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_VerifyTypedData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTypedDataParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).VerifyTypedData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/VerifyTypedData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).VerifyTypedData(ctx, req.(*VerifyTypedDataParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_ImportAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).ImportAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/ImportAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).ImportAccount(ctx, req.(*ImportAccountParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).Decrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/Decrypt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).Decrypt(ctx, req.(*DecryptParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_GetPurchasedMetaData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchasedMetaDataParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).GetPurchasedMetaData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/GetPurchasedMetaData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).GetPurchasedMetaData(ctx, req.(*PurchasedMetaDataParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryService_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatusParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryServiceServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.BinaryService/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryServiceServer).Status(ctx, req.(*StatusParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _BinaryService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.BinaryService",
 	HandlerType: (*BinaryServiceServer)(nil),
@@ -2047,6 +5282,10 @@ var _BinaryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnSubscribeEvent",
 			Handler:    _BinaryService_UnSubscribeEvent_Handler,
 		},
+		{
+			MethodName: "AckEvents",
+			Handler:    _BinaryService_AckEvents_Handler,
+		},
 		{
 			MethodName: "Publish",
 			Handler:    _BinaryService_Publish_Handler,
@@ -2111,6 +5350,62 @@ var _BinaryService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEthBalance",
 			Handler:    _BinaryService_GetEthBalance_Handler,
 		},
+		{
+			MethodName: "ListAccounts",
+			Handler:    _BinaryService_ListAccounts_Handler,
+		},
+		{
+			MethodName: "ExportAccount",
+			Handler:    _BinaryService_ExportAccount_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _BinaryService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _BinaryService_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _BinaryService_Unlock_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _BinaryService_Lock_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _BinaryService_SignMessage_Handler,
+		},
+		{
+			MethodName: "SignTypedData",
+			Handler:    _BinaryService_SignTypedData_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _BinaryService_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "VerifyTypedData",
+			Handler:    _BinaryService_VerifyTypedData_Handler,
+		},
+		{
+			MethodName: "ImportAccount",
+			Handler:    _BinaryService_ImportAccount_Handler,
+		},
+		{
+			MethodName: "Decrypt",
+			Handler:    _BinaryService_Decrypt_Handler,
+		},
+		{
+			MethodName: "GetPurchasedMetaData",
+			Handler:    _BinaryService_GetPurchasedMetaData_Handler,
+		},
+		{
+			MethodName: "Status",
+			Handler:    _BinaryService_Status_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
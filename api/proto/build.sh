@@ -3,8 +3,12 @@
 PRJ_PATH=`pwd`/../../
 PROTO_PATH="./"
 PROTO_FILE="*.proto"
+GATEWAY_PROTO_FILE="binary.proto" #the key service isn't served by the gateway
 GO_OUTPUT_FILEPATH="$PRJ_PATH/api/go"
 JS_OUTPUT_FILEPATH="$PRJ_PATH/api/js"
+OPENAPI_OUTPUT_FILEPATH="$PRJ_PATH/api/openapi"
 
 protoc --go_out=plugins=grpc:$GO_OUTPUT_FILEPATH $PROTO_FILE
+protoc --grpc-gateway_out=logtostderr=true:$GO_OUTPUT_FILEPATH $GATEWAY_PROTO_FILE
+protoc --swagger_out=logtostderr=true:$OPENAPI_OUTPUT_FILEPATH $GATEWAY_PROTO_FILE
 protoc --js_out="import_style=commonjs,binary:${JS_OUTPUT_FILEPATH}" --ts_out="service=true:${JS_OUTPUT_FILEPATH}" $PROTO_FILE
\ No newline at end of file
@@ -16,6 +16,7 @@ library common {
         uint256 price;
         bytes metaDataIdEncSeller;
         bytes32[] proofDataIds;
+        // slots of proofDataIds, a content ID other than CIDv0 takes several slots, decode the ids to count them
         uint256 numberOfProof;
         string descDataId;
         address seller;
@@ -5,12 +5,12 @@ package WSConnect
 
 import (
     "encoding/json"
-    "github.com/btcsuite/btcutil/base58"
     "github.com/ethereum/go-ethereum/common"
     "github.com/pkg/errors"
     "github.com/scryinfo/dp/demo/src/application/definition"
     "github.com/scryinfo/dp/demo/src/sdk/core/ethereum/events"
     "github.com/scryinfo/dp/demo/src/sdk/util/storage/ipfsaccess"
+    "github.com/scryinfo/dp/dots/storage"
     rlog "github.com/sirupsen/logrus"
     "io/ioutil"
     "math/big"
@@ -146,15 +146,18 @@ func getAndRenameProofFiles(ipfsIDs [][32]byte, extensions []string) ([]string,
             rlog.Error(er, "in callback: get and rename proof files failed. ")
         }
     }()
+    cids, err := storage.Bytes32ToCids(ipfsIDs)
+    if err != nil {
+        return nil, errors.Wrap(err, "Node - callback: decode proof IDs failed. ")
+    }
     var (
-        proofs                   = make([]string, len(ipfsIDs))
+        proofs                   = make([]string, len(cids))
         oldFileName, newFileName string
     )
-    if len(ipfsIDs) != len(extensions) {
+    if len(cids) != len(extensions) {
         return nil, errors.New("Invalid IPFS IDs or extensions. ")
     }
-    for i := 0; i < len(ipfsIDs); i++ {
-        ipfsID := ipfsBytes32ToHash(ipfsIDs[i])
+    for i, ipfsID := range cids {
         if err = ipfsaccess.GetIAInstance().GetFromIPFS(ipfsID); err != nil {
             return nil, errors.Wrap(err, "Node - callback: IPFS get failed. ")
         }
@@ -168,14 +171,6 @@ func getAndRenameProofFiles(ipfsIDs [][32]byte, extensions []string) ([]string,
 
     return proofs, nil
 }
-func ipfsBytes32ToHash(ipfsb [32]byte) string {
-    byte34 := make([]byte, 34)
-    // if ipfs change encrypt algorithm, byte will change together.
-    copy(byte34[:2], []byte{byte(18), byte(32)})
-    copy(byte34[2:], ipfsb[:])
-
-    return base58.Encode(byte34)
-}
 
 func onPurchase(event events.Event) bool {
     go func() {
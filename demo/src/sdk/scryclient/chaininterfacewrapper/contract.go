@@ -4,8 +4,6 @@
 package chaininterfacewrapper
 
 import (
-    "errors"
-    "github.com/btcsuite/btcutil/base58"
     "github.com/ethereum/go-ethereum/common"
     "github.com/ethereum/go-ethereum/core/types"
     "github.com/ethereum/go-ethereum/ethclient"
@@ -15,6 +13,7 @@ import (
     "github.com/scryinfo/dp/demo/src/sdk/util/accounts"
     "github.com/scryinfo/dp/demo/src/sdk/util/uuid"
     "github.com/scryinfo/dp/dots/service"
+    "github.com/scryinfo/dp/dots/storage"
     rlog "github.com/sirupsen/logrus"
     "math/big"
 )
@@ -55,14 +54,10 @@ func Publish(txParams *op.TransactParams, price *big.Int, metaDataID []byte, pro
     //generate publishId
     publishId := uuid.GenerateUUID()
 
-    pdIDs := make([][32]byte, proofNum)
-    var err error = nil
-    for i := 0; i < proofNum; i++ {
-        pdIDs[i], err = ipfsHashToBytes32(proofDataIDs[i])
-        if err != nil {
-            rlog.Error("failed to convert ipfs hash to bytes32")
-            return "", err
-        }
+    pdIDs, err := storage.CidsToBytes32(proofDataIDs[:proofNum])
+    if err != nil {
+        rlog.Error("failed to convert ipfs hash to bytes32")
+        return "", err
     }
 
     encMetaId, err := service.GetAMIns().Encrypt(metaDataID, txParams.From.String())
@@ -84,29 +79,6 @@ func Publish(txParams *op.TransactParams, price *big.Int, metaDataID []byte, pro
     return publishId, nil
 }
 
-func ipfsHashToBytes32(src string) ([32]byte, error) {
-    hashArray1 := base58.Decode(src)
-    if len(hashArray1) != 34 {
-        var nilArray [32]byte
-        return nilArray, errors.New("invalid ipfs hash")
-    }
-
-    var hashArray2 [32]byte
-    copy(hashArray2[:], hashArray1[2:])
-    return hashArray2, nil
-}
-
-func Bytes32ToIpfsHash(value [32]byte) (string, error) {
-    byteArray := [34]byte{18, 32}
-    copy(byteArray[2:], value[:])
-    if len(byteArray) != 34 {
-        return "", errors.New("invalid bytes32 value")
-    }
-
-    hash := base58.Encode(byteArray[:])
-    return hash, nil
-}
-
 func PrepareToBuy(txParams *op.TransactParams, publishId string, startVerify bool) error {
     defer func() {
         if err := recover(); err != nil {
@@ -0,0 +1,38 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+// Package amount converts amounts of the api, which are decimal strings, the int64 fields they replace
+// are used by clients and services which don't set the decimal ones yet.
+package amount
+
+import (
+    "github.com/scryinfo/dp/dots/errkind"
+    "math/big"
+)
+
+// Parse returns the amount in decimal, or the deprecated one if decimal is empty.
+func Parse(decimal string, deprecated int64) (*big.Int, error) {
+    if decimal == "" {
+        return big.NewInt(deprecated), nil
+    }
+
+    v, ok := new(big.Int).SetString(decimal, 10)
+    if !ok || v.Sign() < 0 {
+        return nil, errkind.New(errkind.InvalidArgument, "invalid amount '" + decimal + "'")
+    }
+
+    return v, nil
+}
+
+// Format returns v in decimal and as the deprecated int64, which is 0 if v overflows it.
+func Format(v *big.Int) (string, int64) {
+    if v == nil {
+        return "", 0
+    }
+
+    if v.IsInt64() {
+        return v.String(), v.Int64()
+    }
+
+    return v.String(), 0
+}
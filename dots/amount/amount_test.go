@@ -0,0 +1,39 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package amount
+
+import (
+    "math/big"
+    "testing"
+)
+
+func TestParse(t *testing.T) {
+    // 1000 tokens of 18 decimals
+    v, err := Parse("1000000000000000000000", 1)
+    if err != nil || v.String() != "1000000000000000000000" {
+        t.Fatal("wrong amount", v, err)
+    }
+
+    if v, err = Parse("", 42); err != nil || v.Int64() != 42 {
+        t.Fatal("deprecated amount isn't used", v, err)
+    }
+
+    for _, dec := range []string{"-1", "1.5", "0x10", "1e18"} {
+        if _, err = Parse(dec, 0); err == nil {
+            t.Error("parsed invalid amount", dec)
+        }
+    }
+}
+
+func TestFormat(t *testing.T) {
+    dec, compat := Format(big.NewInt(42))
+    if dec != "42" || compat != 42 {
+        t.Error("wrong amount", dec, compat)
+    }
+
+    v, _ := new(big.Int).SetString("1000000000000000000000", 10)
+    if dec, compat = Format(v); dec != "1000000000000000000000" || compat != 0 {
+        t.Error("wrong overflowed amount", dec, compat)
+    }
+}
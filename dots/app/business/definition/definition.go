@@ -3,6 +3,8 @@
 
 package definition
 
+import "encoding/json"
+
 type AccInfo struct {
     Account  string `json:"account"`
     Password string `json:"password"`
@@ -206,3 +208,19 @@ type Balance struct {
     Balance   string
     TimeStamp string
 }
+
+type AccPasswordData struct {
+    Account     string `json:"account"`
+    Password    string `json:"password"`
+    NewPassword string `json:"newPassword"`
+}
+
+type AccBackup struct {
+    Accounts []AccBackupItem `json:"accounts"`
+}
+type AccBackupItem struct {
+    Address  string          `json:"address"`
+    Nickname string          `json:"nickname"`
+    Password string          `json:"password,omitempty"`
+    Keystore json.RawMessage `json:"keystore,omitempty"`
+}
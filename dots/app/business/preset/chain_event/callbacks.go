@@ -1,8 +1,8 @@
 package cec
 
 import (
+    "context"
     "encoding/json"
-    "github.com/btcsuite/btcutil/base58"
     "github.com/ethereum/go-ethereum/common"
     "github.com/pkg/errors"
     "github.com/scryinfo/dot/dot"
@@ -12,9 +12,7 @@ import (
     "github.com/scryinfo/dp/dots/eth/event"
     "github.com/scryinfo/dp/dots/storage"
     "go.uber.org/zap"
-    "io/ioutil"
     "math/big"
-    "os"
     "strconv"
 )
 
@@ -24,7 +22,7 @@ type Callbacks struct {
     ExtChan      chan []string
     config       cbsConfig
     WS           *app.WSServer  `dot:""`
-    Storage      *storage.Ipfs `dot:""`
+    Storage      storage.Storage `dot:"0d3dd0df-241e-4fc3-a615-c5713f9f8db7"`
 }
 
 type cbsConfig struct {
@@ -33,6 +31,8 @@ type cbsConfig struct {
 
 const (
     CBsTypeId = "36b2b9b7-1559-4d57-a388-f8224072a5d1"
+
+    maxDetailsSize = 1 << 20
 )
 
 func (c *Callbacks) Create(l dot.Line) error {
@@ -103,7 +103,7 @@ func CBsTypeLive() []*dot.TypeLives {
             },
         },
         app.WebSocketTypeLive(),
-        storage.IpfsTypeLive(),
+        storage.StorageTypeLive(),
     }
 }
 
@@ -134,34 +134,15 @@ func (c *Callbacks) getPubDataDetails(ipfsID string) (detailsData definition.OnP
         }
     }()
 
-    var fileName string
-    {
-        outDir := c.config.ProofsOutDir
-        if err = c.Storage.Get(ipfsID, outDir); err != nil {
-            return
-        }
-
-        oldFileName := outDir + "/" + ipfsID
-        fileName = oldFileName + ".txt"
-
-        if err = os.Rename(oldFileName, fileName); err != nil {
-            return
-        }
-    }
-
-    {
-        var details []byte
-        if details, err = ioutil.ReadFile(fileName); err != nil {
-            return
-        }
-        if err = json.Unmarshal(details, &detailsData); err != nil {
-            return
-        }
+    var bs []byte
+    bs, err = storage.ReadVerified(context.Background(), c.Storage, ipfsID, storage.MaxSize(maxDetailsSize))
+    if err == storage.ErrUnverifiable {
+        dot.Logger().Warnln("details of published data can't be verified", zap.String("id", ipfsID))
+    } else if err != nil {
+        return
     }
 
-    if err = os.Remove(fileName); err != nil {
-        dot.Logger().Debugln("", zap.NamedError("onPublish.callback: delete details file failed. ", err))
-    }
+    err = json.Unmarshal(bs, &detailsData)
 
     return
 }
@@ -224,45 +205,38 @@ func (c *Callbacks) onTransactionCreate(event event.Event) bool {
     return true
 }
 
-func (c *Callbacks) getAndRenameProofFiles(ipfsIDs [][32]byte, extensions []string) ([]string, error) {
-    if len(ipfsIDs) != len(extensions) {
-        return nil, errors.New("Quantity of IPFS IDs or extensions is wrong. ")
-    }
-
+func (c *Callbacks) getAndRenameProofFiles(proofIDs [][32]byte, extensions []string) ([]string, error) {
     defer func() {
         if er := recover(); er != nil {
             dot.Logger().Errorln("", zap.Any("in callback: get and rename proof files failed. ", er))
         }
     }()
 
+    ipfsIDs, err := storage.Bytes32ToCids(proofIDs)
+    if err != nil {
+        return nil, errors.Wrap(err, "Node - callback: decode proof IDs failed. ")
+    }
+    if len(ipfsIDs) != len(extensions) {
+        return nil, errors.New("Quantity of IPFS IDs or extensions is wrong. ")
+    }
+
     var proofs = make([]string, len(ipfsIDs))
 
     outDir := c.config.ProofsOutDir
-    for i := 0; i < len(ipfsIDs); i++ {
-        ipfsID := ipfsBytes32ToHash(ipfsIDs[i])
-        if err := c.Storage.Get(ipfsID, outDir); err != nil {
-            err = errors.Wrap(err, "Node - callback: IPFS get failed. ")
-            break
+    for i, ipfsID := range ipfsIDs {
+        fileName := outDir + "/" + ipfsID + extensions[i]
+        // proofs must be the ones recorded on chain, the verification is kept next to the file for arbitration,
+        // proofs added in a layout which can't be reproduced are kept unverified
+        if _, err := storage.GetVerified(context.Background(), c.Storage, ipfsID, fileName); err == storage.ErrUnverifiable {
+            dot.Logger().Warnln("proof file can't be verified", zap.String("id", ipfsID))
+        } else if err != nil {
+            return nil, errors.Wrap(err, "Node - callback: get and verify proof file "+ipfsID+" failed. ")
         }
-        oldFileName := outDir + "/" + ipfsID
-        newFileName := oldFileName + extensions[i]
-        if err := os.Rename(oldFileName, newFileName); err != nil {
-            err = errors.Wrap(err, "Node - callback: rename proof file failed. ")
-            break
-        }
-        proofs[i] = newFileName
+        proofs[i] = fileName
     }
 
     return proofs, nil
 }
-func ipfsBytes32ToHash(ipfsb [32]byte) string {
-    byte34 := make([]byte, 34)
-    // if ipfs change encrypt algorithm, byte 18 and 32 will change together.
-    copy(byte34[:2], []byte{byte(18), byte(32)})
-    copy(byte34[2:], ipfsb[:])
-
-    return base58.Encode(byte34)
-}
 
 func (c *Callbacks) onPurchase(event event.Event) bool {
     var op definition.OnPurchase
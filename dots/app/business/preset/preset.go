@@ -1,6 +1,7 @@
 package preset
 
 import (
+    "context"
     "encoding/json"
     "github.com/ethereum/go-ethereum/common"
     "github.com/pkg/errors"
@@ -8,12 +9,14 @@ import (
     "github.com/scryinfo/dp/dots/app/business/definition"
     "github.com/scryinfo/dp/dots/app/business/preset/chain_event"
     "github.com/scryinfo/dp/dots/app/server"
+    "github.com/scryinfo/dp/dots/auth"
     "github.com/scryinfo/dp/dots/binary"
     scry2 "github.com/scryinfo/dp/dots/binary/scry"
     "github.com/scryinfo/dp/dots/eth/transaction"
+    "github.com/scryinfo/dp/dots/storage"
     "io/ioutil"
     "math/big"
-    "os"
+    "strings"
     "time"
 )
 
@@ -23,8 +26,10 @@ type Preset struct {
     CurUser           scry2.Client
     Deployer          *definition.AccInfo
     config            presetConfig
+    token             string
     Bin               *binary.Binary `dot:""`
     CBs               *cec.Callbacks `dot:""`
+    Sessions          *auth.Sessions `dot:"af5f72bc-ed92-4aee-98ec-9944d4f4a0c7"`
 }
 
 type presetConfig struct {
@@ -72,6 +77,10 @@ func (p *Preset) Create(l dot.Line) error {
         "get.token.balance",
         "acc.backup",
         "acc.restore",
+        "acc.list",
+        "acc.export",
+        "acc.change.password",
+        "acc.delete",
     }
 
     p.PresetMsgHandlers = []server.PresetFunc{
@@ -95,6 +104,10 @@ func (p *Preset) Create(l dot.Line) error {
         p.GetTokenBalance,
         p.Backup,
         p.Restore,
+        p.ListAccounts,
+        p.ExportAccount,
+        p.ChangePassword,
+        p.DeleteAccount,
     }
     return nil
 }
@@ -161,6 +174,10 @@ func (p *Preset) LoginVerify(mi *server.MessageIn) (payload interface{}, err err
        return
    }
 
+   if err = p.unlock(ai.Account, ai.Password); err != nil {
+       return
+   }
+
    payload = true
 
    return
@@ -180,6 +197,10 @@ func (p *Preset) CreateNewAccount(mi *server.MessageIn) (payload interface{}, er
 
    p.CurUser = client
 
+   if err = p.unlock(client.Account().Addr, pwd.Password); err != nil {
+       return
+   }
+
    payload = client.Account().Addr
 
    return
@@ -250,6 +271,8 @@ func (p *Preset) Logout(_ *server.MessageIn) (payload interface{}, err error) {
    }
 
    p.CurUser = nil
+   p.Sessions.Lock(p.token)
+   p.token = ""
 
    payload = true
 
@@ -420,25 +443,41 @@ func (p *Preset) Decrypt(mi *server.MessageIn) (payload interface{}, err error)
        return
    }
 
-   var oldFileName string
+   password := dd.Password
+   if password == "" {
+       if password, err = p.Sessions.Password(p.token, dd.SelectedTx.User, scry2.OpDecrypt); err != nil {
+           return "", errors.Wrap(err, "Get password of session failed. ")
+       }
+   }
+
+   var fileName string
    {
+       var r scry2.Recipient
+       if r, err = scry2.RecipientFor(dd.SelectedTx.MetaDataIDEncrypt, dd.SelectedTx.User); err != nil {
+           return "", errors.Wrap(err, "Get encrypted meta data ID of current user failed. ")
+       }
+
        var metaDataIDByte []byte
-       if metaDataIDByte, err = p.Bin.Account.Decrypt(dd.SelectedTx.MetaDataIDEncrypt, dd.SelectedTx.User, dd.Password); err != nil {
+       if metaDataIDByte, err = p.Bin.Signers.Signer(dd.SelectedTx.User).Decrypt(context.Background(), r.CipherText, dd.SelectedTx.User, password); err != nil {
            return "", errors.Wrap(err, "Decrypt encrypted meta data ID failed. ")
        }
-       outDir := p.config.MetaDataOutDir
-       if err = p.Bin.Storage.Get(string(metaDataIDByte), outDir); err != nil {
-           return "", errors.Wrap(err, "Get meta data from IPFS failed. ")
+       fileName = p.config.MetaDataOutDir + "/" + string(metaDataIDByte) + dd.SelectedTx.MetaDataExtension
+       // envelopes are authenticated by decryption, so they are read even if their layout can't be verified
+       _, err = storage.GetVerified(context.Background(), p.Bin.Storage, string(metaDataIDByte), fileName)
+       if err != nil && err != storage.ErrUnverifiable {
+           return "", errors.Wrap(err, "Get and verify meta data failed. ")
+       }
+       unverified := err != nil
+       var enveloped bool
+       if enveloped, err = storage.DecryptFile(fileName, r.UnwrapKey(context.Background(), p.Bin.Signers, password)); err != nil {
+           return "", errors.Wrap(err, "Decrypt meta data failed. ")
+       }
+       if unverified && !enveloped {
+           return "", errors.Wrap(storage.ErrUnverifiable, "Get and verify meta data failed. ")
        }
-       oldFileName = outDir + "/" + string(metaDataIDByte)
-   }
-
-   newFileName := oldFileName + dd.SelectedTx.MetaDataExtension
-   if err = os.Rename(oldFileName, newFileName); err != nil {
-       return "", errors.Wrap(err, "Add extension to meta data failed. ")
    }
 
-   payload = newFileName
+   payload = fileName
 
    return
 }
@@ -634,19 +673,185 @@ func (p *Preset) GetTokenBalance(mi *server.MessageIn) (payload interface{}, err
    return
 }
 
-func (p *Preset) Backup(mi *server.MessageIn) (interface{}, error) {
-   return true, ioutil.WriteFile(p.config.AccsBackupFile, mi.Payload, 0777)
+// Backup writes nicknames of accounts to the backup file, with keystores exported by their signers
+// for accounts whose password is given, the keystores stay encrypted with the same password.
+func (p *Preset) Backup(mi *server.MessageIn) (payload interface{}, err error) {
+   var ab definition.AccBackup
+   if err = json.Unmarshal(mi.Payload, &ab); err != nil {
+       return
+   }
+
+   for i := range ab.Accounts {
+       acc := &ab.Accounts[i]
+       if acc.Password == "" {
+           continue
+       }
+
+       var keyJson []byte
+       if keyJson, err = p.Bin.Signers.Export(context.Background(), acc.Address, acc.Password, acc.Password); err != nil {
+           err = errors.Wrap(err, "Export account "+acc.Address+" failed. ")
+           return
+       }
+       acc.Keystore = keyJson
+       acc.Password = ""
+   }
+
+   var bs []byte
+   if bs, err = json.Marshal(&ab); err != nil {
+       return
+   }
+
+   if err = ioutil.WriteFile(p.config.AccsBackupFile, bs, 0600); err != nil {
+       return
+   }
+
+   payload = true
+
+   return
+}
+
+// Restore imports keystores in the backup file which are not managed by the signers yet,
+// passwords of them are given in the payload, then returns the backup without keystores.
+func (p *Preset) Restore(mi *server.MessageIn) (payload interface{}, err error) {
+   pwds := make(map[string]string)
+   if len(mi.Payload) > 0 {
+       var in definition.AccBackup
+       if err = json.Unmarshal(mi.Payload, &in); err != nil {
+           return
+       }
+       for _, acc := range in.Accounts {
+           pwds[strings.ToLower(acc.Address)] = acc.Password
+       }
+   }
+
+   var bs []byte
+   if bs, err = ioutil.ReadFile(p.config.AccsBackupFile); err != nil {
+       return
+   }
+
+   var ab definition.AccBackup
+   if err = json.Unmarshal(bs, &ab); err != nil {
+       return
+   }
+
+   var managed []string
+   if managed, err = scry2.ListAccounts(); err != nil {
+       return
+   }
+   exists := make(map[string]bool, len(managed))
+   for _, addr := range managed {
+       exists[strings.ToLower(addr)] = true
+   }
+
+   for i := range ab.Accounts {
+       acc := &ab.Accounts[i]
+       pwd, ok := pwds[strings.ToLower(acc.Address)]
+       if len(acc.Keystore) > 0 && ok && !exists[strings.ToLower(acc.Address)] {
+           if _, err = p.Bin.Signers.Import(context.Background(), acc.Keystore, pwd, pwd); err != nil {
+               err = errors.Wrap(err, "Import account "+acc.Address+" failed. ")
+               return
+           }
+       }
+       acc.Keystore = nil
+   }
+
+   if bs, err = json.Marshal(&ab); err != nil {
+       return
+   }
+
+   payload = string(bs)
+
+   return
+}
+
+func (p *Preset) ListAccounts(_ *server.MessageIn) (payload interface{}, err error) {
+   if p.CurUser == nil {
+       err = errors.New("Current user is nil. ")
+       return
+   }
+
+   return scry2.ListAccounts()
+}
+
+func (p *Preset) ExportAccount(mi *server.MessageIn) (payload interface{}, err error) {
+   var apd definition.AccPasswordData
+   if err = json.Unmarshal(mi.Payload, &apd); err != nil {
+       return
+   }
+
+   var keyJson []byte
+   if keyJson, err = p.Bin.Signers.Export(context.Background(), apd.Account, apd.Password, apd.NewPassword); err != nil {
+       err = errors.Wrap(err, "Export account failed. ")
+       return
+   }
+
+   payload = string(keyJson)
+
+   return
+}
+
+func (p *Preset) ChangePassword(mi *server.MessageIn) (payload interface{}, err error) {
+   var apd definition.AccPasswordData
+   if err = json.Unmarshal(mi.Payload, &apd); err != nil {
+       return
+   }
+
+   if err = p.Bin.Signers.ChangePassword(context.Background(), apd.Account, apd.Password, apd.NewPassword); err != nil {
+       err = errors.Wrap(err, "Change password failed. ")
+       return
+   }
+
+   payload = true
+
+   return
+}
+
+func (p *Preset) DeleteAccount(mi *server.MessageIn) (payload interface{}, err error) {
+   var ai definition.AccInfo
+   if err = json.Unmarshal(mi.Payload, &ai); err != nil {
+       return
+   }
+
+   if p.CurUser != nil && strings.EqualFold(p.CurUser.Account().Addr, ai.Account) {
+       if _, err = p.Logout(mi); err != nil {
+           return
+       }
+   }
+
+   if err = p.Bin.Signers.Delete(context.Background(), ai.Account, ai.Password); err != nil {
+       err = errors.Wrap(err, "Delete account failed. ")
+       return
+   }
+
+   payload = true
+
+   return
 }
 
-func (p *Preset) Restore(_ *server.MessageIn) (interface{}, error) {
-   return ioutil.ReadFile(p.config.AccsBackupFile)
+// unlock opens a session for the login user, so the ui can leave passwords out of later messages.
+func (p *Preset) unlock(address string, password string) error {
+   p.Sessions.Lock(p.token)
+
+   s, err := p.Sessions.Unlock(context.Background(), address, password, []string{auth.OpAny}, 0)
+   if err != nil {
+       return errors.Wrap(err, "Unlock session failed. ")
+   }
+   p.token = s.Token
+
+   return nil
 }
 
+// makeTxParams uses the session of the login user when password is empty.
 func (p *Preset) makeTxParams(password string) *transaction.TxParams {
-   return &transaction.TxParams{
+   t := &transaction.TxParams{
        From:     common.HexToAddress(p.CurUser.Account().Addr),
        Password: password,
        Value:    big.NewInt(0),
        Pending:  false,
    }
+   if password == "" {
+       t.Token = p.token
+   }
+
+   return t
 }
@@ -5,29 +5,73 @@ package auth
 
 import (
     "context"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
     "github.com/pkg/errors"
     "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/dots/auth/signdata"
     authStub "github.com/scryinfo/dp/dots/auth/stub"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
+    "github.com/scryinfo/dp/dots/errkind"
     "go.uber.org/zap"
     "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/connectivity"
+    "google.golang.org/grpc/status"
+    "time"
 )
 
 const (
     AccountTypeId = "ca1c6ce4-182b-430a-9813-caeccf83f8ab"
+
+    defaultCallTimeout       = 10000 //milli seconds
+    defaultCallRetries       = 2
+    defaultRetryInterval     = 200   //milli seconds
+    defaultMaxReconnectDelay = 10000 //milli seconds
+    defaultDialTimeout       = 10000 //milli seconds
 )
 
 type Account struct {
+    config accountConfig
     cn     *grpc.ClientConn
     client authStub.KeyServiceClient
+    stop   chan struct{}
+}
+
+type accountConfig struct {
+    CallTimeout       int64 `json:"callTimeout"`       //milli seconds
+    Retries           int   `json:"retries"`           //retries of idempotent calls on transient errors, negative for none
+    RetryInterval     int64 `json:"retryInterval"`     //milli seconds, doubled on every retry
+    MaxReconnectDelay int64 `json:"maxReconnectDelay"` //milli seconds
+    DialTimeout       int64 `json:"dialTimeout"`       //milli seconds, for connecting at startup
 }
 
 type UserAccount struct {
     Addr string
 }
 
+// HDParams selects the accounts derived from a BIP-39 mnemonic.
+type HDParams struct {
+    Mnemonic   string //empty to generate a new one
+    Passphrase string //optional BIP-39 passphrase
+    Path       string //base derivation path, m/44'/60'/0'/0 by default
+    Index      uint32 //index of the first account under the base path
+    Count      uint32 //1 by default
+    Strength   int32  //bits of entropy of a generated mnemonic, 128 by default
+}
+
 //construct dot
-func newAccountDot(_ interface{}) (dot.Dot, error) {
-    d := &Account{}
+func newAccountDot(conf interface{}) (dot.Dot, error) {
+    dConf := &accountConfig{}
+    if bs, ok := conf.([]byte); ok && len(bs) > 0 {
+        if err := dot.UnMarshalConfig(bs, dConf); err != nil {
+            return nil, err
+        }
+    }
+
+    d := &Account{config: *dConf}
     return d, nil
 }
 
@@ -47,6 +91,15 @@ func (c *Account) Create(l dot.Line) error {
 }
 
 func (c *Account) Destroy(ignore bool) error {
+    if c.cn == nil {
+        return nil
+    }
+
+    if c.stop != nil {
+        close(c.stop)
+        c.stop = nil
+    }
+
     dot.Logger().Debugln("closing grpc connection...")
     err := c.cn.Close()
     if err != nil {
@@ -59,12 +112,36 @@ func (c *Account) Destroy(ignore bool) error {
     return nil
 }
 
-func (c *Account) Initialize(authServiceAddr string) error {
+// Initialize connects the key service, with TLS if tlsConf is enabled. It waits for the connection,
+// so an unreachable key service or a wrong certificate fails at startup. The connection is
+// re-established by grpc after later failures, calls wait for it within their timeout.
+func (c *Account) Initialize(authServiceAddr string, tlsConf tlsconfig.Config) error {
+    opts := []grpc.DialOption{
+        grpc.WithBackoffMaxDelay(millis(c.config.MaxReconnectDelay, defaultMaxReconnectDelay)),
+        grpc.WithBlock(),
+        grpc.FailOnNonTempDialError(true),
+        grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+    }
+    if tlsConf.Enabled() {
+        creds, err := tlsconfig.NewClientCredentials(tlsConf)
+        if err != nil {
+            dot.Logger().Errorln("invalid tls configuration of key service", zap.Error(err))
+            return err
+        }
+        opts = append(opts, grpc.WithTransportCredentials(creds))
+    } else {
+        opts = append(opts, grpc.WithInsecure())
+    }
+
+    ctx, cancel := context.WithTimeout(context.Background(), millis(c.config.DialTimeout, defaultDialTimeout))
+    defer cancel()
+
     var err error
-    c.cn, err = grpc.Dial(authServiceAddr, grpc.WithInsecure())
+    c.cn, err = grpc.DialContext(ctx, authServiceAddr, opts...)
     if err != nil {
-        dot.Logger().Errorln("failed to Connect to node:" + authServiceAddr)
-        return err
+        err = errors.Wrap(err, "failed to connect key service "+authServiceAddr)
+        dot.Logger().Errorln("Account::Initialize", zap.Error(err))
+        return errkind.Wrap(errkind.KeyServiceUnavailable, err)
     }
 
     c.client = authStub.NewKeyServiceClient(c.cn)
@@ -73,10 +150,130 @@ func (c *Account) Initialize(authServiceAddr string) error {
         return errors.New("failed to create interface service client")
     }
 
+    c.stop = make(chan struct{})
+    go c.monitor(c.cn, c.stop)
+
     return nil
 }
 
+// State returns the state of the connection to the key service.
+func (c *Account) State() connectivity.State {
+    if c.cn == nil {
+        return connectivity.Shutdown
+    }
+
+    return c.cn.GetState()
+}
+
+// monitor logs state changes of the connection until stop is closed.
+func (c *Account) monitor(cn *grpc.ClientConn, stop chan struct{}) {
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+    go func() {
+        <-stop
+        cancel()
+    }()
+
+    state := cn.GetState()
+    for cn.WaitForStateChange(ctx, state) {
+        state = cn.GetState()
+        switch state {
+        case connectivity.Ready:
+            dot.Logger().Infoln("key service is connected")
+        case connectivity.TransientFailure:
+            dot.Logger().Warnln("connection to key service failed, reconnecting")
+        case connectivity.Shutdown:
+            return
+        }
+    }
+}
+
+// invoke calls f with the configured timeout, and retries it on transient errors until ctx is done,
+// f must be idempotent.
+func (c *Account) invoke(ctx context.Context, f func(ctx context.Context) error) error {
+    retries := c.config.Retries
+    if retries == 0 {
+        retries = defaultCallRetries
+    }
+
+    return c.call(ctx, retries, f)
+}
+
+// invokeOnce calls f with the configured timeout without retrying it, for calls which change the key
+// store and may be applied already when they fail. Calls wait for the connection, so they are not
+// failed by a broken one before they are sent.
+func (c *Account) invokeOnce(ctx context.Context, f func(ctx context.Context) error) error {
+    return c.call(ctx, -1, f)
+}
+
+func (c *Account) call(ctx context.Context, retries int, f func(ctx context.Context) error) error {
+    interval := millis(c.config.RetryInterval, defaultRetryInterval)
+    timeout := millis(c.config.CallTimeout, defaultCallTimeout)
+
+    for i := 0; ; i++ {
+        cctx, cancel := context.WithTimeout(ctx, timeout)
+        err := f(cctx)
+        cancel()
+
+        if err == nil || i >= retries || !isTransient(err) {
+            return unavailable(err)
+        }
+
+        dot.Logger().Debugln("retry key service call", zap.Int("retry", i+1), zap.Error(err))
+
+        select {
+        case <-ctx.Done():
+            return unavailable(err)
+        case <-time.After(interval << uint(i)):
+        }
+    }
+}
+
+func isTransient(err error) bool {
+    switch status.Code(err) {
+    case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+        return true
+    }
+
+    return false
+}
+
+// unavailable classifies the errors of calls which didn't reach the key service.
+func unavailable(err error) error {
+    switch status.Code(err) {
+    case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+        return errkind.Wrap(errkind.KeyServiceUnavailable, err)
+    }
+
+    return err
+}
+
+// keyServiceError classifies the error which the key service returns by its status.
+func keyServiceError(prefix string, st authStub.Status, msg string) error {
+    kind := errkind.Unknown
+    switch st {
+    case authStub.Status_UNAUTHENTICATED:
+        kind = errkind.Unauthenticated
+    case authStub.Status_NOT_FOUND:
+        kind = errkind.NotFound
+    }
+
+    return errkind.New(kind, prefix+msg)
+}
+
+func millis(v int64, def int64) time.Duration {
+    if v <= 0 {
+        v = def
+    }
+
+    return time.Duration(v) * time.Millisecond
+}
+
 func (c *Account) CreateUserAccount(password string) (*UserAccount, error) {
+    return c.CreateUserAccountContext(context.Background(), password)
+}
+
+func (c *Account) CreateUserAccountContext(ctx context.Context, password string) (*UserAccount, error) {
     defer func() {
         if er := recover(); er != nil {
             dot.Logger().Errorln("", zap.Any("failed to create user account, error:", er))
@@ -84,18 +281,19 @@ func (c *Account) CreateUserAccount(password string) (*UserAccount, error) {
     }()
 
     if c.client == nil {
-        return nil, errors.New("failed to create user account, error: null grpc client")
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to create user account, error: null grpc client")
     }
 
-    addr, err := c.client.GenerateAddress(
-        context.Background(),
-        &authStub.AddressParameter{Password: password},
-    )
+    var addr *authStub.AddressInfo
+    err := c.invokeOnce(ctx, func(ctx context.Context) (err error) {
+        addr, err = c.client.GenerateAddress(ctx, &authStub.AddressParameter{Password: password})
+        return
+    })
 
     if err != nil {
         err = errors.Wrap(err, "failed to create user account")
     } else if addr != nil && addr.Status != authStub.Status_OK {
-        err = errors.New("failed to create user account, status is not ok, error:" + addr.Msg)
+        err = keyServiceError("failed to create user account, status is not ok, error:", addr.Status, addr.Msg)
     } else if addr == nil {
         err = errors.New("failed to create user account, returned address is null")
     }
@@ -111,6 +309,10 @@ func (c *Account) CreateUserAccount(password string) (*UserAccount, error) {
 }
 
 func (c *Account) AuthUserAccount(address string, password string) (bool, error) {
+    return c.AuthUserAccountContext(context.Background(), address, password)
+}
+
+func (c *Account) AuthUserAccountContext(ctx context.Context, address string, password string) (bool, error) {
     defer func() {
         if er := recover(); er != nil {
             dot.Logger().Errorln("", zap.Any("failed to authenticate, error:", er))
@@ -118,19 +320,20 @@ func (c *Account) AuthUserAccount(address string, password string) (bool, error)
     }()
 
     if c.client == nil {
-        return false, errors.New("failed to authenticate interface, error: null grpc client")
+        return false, errkind.New(errkind.KeyServiceUnavailable, "failed to authenticate interface, error: null grpc client")
     }
 
-    addr, err := c.client.VerifyAddress(
-        context.Background(),
-        &authStub.AddressParameter{Password: password, Address: address},
-    )
+    var addr *authStub.AddressInfo
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        addr, err = c.client.VerifyAddress(ctx, &authStub.AddressParameter{Password: password, Address: address})
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to authenticate user account")
     } else if addr == nil {
         err = errors.New("failed to authenticate user account, returned address is null")
     } else if addr.Status != authStub.Status_OK {
-        err = errors.New(addr.Msg)
+        err = errkind.New(errkind.Unauthenticated, addr.Msg)
     }
 
     if err != nil {
@@ -141,9 +344,27 @@ func (c *Account) AuthUserAccount(address string, password string) (bool, error)
     return true, nil
 }
 
+// Verify checks password of address with the key service.
+func (c *Account) Verify(address string, password string) error {
+    return c.VerifyContext(context.Background(), address, password)
+}
+
+func (c *Account) VerifyContext(ctx context.Context, address string, password string) error {
+    _, err := c.AuthUserAccountContext(ctx, address, password)
+    return err
+}
+
 func (c *Account) Encrypt(
     plainText []byte,
     address string,
+) ([]byte, error) {
+    return c.EncryptContext(context.Background(), plainText, address)
+}
+
+func (c *Account) EncryptContext(
+    ctx context.Context,
+    plainText []byte,
+    address string,
 ) ([]byte, error) {
     defer func() {
         if er := recover(); er != nil {
@@ -152,18 +373,22 @@ func (c *Account) Encrypt(
     }()
 
     if c.client == nil {
-        return nil, errors.New("failed to encrypt, error: client is null")
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to encrypt, error: client is null")
     }
 
     in := authStub.CipherParameter{Message: plainText, Address: address}
 
-    out, err := c.client.ContentEncrypt(context.Background(), &in)
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ContentEncrypt(ctx, &in)
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to encrypt data")
     } else if out == nil {
         err = errors.New("failed to encrypt data, error: result is null")
     } else if out.Status != authStub.Status_OK {
-        err = errors.New("failed to encrypt data, status is not okk, error:" + out.Msg)
+        err = keyServiceError("failed to encrypt data, status is not okk, error:", out.Status, out.Msg)
     }
     if err != nil {
         dot.Logger().Errorln("failed to encrypt data", zap.Error(err))
@@ -178,6 +403,15 @@ func (c *Account) Decrypt(
     address string,
     password string,
 ) ([]byte, error) {
+    return c.DecryptContext(context.Background(), cipherText, address, password)
+}
+
+func (c *Account) DecryptContext(
+    ctx context.Context,
+    cipherText []byte,
+    address string,
+    password string,
+) ([]byte, error) {
 
     defer func() {
         if er := recover(); er != nil {
@@ -186,7 +420,7 @@ func (c *Account) Decrypt(
     }()
 
     if c.client == nil {
-        return nil, errors.New("failed to decrypt, error: client is null")
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to decrypt, error: client is null")
     }
 
     in := authStub.CipherParameter{
@@ -195,15 +429,17 @@ func (c *Account) Decrypt(
         Password: password,
     }
 
-    dot.Logger().Debugln("Node: show decrypt params. ", zap.Any("in", in))
-
-    out, err := c.client.ContentDecrypt(context.Background(), &in)
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ContentDecrypt(ctx, &in)
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to decrypt data")
     } else if out == nil {
         err = errors.New("failed to encrypt data, error: result is null")
     } else if out.Status != authStub.Status_OK {
-        err = errors.New("failed to decrypt, status is not ok, error:" + out.Msg)
+        err = keyServiceError("failed to decrypt, status is not ok, error:", out.Status, out.Msg)
     }
     if err != nil {
         dot.Logger().Errorln("failed to decrypt", zap.Error(err))
@@ -213,10 +449,22 @@ func (c *Account) Decrypt(
     return out.Data, nil
 }
 
+// ReEncrypt decrypts cipherText of address1 and encrypts it for recipientKey, the uncompressed public key
+// of the recipient, whose account needn't be managed by the key service.
 func (c *Account) ReEncrypt(
     cipherText []byte,
     address1 string,
-    address2 string,
+    recipientKey []byte,
+    password string,
+) ([]byte, error) {
+    return c.ReEncryptContext(context.Background(), cipherText, address1, recipientKey, password)
+}
+
+func (c *Account) ReEncryptContext(
+    ctx context.Context,
+    cipherText []byte,
+    address1 string,
+    recipientKey []byte,
     password string,
 ) ([]byte, error) {
     defer func() {
@@ -226,31 +474,38 @@ func (c *Account) ReEncrypt(
     }()
 
     if c.client == nil {
-        return nil, errors.New("failed to re-encrypt, error: client is null")
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to re-encrypt, error: client is null")
     }
 
     in := authStub.CipherParameter{Message: cipherText, Address: address1, Password: password}
-    out, err := c.client.ContentDecrypt(context.Background(), &in)
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ContentDecrypt(ctx, &in)
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to encrypt data")
     } else if out == nil {
         err = errors.New("failed to encrypt data, error: result is null")
     } else if out.Status != authStub.Status_OK {
-        err = errors.New("failed to decrypt, status is not ok, error:" + out.Msg)
+        err = keyServiceError("failed to decrypt, status is not ok, error:", out.Status, out.Msg)
     }
     if err != nil {
         dot.Logger().Errorln("failed to decrypt", zap.Error(err))
         return nil, err
     }
 
-    in = authStub.CipherParameter{Message: out.Data, Address: address2}
-    out, err = c.client.ContentEncrypt(context.Background(), &in)
+    in = authStub.CipherParameter{Message: out.Data, PublicKey: recipientKey}
+    err = c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ContentEncrypt(ctx, &in)
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to encrypt data")
     } else if out == nil {
         err = errors.New("failed to encrypt data, error: result is null")
     } else if out.Status != authStub.Status_OK {
-        err = errors.New("failed to encrypt data, status is not ok, error:" + out.Msg)
+        err = keyServiceError("failed to encrypt data, status is not ok, error:", out.Status, out.Msg)
     }
     if err != nil {
         dot.Logger().Errorln("failed to encrypt data", zap.Error(err))
@@ -260,7 +515,87 @@ func (c *Account) ReEncrypt(
     return out.Data, nil
 }
 
+// PublicKey returns the uncompressed public key of address.
+func (c *Account) PublicKey(address string) ([]byte, error) {
+    return c.PublicKeyContext(context.Background(), address)
+}
+
+func (c *Account) PublicKeyContext(ctx context.Context, address string) ([]byte, error) {
+    if c.client == nil {
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to get public key, error: client is null")
+    }
+
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.PublicKey(ctx, &authStub.AddressParameter{Address: address})
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to get public key")
+    } else if out == nil {
+        err = errors.New("failed to get public key, error: result is null")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to get public key, status is not ok, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to get public key", zap.Error(err))
+        return nil, err
+    }
+
+    return out.Data, nil
+}
+
+// ReEncryptionKey issues the proxy re-encryption key from address to the delegatee of the public key,
+// see package pre for re-encrypting with it.
+func (c *Account) ReEncryptionKey(address string, password string, delegatee *ecdsa.PublicKey) ([]byte, error) {
+    return c.ReEncryptionKeyContext(context.Background(), address, password, delegatee)
+}
+
+func (c *Account) ReEncryptionKeyContext(
+    ctx context.Context,
+    address string,
+    password string,
+    delegatee *ecdsa.PublicKey,
+) ([]byte, error) {
+    if c.client == nil {
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to issue re-encryption key, error: client is null")
+    }
+    if delegatee == nil {
+        return nil, errkind.New(errkind.InvalidArgument, "failed to issue re-encryption key, error: public key of the delegatee is null")
+    }
+
+    in := authStub.ReKeyParameter{
+        Address:      address,
+        Password:     password,
+        Delegatee:    crypto.PubkeyToAddress(*delegatee).Hex(),
+        DelegateeKey: crypto.FromECDSAPub(delegatee),
+    }
+
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ReEncryptionKey(ctx, &in)
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to issue re-encryption key")
+    } else if out == nil {
+        err = errors.New("failed to issue re-encryption key, error: result is null")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to issue re-encryption key, status is not ok, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to issue re-encryption key", zap.Error(err))
+        return nil, err
+    }
+
+    return out.Data, nil
+}
+
 func (c *Account) SignTransaction(message []byte, address string, password string) ([]byte, error) {
+    return c.SignTransactionContext(context.Background(), message, address, password)
+}
+
+func (c *Account) SignTransactionContext(ctx context.Context, message []byte, address string, password string) ([]byte, error) {
     defer func() {
         if er := recover(); er != nil {
             dot.Logger().Errorln("", zap.Any("failed to signature transaction, error:", er))
@@ -268,7 +603,7 @@ func (c *Account) SignTransaction(message []byte, address string, password strin
     }()
 
     if c.client == nil {
-        return nil, errors.New("failed to signature transaction, error: client is null")
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to signature transaction, error: client is null")
     }
 
     in := authStub.CipherParameter{
@@ -277,13 +612,17 @@ func (c *Account) SignTransaction(message []byte, address string, password strin
         Password: password,
     }
 
-    out, err := c.client.Signature(context.Background(), &in)
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.Signature(ctx, &in)
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to signature transaction, error:")
     } else if out == nil {
         err = errors.New("failed to signature transaction, error: result is nil")
     } else if out.Status != authStub.Status_OK {
-        err = errors.New("failed to signature transaction, status is not ok, error:" + out.Msg)
+        err = keyServiceError("failed to signature transaction, status is not ok, error:", out.Status, out.Msg)
     }
     if err != nil {
         dot.Logger().Errorln("failed to signature transaction", zap.Error(err))
@@ -293,10 +632,45 @@ func (c *Account) SignTransaction(message []byte, address string, password strin
     return out.Data, nil
 }
 
+// SignTx signs transaction with the key service.
+func (c *Account) SignTx(
+    signer types.Signer,
+    address common.Address,
+    tx *types.Transaction,
+    password string,
+) (*types.Transaction, error) {
+    return c.SignTxContext(context.Background(), signer, address, tx, password)
+}
+
+func (c *Account) SignTxContext(
+    ctx context.Context,
+    signer types.Signer,
+    address common.Address,
+    tx *types.Transaction,
+    password string,
+) (*types.Transaction, error) {
+    h := signer.Hash(tx)
+    sign, err := c.SignTransactionContext(ctx, h[:], address.String(), password)
+    if err != nil {
+        return nil, err
+    }
+
+    return tx.WithSignature(signer, sign)
+}
+
 func (c *Account) ImportUserAccount(
     keyJson []byte,
     oldPassword string,
     newPassword string,
+) (string, error) {
+    return c.ImportUserAccountContext(context.Background(), keyJson, oldPassword, newPassword)
+}
+
+func (c *Account) ImportUserAccountContext(
+    ctx context.Context,
+    keyJson []byte,
+    oldPassword string,
+    newPassword string,
 ) (string, error) {
     defer func() {
         if er := recover(); er != nil {
@@ -305,17 +679,21 @@ func (c *Account) ImportUserAccount(
     }()
 
     if c.client == nil {
-        return "", errors.New("failed to import user account, client is null")
+        return "", errkind.New(errkind.KeyServiceUnavailable, "failed to import user account, client is null")
     }
 
     in := authStub.ImportParameter{ContentPassword: oldPassword, ImportPsd: newPassword, Content: keyJson}
-    out, err := c.client.ImportKeystore(context.Background(), &in)
+    var out *authStub.AddressInfo
+    err := c.invokeOnce(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ImportKeystore(ctx, &in)
+        return
+    })
     if err != nil {
         err = errors.Wrap(err, "failed to import user account, error:")
     } else if out == nil {
         err = errors.New("failed to import user account, error: result is nil")
     } else if out.Status != authStub.Status_OK {
-        err = errors.New("failed to import user account, error:" + out.Msg)
+        err = keyServiceError("failed to import user account, error:", out.Status, out.Msg)
     }
     if err != nil {
         dot.Logger().Errorln("failed to import user account", zap.Error(err))
@@ -324,3 +702,329 @@ func (c *Account) ImportUserAccount(
 
     return out.Address, nil
 }
+
+// DeriveUserAccounts stores the accounts derived from a mnemonic with password, a new mnemonic is
+// generated if params.Mnemonic is empty, the mnemonic is returned with the accounts.
+func (c *Account) DeriveUserAccounts(params HDParams, password string) (string, []*UserAccount, error) {
+    return c.DeriveUserAccountsContext(context.Background(), params, password)
+}
+
+func (c *Account) DeriveUserAccountsContext(
+    ctx context.Context,
+    params HDParams,
+    password string,
+) (string, []*UserAccount, error) {
+    defer func() {
+        if er := recover(); er != nil {
+            dot.Logger().Errorln("", zap.Any("failed to derive user accounts, error:", er))
+        }
+    }()
+
+    if c.client == nil {
+        return "", nil, errkind.New(errkind.KeyServiceUnavailable, "failed to derive user accounts, client is null")
+    }
+
+    in := authStub.MnemonicParameter{
+        Password:   password,
+        Mnemonic:   params.Mnemonic,
+        Passphrase: params.Passphrase,
+        Path:       params.Path,
+        Index:      params.Index,
+        Count:      params.Count,
+        Strength:   params.Strength,
+    }
+
+    //a generated mnemonic would be lost with the accounts stored by a call whose response is lost
+    invoke := c.invoke
+    if in.Mnemonic == "" {
+        invoke = c.invokeOnce
+    }
+
+    var out *authStub.MnemonicInfo
+    err := invoke(ctx, func(ctx context.Context) (err error) {
+        if in.Mnemonic == "" {
+            out, err = c.client.GenerateMnemonic(ctx, &in)
+        } else {
+            out, err = c.client.DeriveAddresses(ctx, &in)
+        }
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to derive user accounts")
+    } else if out == nil {
+        err = errors.New("failed to derive user accounts, error: result is nil")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to derive user accounts, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to derive user accounts", zap.Error(err))
+        return "", nil, err
+    }
+
+    mnemonic := params.Mnemonic
+    if mnemonic == "" {
+        mnemonic = out.Mnemonic
+    }
+
+    accs := make([]*UserAccount, 0, len(out.Addresses))
+    for _, addr := range out.Addresses {
+        accs = append(accs, &UserAccount{addr})
+    }
+
+    return mnemonic, accs, nil
+}
+
+func (c *Account) ListUserAccounts() ([]string, error) {
+    return c.ListUserAccountsContext(context.Background())
+}
+
+func (c *Account) ListUserAccountsContext(ctx context.Context) ([]string, error) {
+    defer func() {
+        if er := recover(); er != nil {
+            dot.Logger().Errorln("", zap.Any("failed to list user accounts, error:", er))
+        }
+    }()
+
+    if c.client == nil {
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to list user accounts, client is null")
+    }
+
+    var out *authStub.AddressList
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ListAddresses(ctx, &authStub.ListParameter{})
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to list user accounts")
+    } else if out == nil {
+        err = errors.New("failed to list user accounts, error: result is nil")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to list user accounts, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to list user accounts", zap.Error(err))
+        return nil, err
+    }
+
+    return out.Addresses, nil
+}
+
+// ExportUserAccount returns the keystore of address encrypted with exportPassword.
+func (c *Account) ExportUserAccount(
+    address string,
+    password string,
+    exportPassword string,
+) ([]byte, error) {
+    return c.ExportUserAccountContext(context.Background(), address, password, exportPassword)
+}
+
+func (c *Account) ExportUserAccountContext(
+    ctx context.Context,
+    address string,
+    password string,
+    exportPassword string,
+) ([]byte, error) {
+    defer func() {
+        if er := recover(); er != nil {
+            dot.Logger().Errorln("", zap.Any("failed to export user account, error:", er))
+        }
+    }()
+
+    if c.client == nil {
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to export user account, client is null")
+    }
+
+    in := authStub.ExportParameter{Address: address, Password: password, ExportPsd: exportPassword}
+    var out *authStub.KeystoreContent
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ExportKeystore(ctx, &in)
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to export user account")
+    } else if out == nil {
+        err = errors.New("failed to export user account, error: result is nil")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to export user account, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to export user account", zap.Error(err))
+        return nil, err
+    }
+
+    return out.Content, nil
+}
+
+func (c *Account) ChangeUserPassword(
+    address string,
+    oldPassword string,
+    newPassword string,
+) error {
+    return c.ChangeUserPasswordContext(context.Background(), address, oldPassword, newPassword)
+}
+
+func (c *Account) ChangeUserPasswordContext(
+    ctx context.Context,
+    address string,
+    oldPassword string,
+    newPassword string,
+) error {
+    defer func() {
+        if er := recover(); er != nil {
+            dot.Logger().Errorln("", zap.Any("failed to change password, error:", er))
+        }
+    }()
+
+    if c.client == nil {
+        return errkind.New(errkind.KeyServiceUnavailable, "failed to change password, client is null")
+    }
+
+    in := authStub.PasswordParameter{Address: address, OldPassword: oldPassword, NewPassword: newPassword}
+    var out *authStub.AddressInfo
+    err := c.invokeOnce(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.ChangePassword(ctx, &in)
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to change password")
+    } else if out == nil {
+        err = errors.New("failed to change password, error: result is nil")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to change password, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to change password", zap.Error(err))
+        return err
+    }
+
+    return nil
+}
+
+func (c *Account) DeleteUserAccount(address string, password string) error {
+    return c.DeleteUserAccountContext(context.Background(), address, password)
+}
+
+func (c *Account) DeleteUserAccountContext(ctx context.Context, address string, password string) error {
+    defer func() {
+        if er := recover(); er != nil {
+            dot.Logger().Errorln("", zap.Any("failed to delete user account, error:", er))
+        }
+    }()
+
+    if c.client == nil {
+        return errkind.New(errkind.KeyServiceUnavailable, "failed to delete user account, client is null")
+    }
+
+    in := authStub.AddressParameter{Address: address, Password: password}
+    var out *authStub.AddressInfo
+    err := c.invokeOnce(ctx, func(ctx context.Context) (err error) {
+        out, err = c.client.DeleteAddress(ctx, &in)
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to delete user account")
+    } else if out == nil {
+        err = errors.New("failed to delete user account, error: result is nil")
+    } else if out.Status != authStub.Status_OK {
+        err = keyServiceError("failed to delete user account, error:", out.Status, out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to delete user account", zap.Error(err))
+        return err
+    }
+
+    return nil
+}
+
+// SignMessage signs an EIP-191 personal message, V of the signature is 27/28.
+func (c *Account) SignMessage(message []byte, address string, password string) ([]byte, error) {
+    return c.SignMessageContext(context.Background(), message, address, password)
+}
+
+func (c *Account) SignMessageContext(ctx context.Context, message []byte, address string, password string) ([]byte, error) {
+    if c.client == nil {
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to sign message, client is null")
+    }
+
+    return c.sign(ctx, "message", c.client.SignMessage, &authStub.CipherParameter{
+        Message:  message,
+        Address:  address,
+        Password: password,
+    })
+}
+
+// SignTypedData signs EIP-712 typed data in json, V of the signature is 27/28.
+func (c *Account) SignTypedData(typedData []byte, address string, password string) ([]byte, error) {
+    return c.SignTypedDataContext(context.Background(), typedData, address, password)
+}
+
+func (c *Account) SignTypedDataContext(ctx context.Context, typedData []byte, address string, password string) ([]byte, error) {
+    if c.client == nil {
+        return nil, errkind.New(errkind.KeyServiceUnavailable, "failed to sign typed data, client is null")
+    }
+
+    return c.sign(ctx, "typed data", c.client.SignTypedData, &authStub.CipherParameter{
+        Message:  typedData,
+        Address:  address,
+        Password: password,
+    })
+}
+
+func (c *Account) sign(
+    ctx context.Context,
+    what string,
+    call func(ctx context.Context, in *authStub.CipherParameter, opts ...grpc.CallOption) (*authStub.CipherText, error),
+    in *authStub.CipherParameter,
+) ([]byte, error) {
+    defer func() {
+        if er := recover(); er != nil {
+            dot.Logger().Errorln("", zap.Any("failed to sign "+what+", error:", er))
+        }
+    }()
+
+    var out *authStub.CipherText
+    err := c.invoke(ctx, func(ctx context.Context) (err error) {
+        out, err = call(ctx, in)
+        return
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to sign "+what)
+    } else if out == nil {
+        err = errors.New("failed to sign " + what + ", error: result is nil")
+    } else if out.Status != authStub.Status_OK {
+        err = errors.New("failed to sign " + what + ", error:" + out.Msg)
+    }
+    if err != nil {
+        dot.Logger().Errorln("failed to sign "+what, zap.Error(err))
+        return nil, err
+    }
+
+    return out.Data, nil
+}
+
+// VerifyMessage recovers the signer of an EIP-191 personal message locally,
+// it fails with signdata.ErrSignatureMismatch if address isn't empty and isn't the signer.
+func (c *Account) VerifyMessage(message []byte, signature []byte, address string) (string, error) {
+    signer, err := signdata.VerifyMessage(message, signature, address)
+    if err != nil {
+        return "", err
+    }
+
+    return signer.Hex(), nil
+}
+
+// VerifyTypedData recovers the signer of EIP-712 typed data in json locally,
+// it fails with signdata.ErrSignatureMismatch if address isn't empty and isn't the signer.
+func (c *Account) VerifyTypedData(typedData []byte, signature []byte, address string) (string, error) {
+    td, err := signdata.ParseTypedData(typedData)
+    if err != nil {
+        return "", err
+    }
+
+    signer, err := signdata.VerifyTypedData(td, signature, address)
+    if err != nil {
+        return "", err
+    }
+
+    return signer.Hex(), nil
+}
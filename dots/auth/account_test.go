@@ -0,0 +1,150 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    authStub "github.com/scryinfo/dp/dots/auth/stub"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
+    "github.com/scryinfo/dp/dots/errkind"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "net"
+    "sync/atomic"
+    "testing"
+    "time"
+)
+
+// flakyKeyService fails VerifyAddress with Unavailable for the first failures calls,
+// and holds every call for delay.
+type flakyKeyService struct {
+    authStub.KeyServiceServer
+    failures int32
+    calls    int32
+    delay    time.Duration
+}
+
+func (s *flakyKeyService) VerifyAddress(ctx context.Context, in *authStub.AddressParameter) (*authStub.AddressInfo, error) {
+    n := atomic.AddInt32(&s.calls, 1)
+    if n <= s.failures {
+        return nil, status.Error(codes.Unavailable, "not ready")
+    }
+
+    select {
+    case <-time.After(s.delay):
+    case <-ctx.Done():
+        return nil, ctx.Err()
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: in.Address}, nil
+}
+
+func (s *flakyKeyService) GenerateAddress(ctx context.Context, in *authStub.AddressParameter) (*authStub.AddressInfo, error) {
+    n := atomic.AddInt32(&s.calls, 1)
+    if n <= s.failures {
+        return nil, status.Error(codes.Unavailable, "not ready")
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: "0x01"}, nil
+}
+
+func startFlakyKeyService(t *testing.T, ks *flakyKeyService, conf string) (*Account, func()) {
+    lis, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := grpc.NewServer()
+    authStub.RegisterKeyServiceServer(s, ks)
+    go s.Serve(lis)
+
+    d, err := newAccountDot([]byte(conf))
+    if err != nil {
+        t.Fatal(err)
+    }
+    acc := d.(*Account)
+    if err = acc.Initialize(lis.Addr().String(), tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+
+    return acc, func() {
+        acc.Destroy(true)
+        s.Stop()
+    }
+}
+
+func TestAccountRetry(t *testing.T) {
+    ks := &flakyKeyService{failures: 2}
+    acc, stop := startFlakyKeyService(t, ks, `{"retries": 2, "retryInterval": 10}`)
+    defer stop()
+
+    if err := acc.Verify("0x01", "111111"); err != nil {
+        t.Fatal("failed after retries", err)
+    }
+    if n := atomic.LoadInt32(&ks.calls); n != 3 {
+        t.Errorf("called %d times, want 3", n)
+    }
+
+    ks.failures, ks.calls = 5, 0
+    if err := acc.Verify("0x01", "111111"); err == nil {
+        t.Error("succeeded when retries are exhausted")
+    }
+
+    // an account may be created by a failed call, so it isn't retried
+    ks.failures, ks.calls = 1, 0
+    if _, err := acc.CreateUserAccount("111111"); errkind.Of(err) != errkind.KeyServiceUnavailable {
+        t.Error("wrong error of a failed call", err)
+    }
+    if n := atomic.LoadInt32(&ks.calls); n != 1 {
+        t.Errorf("called %d times, want 1", n)
+    }
+}
+
+func TestAccountDeadline(t *testing.T) {
+    ks := &flakyKeyService{delay: time.Minute}
+    acc, stop := startFlakyKeyService(t, ks, `{"callTimeout": 50, "retries": -1}`)
+    defer stop()
+
+    begin := time.Now()
+    if err := acc.Verify("0x01", "111111"); err == nil {
+        t.Fatal("call to a hanging key service succeeded")
+    }
+    if elapsed := time.Since(begin); elapsed > 5*time.Second {
+        t.Errorf("call timed out after %s", elapsed)
+    }
+
+    ctx, cancel := context.WithCancel(context.Background())
+    time.AfterFunc(50*time.Millisecond, cancel)
+    acc.config.CallTimeout = 0
+    begin = time.Now()
+    if err := acc.VerifyContext(ctx, "0x01", "111111"); err == nil {
+        t.Fatal("cancelled call succeeded")
+    }
+    if elapsed := time.Since(begin); elapsed > 5*time.Second {
+        t.Errorf("cancelled call returned after %s", elapsed)
+    }
+}
+
+func TestAccountInitialize(t *testing.T) {
+    lis, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+    addr := lis.Addr().String()
+    lis.Close()
+
+    d, err := newAccountDot([]byte(`{"dialTimeout": 3000}`))
+    if err != nil {
+        t.Fatal(err)
+    }
+    acc := d.(*Account)
+
+    begin := time.Now()
+    if err = acc.Initialize(addr, tlsconfig.Config{}); errkind.Of(err) != errkind.KeyServiceUnavailable {
+        t.Error("initialized without key service", err)
+    }
+    if elapsed := time.Since(begin); elapsed > 5*time.Second {
+        t.Errorf("initialize failed after %s", elapsed)
+    }
+}
@@ -0,0 +1,140 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package hdwallet
+
+import (
+    "crypto/ecdsa"
+    "crypto/hmac"
+    "crypto/sha512"
+    "encoding/binary"
+    "github.com/ethereum/go-ethereum/accounts"
+    "github.com/ethereum/go-ethereum/common/math"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/pkg/errors"
+    "github.com/tyler-smith/go-bip39"
+    "math/big"
+)
+
+const (
+    DefaultStrength = 128 //12 words
+
+    hardenedKeyStart = 0x80000000
+)
+
+var (
+    ErrInvalidMnemonic = errors.New("invalid mnemonic")
+    ErrInvalidChild    = errors.New("invalid child key, use the next index")
+
+    masterKey = []byte("Bitcoin seed")
+)
+
+// NewMnemonic generates a BIP-39 mnemonic of strength bits of entropy, which is
+// a multiple of 32 in [128, 256], 0 for the default.
+func NewMnemonic(strength int) (string, error) {
+    if strength == 0 {
+        strength = DefaultStrength
+    }
+
+    entropy, err := bip39.NewEntropy(strength)
+    if err != nil {
+        return "", errors.Wrap(err, "failed to generate entropy")
+    }
+
+    return bip39.NewMnemonic(entropy)
+}
+
+// Wallet derives keys from the BIP-32 master key of a BIP-39 seed.
+type Wallet struct {
+    key       []byte
+    chainCode []byte
+}
+
+// New creates the wallet of mnemonic, passphrase is the optional BIP-39 passphrase.
+func New(mnemonic string, passphrase string) (*Wallet, error) {
+    seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+    if err != nil {
+        return nil, ErrInvalidMnemonic
+    }
+
+    return NewFromSeed(seed)
+}
+
+func NewFromSeed(seed []byte) (*Wallet, error) {
+    mac := hmac.New(sha512.New, masterKey)
+    mac.Write(seed)
+    i := mac.Sum(nil)
+
+    if !validKey(new(big.Int).SetBytes(i[:32])) {
+        return nil, errors.New("invalid seed")
+    }
+
+    return &Wallet{key: i[:32], chainCode: i[32:]}, nil
+}
+
+// ParsePath parses a derivation path like "m/44'/60'/0'/0", empty for the BIP-44 base path of ethereum.
+func ParsePath(path string) (accounts.DerivationPath, error) {
+    if path == "" {
+        return accounts.DefaultRootDerivationPath, nil
+    }
+
+    return accounts.ParseDerivationPath(path)
+}
+
+// Derive returns the private key at path.
+func (w *Wallet) Derive(path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+    key, chainCode := w.key, w.chainCode
+    for _, index := range path {
+        var err error
+        if key, chainCode, err = child(key, chainCode, index); err != nil {
+            return nil, err
+        }
+    }
+
+    return crypto.ToECDSA(key)
+}
+
+// DeriveIndex returns the private key of account index under base, such as m/44'/60'/0'/0/index.
+func (w *Wallet) DeriveIndex(base accounts.DerivationPath, index uint32) (*ecdsa.PrivateKey, error) {
+    path := make(accounts.DerivationPath, len(base), len(base)+1)
+    copy(path, base)
+
+    return w.Derive(append(path, index))
+}
+
+// child is the private parent key to private child key derivation of BIP-32.
+func child(key []byte, chainCode []byte, index uint32) ([]byte, []byte, error) {
+    var data []byte
+    if index >= hardenedKeyStart {
+        data = append([]byte{0}, key...)
+    } else {
+        priv, err := crypto.ToECDSA(key)
+        if err != nil {
+            return nil, nil, err
+        }
+        data = crypto.CompressPubkey(&priv.PublicKey)
+    }
+    data = append(data, 0, 0, 0, 0)
+    binary.BigEndian.PutUint32(data[len(data)-4:], index)
+
+    mac := hmac.New(sha512.New, chainCode)
+    mac.Write(data)
+    i := mac.Sum(nil)
+
+    il := new(big.Int).SetBytes(i[:32])
+    if il.Cmp(crypto.S256().Params().N) >= 0 {
+        return nil, nil, ErrInvalidChild
+    }
+
+    k := il.Add(il, new(big.Int).SetBytes(key))
+    k.Mod(k, crypto.S256().Params().N)
+    if k.Sign() == 0 {
+        return nil, nil, ErrInvalidChild
+    }
+
+    return math.PaddedBigBytes(k, 32), i[32:], nil
+}
+
+func validKey(k *big.Int) bool {
+    return k.Sign() > 0 && k.Cmp(crypto.S256().Params().N) < 0
+}
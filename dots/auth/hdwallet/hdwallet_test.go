@@ -0,0 +1,73 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package hdwallet
+
+import (
+    "encoding/hex"
+    "github.com/ethereum/go-ethereum/accounts"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "strings"
+    "testing"
+)
+
+func TestDerive(t *testing.T) {
+    // test vector 1 of BIP-32
+    seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+    w, err := NewFromSeed(seed)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    cases := map[string]string{
+        "m/0'":        "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+        "m/0'/1":      "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+        "m/0'/1/2'":   "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+        "m/0'/1/2'/2": "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4",
+    }
+    for p, want := range cases {
+        path, err := accounts.ParseDerivationPath(p)
+        if err != nil {
+            t.Fatal(err)
+        }
+        key, err := w.Derive(path)
+        if err != nil {
+            t.Fatal(err)
+        }
+        if got := hex.EncodeToString(crypto.FromECDSA(key)); got != want {
+            t.Errorf("%s: %s, want %s", p, got, want)
+        }
+    }
+}
+
+func TestMnemonic(t *testing.T) {
+    w, err := New("test test test test test test test test test test test junk", "")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    base, _ := ParsePath("")
+    want := []string{"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"}
+    for i, addr := range want {
+        key, err := w.DeriveIndex(base, uint32(i))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if got := crypto.PubkeyToAddress(key.PublicKey); got != common.HexToAddress(addr) {
+            t.Errorf("account %d: %s, want %s", i, got.Hex(), addr)
+        }
+    }
+
+    m, err := NewMnemonic(0)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if n := len(strings.Fields(m)); n != 12 {
+        t.Errorf("mnemonic of %d words", n)
+    }
+
+    if _, err = New("test test test test test test test test test test test test", ""); err != ErrInvalidMnemonic {
+        t.Error("accepted a mnemonic with a bad checksum", err)
+    }
+}
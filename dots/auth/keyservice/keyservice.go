@@ -0,0 +1,293 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package keyservice
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/dots/auth/hdwallet"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/auth/signdata"
+    authStub "github.com/scryinfo/dp/dots/auth/stub"
+)
+
+// KeyService implements the KeyService grpc interface with keys kept in a keystore directory.
+type KeyService struct {
+    store *keystore.Store
+}
+
+// check if 'KeyService' implements 'KeyServiceServer' interface.
+var _ authStub.KeyServiceServer = (*KeyService)(nil)
+
+func NewKeyService(store *keystore.Store) *KeyService {
+    return &KeyService{store: store}
+}
+
+func (c *KeyService) Store() *keystore.Store {
+    return c.store
+}
+
+func (c *KeyService) GenerateAddress(ctx context.Context, in *authStub.AddressParameter) (*authStub.AddressInfo, error) {
+    addr, err := c.store.NewAccount(in.Password)
+    if err != nil {
+        return addressError(err), nil
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: addr}, nil
+}
+
+func (c *KeyService) VerifyAddress(ctx context.Context, in *authStub.AddressParameter) (*authStub.AddressInfo, error) {
+    if err := c.store.Verify(in.Address, in.Password); err != nil {
+        return addressError(err), nil
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: in.Address}, nil
+}
+
+// ContentEncrypt encrypts for the public key if it is given, so for accounts which aren't in the store,
+// for the recorded public key of the address otherwise.
+func (c *KeyService) ContentEncrypt(ctx context.Context, in *authStub.CipherParameter) (*authStub.CipherText, error) {
+    var out []byte
+    var err error
+    if len(in.PublicKey) > 0 {
+        out, err = encryptFor(in.Message, in.PublicKey, in.Address)
+    } else {
+        out, err = c.store.Encrypt(in.Message, in.Address)
+    }
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    return &authStub.CipherText{Status: authStub.Status_OK, Data: out}, nil
+}
+
+func (c *KeyService) ContentDecrypt(ctx context.Context, in *authStub.CipherParameter) (*authStub.CipherText, error) {
+    out, err := c.store.Decrypt(in.Message, in.Address, in.Password)
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    return &authStub.CipherText{Status: authStub.Status_OK, Data: out}, nil
+}
+
+func (c *KeyService) Signature(ctx context.Context, in *authStub.CipherParameter) (*authStub.CipherText, error) {
+    out, err := c.store.SignHash(in.Message, in.Address, in.Password)
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    return &authStub.CipherText{Status: authStub.Status_OK, Data: out}, nil
+}
+
+func (c *KeyService) ImportKeystore(ctx context.Context, in *authStub.ImportParameter) (*authStub.AddressInfo, error) {
+    addr, err := c.store.Import(in.Content, in.ContentPassword, in.ImportPsd)
+    if err != nil {
+        return addressError(err), nil
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: addr}, nil
+}
+
+func (c *KeyService) ListAddresses(ctx context.Context, in *authStub.ListParameter) (*authStub.AddressList, error) {
+    return &authStub.AddressList{Status: authStub.Status_OK, Addresses: c.store.Accounts()}, nil
+}
+
+func (c *KeyService) ExportKeystore(ctx context.Context, in *authStub.ExportParameter) (*authStub.KeystoreContent, error) {
+    out, err := c.store.Export(in.Address, in.Password, in.ExportPsd)
+    if err != nil {
+        return &authStub.KeystoreContent{Status: errorStatus(err), Msg: err.Error()}, nil
+    }
+
+    return &authStub.KeystoreContent{Status: authStub.Status_OK, Content: out}, nil
+}
+
+func (c *KeyService) ChangePassword(ctx context.Context, in *authStub.PasswordParameter) (*authStub.AddressInfo, error) {
+    if err := c.store.ChangePassword(in.Address, in.OldPassword, in.NewPassword); err != nil {
+        return addressError(err), nil
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: in.Address}, nil
+}
+
+func (c *KeyService) DeleteAddress(ctx context.Context, in *authStub.AddressParameter) (*authStub.AddressInfo, error) {
+    if err := c.store.Delete(in.Address, in.Password); err != nil {
+        return addressError(err), nil
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: in.Address}, nil
+}
+
+// SignMessage signs the EIP-191 hash of the message, V of the signature is 27/28.
+func (c *KeyService) SignMessage(ctx context.Context, in *authStub.CipherParameter) (*authStub.CipherText, error) {
+    return c.sign(signdata.PersonalHash(in.Message), in.Address, in.Password), nil
+}
+
+// SignTypedData signs the EIP-712 hash of the typed data in json, V of the signature is 27/28.
+func (c *KeyService) SignTypedData(ctx context.Context, in *authStub.CipherParameter) (*authStub.CipherText, error) {
+    td, err := signdata.ParseTypedData(in.Message)
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    hash, err := td.Hash()
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    return c.sign(hash, in.Address, in.Password), nil
+}
+
+// VerifyMessage returns the signer of the message, the status is error if it isn't the address.
+func (c *KeyService) VerifyMessage(ctx context.Context, in *authStub.SignatureParameter) (*authStub.AddressInfo, error) {
+    signer, err := signdata.VerifyMessage(in.Message, in.Signature, in.Address)
+    return signerInfo(signer, err), nil
+}
+
+// VerifyTypedData returns the signer of the typed data, the status is error if it isn't the address.
+func (c *KeyService) VerifyTypedData(ctx context.Context, in *authStub.SignatureParameter) (*authStub.AddressInfo, error) {
+    td, err := signdata.ParseTypedData(in.Message)
+    if err != nil {
+        return addressError(err), nil
+    }
+
+    signer, err := signdata.VerifyTypedData(td, in.Signature, in.Address)
+    return signerInfo(signer, err), nil
+}
+
+// GenerateMnemonic generates a mnemonic and stores count (1 by default) addresses derived from it.
+func (c *KeyService) GenerateMnemonic(ctx context.Context, in *authStub.MnemonicParameter) (*authStub.MnemonicInfo, error) {
+    mnemonic, err := hdwallet.NewMnemonic(int(in.Strength))
+    if err != nil {
+        return mnemonicError(err), nil
+    }
+
+    count := in.Count
+    if count == 0 {
+        count = 1
+    }
+
+    addrs, err := c.store.DeriveAccounts(mnemonic, in.Passphrase, in.Path, in.Index, count, in.Password)
+    if err != nil {
+        return mnemonicError(err), nil
+    }
+
+    return &authStub.MnemonicInfo{Status: authStub.Status_OK, Mnemonic: mnemonic, Addresses: addrs}, nil
+}
+
+// DeriveAddresses stores count (1 by default) addresses derived from the mnemonic from index on.
+func (c *KeyService) DeriveAddresses(ctx context.Context, in *authStub.MnemonicParameter) (*authStub.MnemonicInfo, error) {
+    count := in.Count
+    if count == 0 {
+        count = 1
+    }
+
+    addrs, err := c.store.DeriveAccounts(in.Mnemonic, in.Passphrase, in.Path, in.Index, count, in.Password)
+    if err != nil {
+        return mnemonicError(err), nil
+    }
+
+    return &authStub.MnemonicInfo{Status: authStub.Status_OK, Addresses: addrs}, nil
+}
+
+// PublicKey returns the uncompressed public key of the address, which is known once it is unlocked.
+func (c *KeyService) PublicKey(ctx context.Context, in *authStub.AddressParameter) (*authStub.CipherText, error) {
+    pub, err := c.store.PublicKey(in.Address)
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    return &authStub.CipherText{Status: authStub.Status_OK, Data: crypto.FromECDSAPub(pub)}, nil
+}
+
+// ReEncryptionKey issues the proxy re-encryption key from the address to the delegatee, by its public key
+// if it is sent, or the recorded one of the delegatee's address.
+func (c *KeyService) ReEncryptionKey(ctx context.Context, in *authStub.ReKeyParameter) (*authStub.CipherText, error) {
+    pub, err := delegateeKey(c.store, in)
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    out, err := c.store.ReKey(in.Address, in.Password, pub)
+    if err != nil {
+        return cipherError(err), nil
+    }
+
+    return &authStub.CipherText{Status: authStub.Status_OK, Data: out}, nil
+}
+
+func delegateeKey(store *keystore.Store, in *authStub.ReKeyParameter) (*ecdsa.PublicKey, error) {
+    if len(in.DelegateeKey) == 0 {
+        return store.PublicKey(in.Delegatee)
+    }
+
+    pub, err := crypto.UnmarshalPubkey(in.DelegateeKey)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid public key")
+    }
+    if in.Delegatee != "" && crypto.PubkeyToAddress(*pub) != common.HexToAddress(in.Delegatee) {
+        return nil, errors.New("public key is not of the delegatee")
+    }
+
+    return pub, nil
+}
+
+func encryptFor(plainText []byte, pubKey []byte, address string) ([]byte, error) {
+    pub, err := crypto.UnmarshalPubkey(pubKey)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid public key")
+    }
+    if address != "" && crypto.PubkeyToAddress(*pub) != common.HexToAddress(address) {
+        return nil, errors.New("public key is not of the address")
+    }
+
+    return keystore.EncryptFor(pub, plainText)
+}
+
+func (c *KeyService) sign(hash []byte, address string, password string) *authStub.CipherText {
+    out, err := signdata.Sign(hash, func(hash []byte) ([]byte, error) {
+        return c.store.SignHash(hash, address, password)
+    })
+    if err != nil {
+        return cipherError(err)
+    }
+
+    return &authStub.CipherText{Status: authStub.Status_OK, Data: out}
+}
+
+func signerInfo(signer common.Address, err error) *authStub.AddressInfo {
+    if err == signdata.ErrSignatureMismatch {
+        return &authStub.AddressInfo{Status: authStub.Status_ERROR, Address: signer.Hex(), Msg: err.Error()}
+    } else if err != nil {
+        return addressError(err)
+    }
+
+    return &authStub.AddressInfo{Status: authStub.Status_OK, Address: signer.Hex()}
+}
+
+func addressError(err error) *authStub.AddressInfo {
+    return &authStub.AddressInfo{Status: errorStatus(err), Msg: err.Error()}
+}
+
+func mnemonicError(err error) *authStub.MnemonicInfo {
+    return &authStub.MnemonicInfo{Status: errorStatus(err), Msg: err.Error()}
+}
+
+func cipherError(err error) *authStub.CipherText {
+    return &authStub.CipherText{Status: errorStatus(err), Msg: err.Error()}
+}
+
+// errorStatus classifies err for clients, which must not depend on its message.
+func errorStatus(err error) authStub.Status {
+    switch errors.Cause(err) {
+    case keystore.ErrWrongPassword:
+        return authStub.Status_UNAUTHENTICATED
+    case keystore.ErrUnknownAccount:
+        return authStub.Status_NOT_FOUND
+    }
+
+    return authStub.Status_ERROR
+}
@@ -0,0 +1,482 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package keyservice
+
+import (
+    "bytes"
+    "context"
+    "github.com/ethereum/go-ethereum/accounts/keystore"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/scryinfo/dp/dots/auth"
+    dpKeystore "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/auth/pre"
+    authStub "github.com/scryinfo/dp/dots/auth/stub"
+    "github.com/scryinfo/dp/dots/errkind"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
+    "google.golang.org/grpc"
+    "io/ioutil"
+    "math/big"
+    "net"
+    "os"
+    "testing"
+)
+
+func startKeyService(t *testing.T, dir string) (*grpc.Server, string) {
+    store, err := dpKeystore.Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    lis, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    s := grpc.NewServer()
+    authStub.RegisterKeyServiceServer(s, NewKeyService(store))
+    go s.Serve(lis)
+
+    return s, lis.Addr().String()
+}
+
+func TestKeyService(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, addr := startKeyService(t, dir)
+    defer s.Stop()
+
+    acc := &auth.Account{}
+    if err = acc.Initialize(addr, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    user, err := acc.CreateUserAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if ok, err := acc.AuthUserAccount(user.Addr, "111111"); !ok || err != nil {
+        t.Error("failed to authenticate with the right password", err)
+    }
+    if ok, _ := acc.AuthUserAccount(user.Addr, "222222"); ok {
+        t.Error("authenticated with a wrong password")
+    }
+
+    cipher, err := acc.Encrypt([]byte("scry"), user.Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    plain, err := acc.Decrypt(cipher, user.Addr, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(plain, []byte("scry")) {
+        t.Errorf("decrypted %q", plain)
+    }
+    if _, err = acc.Decrypt(cipher, user.Addr, "222222"); errkind.Of(err) != errkind.Unauthenticated {
+        t.Error("wrong password isn't unauthenticated", err)
+    }
+    if _, err = acc.Decrypt(cipher, "0x0000000000000000000000000000000000000001", "111111"); errkind.Of(err) != errkind.NotFound {
+        t.Error("unknown account isn't not found", err)
+    }
+
+    tx := types.NewTransaction(1, common.HexToAddress(user.Addr), big.NewInt(1), 21000, big.NewInt(1), nil)
+    signed, err := acc.SignTx(types.HomesteadSigner{}, common.HexToAddress(user.Addr), tx, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    from, err := types.Sender(types.HomesteadSigner{}, signed)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if from != common.HexToAddress(user.Addr) {
+        t.Errorf("signed by %s, want %s", from.Hex(), user.Addr)
+    }
+}
+
+func TestKeyServiceImport(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, addr := startKeyService(t, dir)
+    defer s.Stop()
+
+    acc := &auth.Account{}
+    if err = acc.Initialize(addr, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    want := crypto.PubkeyToAddress(key.PublicKey)
+    keyJson, err := keystore.EncryptKey(&keystore.Key{Address: want, PrivateKey: key},
+        "old", keystore.LightScryptN, keystore.LightScryptP)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if _, err = acc.ImportUserAccount(keyJson, "wrong", "new"); err == nil {
+        t.Error("imported with a wrong password")
+    }
+
+    got, err := acc.ImportUserAccount(keyJson, "old", "new")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if common.HexToAddress(got) != want {
+        t.Errorf("imported %s, want %s", got, want.Hex())
+    }
+
+    if ok, err := acc.AuthUserAccount(got, "new"); !ok || err != nil {
+        t.Error("failed to authenticate imported account", err)
+    }
+}
+
+func TestKeyServiceLifecycle(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, addr := startKeyService(t, dir)
+    defer s.Stop()
+
+    acc := &auth.Account{}
+    if err = acc.Initialize(addr, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    user, err := acc.CreateUserAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    accs, err := acc.ListUserAccounts()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(accs) != 1 || accs[0] != user.Addr {
+        t.Errorf("accounts: %v", accs)
+    }
+
+    if err = acc.ChangeUserPassword(user.Addr, "111111", "222222"); err != nil {
+        t.Fatal(err)
+    }
+    if ok, err := acc.AuthUserAccount(user.Addr, "222222"); !ok || err != nil {
+        t.Error("failed to authenticate with the new password", err)
+    }
+
+    keyJson, err := acc.ExportUserAccount(user.Addr, "222222", "backup")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if _, err = keystore.DecryptKey(keyJson, "backup"); err != nil {
+        t.Error("exported keystore can't be decrypted", err)
+    }
+
+    if err = acc.DeleteUserAccount(user.Addr, "111111"); err == nil {
+        t.Error("deleted with a wrong password")
+    }
+    if err = acc.DeleteUserAccount(user.Addr, "222222"); err != nil {
+        t.Fatal(err)
+    }
+    if accs, _ = acc.ListUserAccounts(); len(accs) != 0 {
+        t.Errorf("accounts after delete: %v", accs)
+    }
+}
+
+func TestKeyServiceSignature(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, addr := startKeyService(t, dir)
+    defer s.Stop()
+
+    acc := &auth.Account{}
+    if err = acc.Initialize(addr, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    user, err := acc.CreateUserAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    msg := []byte("receipt of data 0x01")
+    sig, err := acc.SignMessage(msg, user.Addr, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if signer, err := acc.VerifyMessage(msg, sig, user.Addr); err != nil || signer != user.Addr {
+        t.Errorf("recovered %s, error %v", signer, err)
+    }
+    if _, err = acc.SignMessage(msg, user.Addr, "222222"); err == nil {
+        t.Error("signed with a wrong password")
+    }
+
+    typedData := []byte(`{
+        "types": {
+            "EIP712Domain": [{"name": "name", "type": "string"}, {"name": "chainId", "type": "uint256"}],
+            "Offer": [{"name": "publishId", "type": "string"}, {"name": "price", "type": "uint256"}]
+        },
+        "primaryType": "Offer",
+        "domain": {"name": "dp", "chainId": 1},
+        "message": {"publishId": "1", "price": "1000000000000000000000"}
+    }`)
+    sig, err = acc.SignTypedData(typedData, user.Addr, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if signer, err := acc.VerifyTypedData(typedData, sig, user.Addr); err != nil || signer != user.Addr {
+        t.Errorf("recovered %s, error %v", signer, err)
+    }
+
+    cn, err := grpc.Dial(addr, grpc.WithInsecure())
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer cn.Close()
+
+    client := authStub.NewKeyServiceClient(cn)
+    info, err := client.VerifyTypedData(context.Background(),
+        &authStub.SignatureParameter{Address: user.Addr, Message: typedData, Signature: sig})
+    if err != nil || info.Status != authStub.Status_OK || info.Address != user.Addr {
+        t.Errorf("verified by key service: %v, error %v", info, err)
+    }
+    info, err = client.VerifyMessage(context.Background(),
+        &authStub.SignatureParameter{Address: user.Addr, Message: msg, Signature: sig})
+    if err != nil || info.Status != authStub.Status_ERROR {
+        t.Errorf("verified a signature of another message: %v, error %v", info, err)
+    }
+}
+
+func TestKeyServiceMnemonic(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, addr := startKeyService(t, dir)
+    defer s.Stop()
+
+    acc := &auth.Account{}
+    if err = acc.Initialize(addr, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    mnemonic, users, err := acc.DeriveUserAccounts(auth.HDParams{Count: 2}, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if mnemonic == "" || len(users) != 2 || users[0].Addr == users[1].Addr {
+        t.Fatalf("mnemonic %q, accounts %v", mnemonic, users)
+    }
+    if ok, err := acc.AuthUserAccount(users[1].Addr, "111111"); !ok || err != nil {
+        t.Error("failed to authenticate a derived account", err)
+    }
+
+    // recover the second account into another key service
+    dir2, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir2)
+
+    s2, addr2 := startKeyService(t, dir2)
+    defer s2.Stop()
+
+    acc2 := &auth.Account{}
+    if err = acc2.Initialize(addr2, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc2.Destroy(true)
+
+    _, recovered, err := acc2.DeriveUserAccounts(auth.HDParams{Mnemonic: mnemonic, Index: 1}, "222222")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(recovered) != 1 || recovered[0].Addr != users[1].Addr {
+        t.Errorf("recovered %v, want %s", recovered, users[1].Addr)
+    }
+    if ok, err := acc2.AuthUserAccount(users[1].Addr, "222222"); !ok || err != nil {
+        t.Error("failed to authenticate a recovered account", err)
+    }
+
+    if _, _, err = acc2.DeriveUserAccounts(auth.HDParams{Mnemonic: "scry scry scry"}, "222222"); err == nil {
+        t.Error("derived accounts from an invalid mnemonic")
+    }
+}
+
+func TestKeyServiceReEncryptionKey(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, addr := startKeyService(t, dir)
+    defer s.Stop()
+
+    acc := &auth.Account{}
+    if err = acc.Initialize(addr, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    seller, err := acc.CreateUserAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    buyer, err := acc.CreateUserAccount("222222")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    pubBytes, err := acc.PublicKey(seller.Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    pub, err := crypto.UnmarshalPubkey(pubBytes)
+    if err != nil || crypto.PubkeyToAddress(*pub).Hex() != seller.Addr {
+        t.Fatal("wrong public key", err)
+    }
+
+    metaDataID := []byte("QmNRCQWfgze6AbBCaT1rkrkV5tJ2aP4oTNPb5JZcXYywve")
+    ct, err := pre.Encrypt(pub, metaDataID)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    buyerBytes, err := acc.PublicKey(buyer.Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    buyerPub, err := crypto.UnmarshalPubkey(buyerBytes)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if _, err = acc.ReEncryptionKey(seller.Addr, "222222", buyerPub); err == nil {
+        t.Error("issued re-encryption key with a wrong password")
+    }
+    reKey, err := acc.ReEncryptionKey(seller.Addr, "111111", buyerPub)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // the proxy re-encrypts without any key of the key service
+    rct, err := pre.ReEncrypt(reKey, ct)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    plain, err := acc.Decrypt(rct, buyer.Addr, "222222")
+    if err != nil || !bytes.Equal(plain, metaDataID) {
+        t.Errorf("buyer decrypted %q, error %v", plain, err)
+    }
+    plain, err = acc.Decrypt(ct, seller.Addr, "111111")
+    if err != nil || !bytes.Equal(plain, metaDataID) {
+        t.Errorf("seller decrypted %q, error %v", plain, err)
+    }
+    if _, err = acc.Decrypt(rct, seller.Addr, "111111"); err == nil {
+        t.Error("seller decrypted the cipher text of buyer")
+    }
+
+    // a delegatee which the key service has never seen
+    remote, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if reKey, err = acc.ReEncryptionKey(seller.Addr, "111111", &remote.PublicKey); err != nil {
+        t.Fatal(err)
+    }
+    if rct, err = pre.ReEncrypt(reKey, ct); err != nil {
+        t.Fatal(err)
+    }
+    plain, err = pre.Decrypt(remote, rct)
+    if err != nil || !bytes.Equal(plain, metaDataID) {
+        t.Errorf("remote buyer decrypted %q, error %v", plain, err)
+    }
+}
+
+func TestKeyServiceReEncryptForRemote(t *testing.T) {
+    sellerDir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(sellerDir)
+    buyerDir, err := ioutil.TempDir("", "keyservice")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(buyerDir)
+
+    // seller and buyer have key services of their own
+    s1, addr1 := startKeyService(t, sellerDir)
+    defer s1.Stop()
+    s2, addr2 := startKeyService(t, buyerDir)
+    defer s2.Stop()
+
+    sellerAcc, buyerAcc := &auth.Account{}, &auth.Account{}
+    if err = sellerAcc.Initialize(addr1, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer sellerAcc.Destroy(true)
+    if err = buyerAcc.Initialize(addr2, tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer buyerAcc.Destroy(true)
+
+    seller, err := sellerAcc.CreateUserAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    buyer, err := buyerAcc.CreateUserAccount("222222")
+    if err != nil {
+        t.Fatal(err)
+    }
+    buyerPub, err := buyerAcc.PublicKey(buyer.Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    ct, err := sellerAcc.Encrypt([]byte("meta data id"), seller.Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    rct, err := sellerAcc.ReEncrypt(ct, seller.Addr, buyerPub, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    plain, err := buyerAcc.Decrypt(rct, buyer.Addr, "222222")
+    if err != nil || string(plain) != "meta data id" {
+        t.Errorf("buyer decrypted %q, error %v", plain, err)
+    }
+
+    if _, err = sellerAcc.ReEncrypt(ct, seller.Addr, buyerPub[1:], "111111"); err == nil {
+        t.Error("re-encrypted for an invalid public key")
+    }
+}
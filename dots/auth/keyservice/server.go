@@ -0,0 +1,145 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package keyservice
+
+import (
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    authStub "github.com/scryinfo/dp/dots/auth/stub"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
+    "go.uber.org/zap"
+    "google.golang.org/grpc"
+    "net"
+)
+
+const (
+    ServerTypeId = "cfa3a511-e9bd-4b5f-9f3f-52437ebed950"
+    ServerLiveId = "cfa3a511-e9bd-4b5f-9f3f-52437ebed950"
+)
+
+// Server serves the KeyService over grpc.
+type Server struct {
+    config   serverConfig
+    service  *KeyService
+    server   *grpc.Server
+    listener net.Listener
+    creds    *tlsconfig.Credentials
+}
+
+type serverConfig struct {
+    Addr        string           `json:"addr"`
+    KeystoreDir string           `json:"keystoreDir"`
+    LightKdf    bool             `json:"lightKdf"`
+    Tls         tlsconfig.Config `json:"tls"`
+}
+
+//construct dot
+func newServerDot(conf interface{}) (dot.Dot, error) {
+    var err error
+    var bs []byte
+    if bt, ok := conf.([]byte); ok {
+        bs = bt
+    } else {
+        return nil, dot.SError.Parameter
+    }
+
+    dConf := &serverConfig{}
+    err = dot.UnMarshalConfig(bs, dConf)
+    if err != nil {
+        return nil, err
+    }
+
+    d := &Server{config: *dConf}
+
+    return d, err
+}
+
+//Data structure needed when generating newer component
+func ServerTypeLive() *dot.TypeLives {
+    return &dot.TypeLives{
+        Meta: dot.Metadata{TypeId: ServerTypeId,
+            NewDoter: func(conf interface{}) (dot dot.Dot, err error) {
+                return newServerDot(conf)
+            }},
+    }
+}
+
+func (c *Server) Create(l dot.Line) error {
+    if c.config.Addr == "" || c.config.KeystoreDir == "" {
+        return errors.New("key service address and keystore directory can not be empty")
+    }
+
+    store, err := keystore.Open(c.config.KeystoreDir, c.config.LightKdf)
+    if err != nil {
+        dot.Logger().Errorln("failed to open keystore", zap.Error(err))
+        return err
+    }
+
+    c.service = NewKeyService(store)
+
+    if c.config.Tls.Enabled() {
+        c.creds, err = tlsconfig.NewServerCredentials(c.config.Tls)
+        if err != nil {
+            dot.Logger().Errorln("invalid tls configuration", zap.Error(err))
+            return err
+        }
+    } else {
+        dot.Logger().Warnln("key service is not protected by tls, only listen on a trusted network")
+    }
+
+    return nil
+}
+
+func (c *Server) Start(ignore bool) error {
+    var err error
+    c.listener, err = net.Listen("tcp", c.config.Addr)
+    if err != nil {
+        dot.Logger().Errorln("failed to listen:"+c.config.Addr, zap.Error(err))
+        return err
+    }
+
+    var opts []grpc.ServerOption
+    if c.creds != nil {
+        opts = append(opts, grpc.Creds(c.creds))
+    }
+
+    c.server = grpc.NewServer(opts...)
+    authStub.RegisterKeyServiceServer(c.server, c.service)
+
+    go func() {
+        if err := c.server.Serve(c.listener); err != nil {
+            dot.Logger().Errorln("key service stopped", zap.Error(err))
+        }
+    }()
+
+    dot.Logger().Infoln("key service is listening at " + c.listener.Addr().String())
+
+    return nil
+}
+
+func (c *Server) Stop(ignore bool) error {
+    if c.server != nil {
+        c.server.GracefulStop()
+    }
+
+    return nil
+}
+
+func (c *Server) Destroy(ignore bool) error {
+    return nil
+}
+
+// Addr returns the address the server is listening at, it is only valid after Start.
+func (c *Server) Addr() string {
+    if c.listener == nil {
+        return ""
+    }
+
+    return c.listener.Addr().String()
+}
+
+func (c *Server) Service() *KeyService {
+    return c.service
+}
@@ -0,0 +1,392 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package keystore
+
+import (
+    "crypto/ecdsa"
+    "crypto/rand"
+    "encoding/hex"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/accounts"
+    "github.com/ethereum/go-ethereum/accounts/keystore"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/crypto/ecies"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/dots/auth/hdwallet"
+    "github.com/scryinfo/dp/dots/auth/pre"
+    "io/ioutil"
+    "math"
+    "os"
+    "path/filepath"
+    "strings"
+    "sync"
+)
+
+const (
+    // public keys can't be read from an encrypted key file, so they are recorded
+    // in a hidden file which the go-ethereum keystore skips while scanning.
+    pubKeysFile = ".pubkeys.json"
+
+    MaxDeriveCount = 100
+)
+
+var (
+    ErrUnknownAccount   = errors.New("unknown account")
+    ErrUnknownPublicKey = errors.New("public key of account is unknown, unlock it once first")
+    ErrWrongPassword    = keystore.ErrDecrypt
+)
+
+// Store keeps accounts in a go-ethereum keystore directory and performs signing
+// and ECIES encryption with their keys.
+type Store struct {
+    dir     string
+    ks      *keystore.KeyStore
+    mu      sync.RWMutex
+    pubKeys map[string]string
+}
+
+// Open opens or creates the keystore directory, light selects the cheap scrypt
+// parameters and should only be used for tests.
+func Open(dir string, light bool) (*Store, error) {
+    if err := os.MkdirAll(dir, 0700); err != nil {
+        return nil, errors.Wrap(err, "failed to create keystore directory")
+    }
+
+    n, p := keystore.StandardScryptN, keystore.StandardScryptP
+    if light {
+        n, p = keystore.LightScryptN, keystore.LightScryptP
+    }
+
+    s := &Store{
+        dir:     dir,
+        ks:      keystore.NewKeyStore(dir, n, p),
+        pubKeys: make(map[string]string),
+    }
+
+    if err := s.loadPubKeys(); err != nil {
+        return nil, err
+    }
+
+    return s, nil
+}
+
+func (s *Store) Dir() string {
+    return s.dir
+}
+
+func (s *Store) KeyStore() *keystore.KeyStore {
+    return s.ks
+}
+
+func (s *Store) HasAddress(address string) bool {
+    return s.ks.HasAddress(common.HexToAddress(address))
+}
+
+func (s *Store) NewAccount(password string) (string, error) {
+    a, err := s.ks.NewAccount(password)
+    if err != nil {
+        return "", errors.Wrap(err, "failed to create account")
+    }
+
+    if _, err = s.unlock(a.Address.String(), password); err != nil {
+        return "", err
+    }
+
+    return a.Address.String(), nil
+}
+
+func (s *Store) Import(keyJson []byte, oldPassword string, newPassword string) (string, error) {
+    a, err := s.ks.Import(keyJson, oldPassword, newPassword)
+    if err != nil {
+        return "", errors.Wrap(err, "failed to import keystore")
+    }
+
+    if _, err = s.unlock(a.Address.String(), newPassword); err != nil {
+        return "", err
+    }
+
+    return a.Address.String(), nil
+}
+
+// DeriveAccounts stores count accounts derived from mnemonic under the base path from index on,
+// accounts which are in the keystore already are left as they are.
+func (s *Store) DeriveAccounts(
+    mnemonic string,
+    passphrase string,
+    path string,
+    index uint32,
+    count uint32,
+    password string,
+) ([]string, error) {
+    if count == 0 || count > MaxDeriveCount {
+        return nil, errors.New("count of accounts to derive is out of range")
+    }
+    if count > math.MaxUint32-index {
+        return nil, errors.New("index of accounts to derive is out of range")
+    }
+
+    base, err := hdwallet.ParsePath(path)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid derivation path")
+    }
+
+    w, err := hdwallet.New(mnemonic, passphrase)
+    if err != nil {
+        return nil, err
+    }
+
+    addrs := make([]string, 0, count)
+    for i := index; i < index+count; i++ {
+        priv, err := w.DeriveIndex(base, i)
+        if err != nil {
+            return nil, errors.WithMessage(err, "failed to derive account")
+        }
+        key := &keystore.Key{Address: crypto.PubkeyToAddress(priv.PublicKey), PrivateKey: priv}
+
+        if !s.ks.HasAddress(key.Address) {
+            if _, err = s.ks.ImportECDSA(priv, password); err != nil {
+                zeroKey(key)
+                return nil, errors.Wrap(err, "failed to store derived account")
+            }
+        }
+
+        err = s.recordPubKey(key)
+        zeroKey(key)
+        if err != nil {
+            return nil, err
+        }
+
+        addrs = append(addrs, key.Address.String())
+    }
+
+    return addrs, nil
+}
+
+func (s *Store) Verify(address string, password string) error {
+    _, err := s.unlock(address, password)
+    return err
+}
+
+// Accounts returns addresses of all accounts in the keystore.
+func (s *Store) Accounts() []string {
+    accs := s.ks.Accounts()
+    addrs := make([]string, 0, len(accs))
+    for _, a := range accs {
+        addrs = append(addrs, a.Address.String())
+    }
+
+    return addrs
+}
+
+// Export returns the key file of address encrypted with exportPassword.
+func (s *Store) Export(address string, password string, exportPassword string) ([]byte, error) {
+    a, err := s.find(address)
+    if err != nil {
+        return nil, err
+    }
+
+    keyJson, err := s.ks.Export(a, password, exportPassword)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to export keystore")
+    }
+
+    return keyJson, nil
+}
+
+func (s *Store) ChangePassword(address string, password string, newPassword string) error {
+    a, err := s.find(address)
+    if err != nil {
+        return err
+    }
+
+    if err = s.ks.Update(a, password, newPassword); err != nil {
+        return errors.Wrap(err, "failed to change password")
+    }
+
+    return nil
+}
+
+// Delete removes the key file of address, the password must match.
+func (s *Store) Delete(address string, password string) error {
+    a, err := s.find(address)
+    if err != nil {
+        return err
+    }
+
+    if err = s.ks.Delete(a, password); err != nil {
+        return errors.Wrap(err, "failed to delete account")
+    }
+
+    return s.removePubKey(a.Address)
+}
+
+func (s *Store) SignHash(hash []byte, address string, password string) ([]byte, error) {
+    key, err := s.unlock(address, password)
+    if err != nil {
+        return nil, err
+    }
+    defer zeroKey(key)
+
+    return crypto.Sign(hash, key.PrivateKey)
+}
+
+func (s *Store) Encrypt(plainText []byte, address string) ([]byte, error) {
+    pub, err := s.PublicKey(address)
+    if err != nil {
+        return nil, err
+    }
+
+    return EncryptFor(pub, plainText)
+}
+
+// EncryptFor encrypts plainText for the public key, whose account needn't be in any store.
+func EncryptFor(pub *ecdsa.PublicKey, plainText []byte) ([]byte, error) {
+    return ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(pub), plainText, nil, nil)
+}
+
+// Decrypt opens an ECIES cipher text, or a proxy re-encryption one for address or re-encrypted for it.
+func (s *Store) Decrypt(cipherText []byte, address string, password string) ([]byte, error) {
+    key, err := s.unlock(address, password)
+    if err != nil {
+        return nil, err
+    }
+    defer zeroKey(key)
+
+    if pre.IsCipherText(cipherText) {
+        return pre.Decrypt(key.PrivateKey, cipherText)
+    }
+
+    return ecies.ImportECDSA(key.PrivateKey).Decrypt(cipherText, nil, nil)
+}
+
+// PublicKey returns the recorded public key of address.
+func (s *Store) PublicKey(address string) (*ecdsa.PublicKey, error) {
+    s.mu.RLock()
+    pubHex, ok := s.pubKeys[strings.ToLower(common.HexToAddress(address).Hex())]
+    s.mu.RUnlock()
+    if !ok {
+        return nil, ErrUnknownPublicKey
+    }
+
+    pubBytes, err := hex.DecodeString(pubHex)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid public key")
+    }
+
+    pub, err := crypto.UnmarshalPubkey(pubBytes)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid public key")
+    }
+
+    return pub, nil
+}
+
+// ReKey issues the proxy re-encryption key from address to delegatee,
+// with which cipher texts of address are re-encrypted for delegatee without decrypting them.
+func (s *Store) ReKey(address string, password string, delegatee *ecdsa.PublicKey) ([]byte, error) {
+    if delegatee == nil {
+        return nil, errors.New("public key of the delegatee is null")
+    }
+
+    key, err := s.unlock(address, password)
+    if err != nil {
+        return nil, err
+    }
+    defer zeroKey(key)
+
+    return pre.ReKey(key.PrivateKey, delegatee)
+}
+
+// unlock decrypts the key of address and records its public key,
+// callers must zero the returned key after use.
+func (s *Store) unlock(address string, password string) (*keystore.Key, error) {
+    a, err := s.find(address)
+    if err != nil {
+        return nil, err
+    }
+
+    keyJson, err := ioutil.ReadFile(a.URL.Path)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to read key file")
+    }
+
+    key, err := keystore.DecryptKey(keyJson, password)
+    if err != nil {
+        return nil, err
+    }
+
+    if err = s.recordPubKey(key); err != nil {
+        zeroKey(key)
+        return nil, err
+    }
+
+    return key, nil
+}
+
+func (s *Store) find(address string) (accounts.Account, error) {
+    a, err := s.ks.Find(accounts.Account{Address: common.HexToAddress(address)})
+    if err != nil {
+        return a, ErrUnknownAccount
+    }
+
+    return a, nil
+}
+
+func (s *Store) recordPubKey(key *keystore.Key) error {
+    addr := strings.ToLower(key.Address.Hex())
+    pub := hex.EncodeToString(crypto.FromECDSAPub(&key.PrivateKey.PublicKey))
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if s.pubKeys[addr] == pub {
+        return nil
+    }
+    s.pubKeys[addr] = pub
+
+    return s.savePubKeys()
+}
+
+func (s *Store) removePubKey(address common.Address) error {
+    addr := strings.ToLower(address.Hex())
+
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if _, ok := s.pubKeys[addr]; !ok {
+        return nil
+    }
+    delete(s.pubKeys, addr)
+
+    return s.savePubKeys()
+}
+
+// savePubKeys must be called with the lock held.
+func (s *Store) savePubKeys() error {
+    bs, err := json.MarshalIndent(s.pubKeys, "", "  ")
+    if err != nil {
+        return err
+    }
+
+    return ioutil.WriteFile(filepath.Join(s.dir, pubKeysFile), bs, 0600)
+}
+
+func (s *Store) loadPubKeys() error {
+    bs, err := ioutil.ReadFile(filepath.Join(s.dir, pubKeysFile))
+    if os.IsNotExist(err) {
+        return nil
+    } else if err != nil {
+        return errors.Wrap(err, "failed to read public keys")
+    }
+
+    return json.Unmarshal(bs, &s.pubKeys)
+}
+
+func zeroKey(key *keystore.Key) {
+    b := key.PrivateKey.D.Bits()
+    for i := range b {
+        b[i] = 0
+    }
+}
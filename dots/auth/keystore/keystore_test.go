@@ -0,0 +1,154 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package keystore
+
+import (
+    "bytes"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "io/ioutil"
+    "math"
+    "os"
+    "testing"
+)
+
+func TestStore(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keystore")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    addr, err := s.NewAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if err = s.Verify(addr, "222222"); err == nil {
+        t.Error("verified with a wrong password")
+    }
+
+    hash := crypto.Keccak256([]byte("scry"))
+    sig, err := s.SignHash(hash, addr, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    pub, err := crypto.SigToPub(hash, sig)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if crypto.PubkeyToAddress(*pub) != common.HexToAddress(addr) {
+        t.Error("signature is not recovered to the account")
+    }
+
+    // public keys survive reopening the directory
+    s, err = Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    cipherText, err := s.Encrypt([]byte("meta data id"), addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    plainText, err := s.Decrypt(cipherText, addr, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(plainText, []byte("meta data id")) {
+        t.Errorf("decrypted %q", plainText)
+    }
+
+    if _, err = s.Encrypt([]byte("meta data id"), "0x0000000000000000000000000000000000000001"); err != ErrUnknownPublicKey {
+        t.Errorf("encrypt for unknown account, error: %v", err)
+    }
+}
+
+func TestStoreLifecycle(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keystore")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    addr, err := s.NewAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if accs := s.Accounts(); len(accs) != 1 || accs[0] != addr {
+        t.Fatalf("accounts: %v", accs)
+    }
+
+    if err = s.ChangePassword(addr, "222222", "333333"); err == nil {
+        t.Error("changed password with a wrong password")
+    }
+    if err = s.ChangePassword(addr, "111111", "333333"); err != nil {
+        t.Fatal(err)
+    }
+    if err = s.Verify(addr, "333333"); err != nil {
+        t.Error("failed to verify the new password", err)
+    }
+
+    keyJson, err := s.Export(addr, "333333", "backup")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if err = s.Delete(addr, "111111"); err == nil {
+        t.Error("deleted with a wrong password")
+    }
+    if err = s.Delete(addr, "333333"); err != nil {
+        t.Fatal(err)
+    }
+    if s.HasAddress(addr) {
+        t.Error("deleted account is still in the keystore")
+    }
+    if _, err = s.Encrypt([]byte("meta data id"), addr); err != ErrUnknownPublicKey {
+        t.Errorf("encrypt for deleted account, error: %v", err)
+    }
+
+    imported, err := s.Import(keyJson, "backup", "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if imported != addr {
+        t.Errorf("imported %s, want %s", imported, addr)
+    }
+}
+
+func TestDeriveAccountsRange(t *testing.T) {
+    dir, err := ioutil.TempDir("", "keystore")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    mnemonic := "tag volcano eight thank tide danger coast health above argue embrace heavy"
+    if _, err = s.DeriveAccounts(mnemonic, "", "", math.MaxUint32-1, 2, "111111"); err == nil {
+        t.Error("derived accounts past the last index")
+    }
+    if len(s.Accounts()) != 0 {
+        t.Error("stored accounts of a rejected derivation", s.Accounts())
+    }
+
+    addrs, err := s.DeriveAccounts(mnemonic, "", "", math.MaxUint32-1, 1, "111111")
+    if err != nil || len(addrs) != 1 {
+        t.Error("failed to derive the last account", addrs, err)
+    }
+}
@@ -0,0 +1,299 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+// Package pre is a proxy re-encryption scheme on secp256k1 after Umbral with one fragment:
+// content is sealed with AES-GCM under a key encapsulated for the delegator, a proxy holding
+// a re-encryption key of the delegator for a delegatee turns the capsule into one for the
+// delegatee, without learning the content or either private key.
+// A proxy colluding with the delegatee could compute the delegator key, so the delegator
+// must only issue re-encryption keys to proxies it trusts not to do that.
+package pre
+
+import (
+    "crypto/aes"
+    "crypto/cipher"
+    "crypto/ecdsa"
+    "crypto/rand"
+    "crypto/sha256"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/pkg/errors"
+    "io"
+    "math/big"
+)
+
+const (
+    tagCipherText   = 0xa1
+    tagReEncrypted  = 0xa2
+    pointLen        = 33
+    scalarLen       = 32
+    nonceLen        = 12
+    cipherHeaderLen = 1 + 2*pointLen + scalarLen + nonceLen
+    reHeaderLen     = 1 + 3*pointLen + nonceLen
+    ReKeyLen        = scalarLen + pointLen
+)
+
+var (
+    ErrInvalidCipherText = errors.New("invalid cipher text of proxy re-encryption")
+    ErrInvalidReKey      = errors.New("invalid re-encryption key")
+    ErrInvalidCapsule    = errors.New("invalid capsule")
+)
+
+type point struct {
+    x, y *big.Int
+}
+
+// IsCipherText reports if data is a cipher text of this scheme, either original or re-encrypted.
+func IsCipherText(data []byte) bool {
+    return len(data) > 0 && (data[0] == tagCipherText || data[0] == tagReEncrypted)
+}
+
+// IsReEncrypted reports if data is a re-encrypted cipher text.
+func IsReEncrypted(data []byte) bool {
+    return len(data) > 0 && data[0] == tagReEncrypted
+}
+
+// Encrypt seals plainText for pub:
+//   tag E V s nonce sealed
+// where E = rG, V = uG, s = u + r·h(E, V) and the content key is derived from (r + u)·pub.
+func Encrypt(pub *ecdsa.PublicKey, plainText []byte) ([]byte, error) {
+    r, err := randScalar()
+    if err != nil {
+        return nil, err
+    }
+    u, err := randScalar()
+    if err != nil {
+        return nil, err
+    }
+
+    e, v := baseMul(r), baseMul(u)
+    s := new(big.Int).Mul(r, hashCapsule(e, v))
+    s.Add(s, u).Mod(s, order())
+
+    shared := mul(point{pub.X, pub.Y}, new(big.Int).Add(r, u))
+
+    out := []byte{tagCipherText}
+    out = append(out, encode(e)...)
+    out = append(out, encode(v)...)
+    out = append(out, padScalar(s)...)
+
+    return seal(out, plainText, shared)
+}
+
+// Decrypt opens a cipher text of this scheme with the private key of the recipient,
+// which is the delegator of an original one or the delegatee of a re-encrypted one.
+func Decrypt(priv *ecdsa.PrivateKey, data []byte) ([]byte, error) {
+    if len(data) == 0 {
+        return nil, ErrInvalidCipherText
+    }
+
+    switch data[0] {
+    case tagCipherText:
+        e, v, _, err := parseCapsule(data)
+        if err != nil {
+            return nil, err
+        }
+        return open(data[:cipherHeaderLen], data[cipherHeaderLen:], mul(add(e, v), priv.D))
+    case tagReEncrypted:
+        if len(data) < reHeaderLen {
+            return nil, ErrInvalidCipherText
+        }
+        e1, err := decode(data[1 : 1+pointLen])
+        if err != nil {
+            return nil, err
+        }
+        v1, err := decode(data[1+pointLen : 1+2*pointLen])
+        if err != nil {
+            return nil, err
+        }
+        x, err := decode(data[1+2*pointLen : 1+3*pointLen])
+        if err != nil {
+            return nil, err
+        }
+
+        d := hashDelegatee(x, point{priv.PublicKey.X, priv.PublicKey.Y}, mul(x, priv.D))
+        return open(data[:reHeaderLen], data[reHeaderLen:], mul(add(e1, v1), d))
+    }
+
+    return nil, ErrInvalidCipherText
+}
+
+// ReKey generates the re-encryption key from the delegator priv to the delegatee pub:
+//   rk X
+// where X = xG for a random x, rk = priv·d⁻¹ and d = h(X, pub, x·pub).
+func ReKey(priv *ecdsa.PrivateKey, pub *ecdsa.PublicKey) ([]byte, error) {
+    x, err := randScalar()
+    if err != nil {
+        return nil, err
+    }
+
+    xp := baseMul(x)
+    d := hashDelegatee(xp, point{pub.X, pub.Y}, mul(point{pub.X, pub.Y}, x))
+    rk := new(big.Int).ModInverse(d, order())
+    rk.Mul(rk, priv.D).Mod(rk, order())
+
+    return append(padScalar(rk), encode(xp)...), nil
+}
+
+// ReEncrypt turns an original cipher text into one for the delegatee of reKey,
+// the content stays sealed, only the capsule is transformed.
+func ReEncrypt(reKey []byte, data []byte) ([]byte, error) {
+    if len(reKey) != ReKeyLen {
+        return nil, ErrInvalidReKey
+    }
+    rk := new(big.Int).SetBytes(reKey[:scalarLen])
+    if rk.Sign() == 0 || rk.Cmp(order()) >= 0 {
+        return nil, ErrInvalidReKey
+    }
+    x, err := decode(reKey[scalarLen:])
+    if err != nil {
+        return nil, ErrInvalidReKey
+    }
+
+    if len(data) == 0 || data[0] != tagCipherText {
+        return nil, ErrInvalidCipherText
+    }
+    e, v, s, err := parseCapsule(data)
+    if err != nil {
+        return nil, err
+    }
+
+    // the capsule must be well formed, so the proxy doesn't transform what it is fed blindly
+    if !equal(baseMul(s), add(v, mul(e, hashCapsule(e, v)))) {
+        return nil, ErrInvalidCapsule
+    }
+
+    out := []byte{tagReEncrypted}
+    out = append(out, encode(mul(e, rk))...)
+    out = append(out, encode(mul(v, rk))...)
+    out = append(out, encode(x)...)
+    out = append(out, data[cipherHeaderLen-nonceLen:]...)
+
+    return out, nil
+}
+
+func parseCapsule(data []byte) (point, point, *big.Int, error) {
+    if len(data) < cipherHeaderLen {
+        return point{}, point{}, nil, ErrInvalidCipherText
+    }
+
+    e, err := decode(data[1 : 1+pointLen])
+    if err != nil {
+        return point{}, point{}, nil, err
+    }
+    v, err := decode(data[1+pointLen : 1+2*pointLen])
+    if err != nil {
+        return point{}, point{}, nil, err
+    }
+
+    return e, v, new(big.Int).SetBytes(data[1+2*pointLen : 1+2*pointLen+scalarLen]), nil
+}
+
+// seal appends a nonce and plain sealed with the key of shared to header,
+// the header, which contains the capsule, isn't authenticated, as a proxy changes it.
+func seal(header []byte, plain []byte, shared point) ([]byte, error) {
+    nonce := make([]byte, nonceLen)
+    if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+        return nil, errors.Wrap(err, "failed to generate nonce")
+    }
+
+    aead, err := newAead(shared)
+    if err != nil {
+        return nil, err
+    }
+
+    out := append(header, nonce...)
+    return aead.Seal(out, nonce, plain, nil), nil
+}
+
+func open(header []byte, sealed []byte, shared point) ([]byte, error) {
+    aead, err := newAead(shared)
+    if err != nil {
+        return nil, err
+    }
+
+    nonce := header[len(header)-nonceLen:]
+    out, err := aead.Open(nil, nonce, sealed, nil)
+    if err != nil {
+        return nil, ErrInvalidCipherText
+    }
+
+    return out, nil
+}
+
+func newAead(shared point) (cipher.AEAD, error) {
+    h := sha256.New()
+    h.Write([]byte("scry-pre-key"))
+    h.Write(encode(shared))
+
+    block, err := aes.NewCipher(h.Sum(nil))
+    if err != nil {
+        return nil, err
+    }
+
+    return cipher.NewGCM(block)
+}
+
+func hashCapsule(e, v point) *big.Int {
+    return hashToScalar([]byte("scry-pre-capsule"), encode(e), encode(v))
+}
+
+func hashDelegatee(x, pub, shared point) *big.Int {
+    return hashToScalar([]byte("scry-pre-delegatee"), encode(x), encode(pub), encode(shared))
+}
+
+func hashToScalar(data ...[]byte) *big.Int {
+    h := new(big.Int).SetBytes(crypto.Keccak256(data...))
+    h.Mod(h, new(big.Int).Sub(order(), big.NewInt(1)))
+
+    return h.Add(h, big.NewInt(1))
+}
+
+func randScalar() (*big.Int, error) {
+    k, err := rand.Int(rand.Reader, new(big.Int).Sub(order(), big.NewInt(1)))
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to generate random scalar")
+    }
+
+    return k.Add(k, big.NewInt(1)), nil
+}
+
+func order() *big.Int {
+    return crypto.S256().Params().N
+}
+
+func baseMul(k *big.Int) point {
+    x, y := crypto.S256().ScalarBaseMult(padScalar(k))
+    return point{x, y}
+}
+
+func mul(p point, k *big.Int) point {
+    x, y := crypto.S256().ScalarMult(p.x, p.y, padScalar(new(big.Int).Mod(k, order())))
+    return point{x, y}
+}
+
+func add(p, q point) point {
+    x, y := crypto.S256().Add(p.x, p.y, q.x, q.y)
+    return point{x, y}
+}
+
+func equal(p, q point) bool {
+    return p.x.Cmp(q.x) == 0 && p.y.Cmp(q.y) == 0
+}
+
+func encode(p point) []byte {
+    return crypto.CompressPubkey(&ecdsa.PublicKey{Curve: crypto.S256(), X: p.x, Y: p.y})
+}
+
+func decode(bs []byte) (point, error) {
+    pub, err := crypto.DecompressPubkey(bs)
+    if err != nil {
+        return point{}, ErrInvalidCipherText
+    }
+
+    return point{pub.X, pub.Y}, nil
+}
+
+func padScalar(k *big.Int) []byte {
+    b := make([]byte, scalarLen)
+    return append(b[:scalarLen-len(k.Bytes())], k.Bytes()...)
+}
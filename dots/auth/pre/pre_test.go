@@ -0,0 +1,103 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package pre
+
+import (
+    "bytes"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/crypto"
+    "testing"
+)
+
+func generateKeys(t *testing.T, n int) []*ecdsa.PrivateKey {
+    keys := make([]*ecdsa.PrivateKey, n)
+    for i := range keys {
+        key, err := crypto.GenerateKey()
+        if err != nil {
+            t.Fatal(err)
+        }
+        keys[i] = key
+    }
+
+    return keys
+}
+
+func TestReEncrypt(t *testing.T) {
+    keys := generateKeys(t, 3)
+    seller, buyer, other := keys[0], keys[1], keys[2]
+    metaDataID := []byte("QmNRCQWfgze6AbBCaT1rkrkV5tJ2aP4oTNPb5JZcXYywve")
+
+    ct, err := Encrypt(&seller.PublicKey, metaDataID)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !IsCipherText(ct) || IsReEncrypted(ct) {
+        t.Fatal("wrong tag of cipher text")
+    }
+
+    plain, err := Decrypt(seller, ct)
+    if err != nil || !bytes.Equal(plain, metaDataID) {
+        t.Fatal("seller failed to decrypt", err)
+    }
+
+    reKey, err := ReKey(seller, &buyer.PublicKey)
+    if err != nil {
+        t.Fatal(err)
+    }
+    rct, err := ReEncrypt(reKey, ct)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !IsReEncrypted(rct) {
+        t.Fatal("wrong tag of re-encrypted cipher text")
+    }
+
+    plain, err = Decrypt(buyer, rct)
+    if err != nil || !bytes.Equal(plain, metaDataID) {
+        t.Fatal("buyer failed to decrypt", err)
+    }
+
+    if _, err = Decrypt(other, rct); err != ErrInvalidCipherText {
+        t.Error("decrypted by another key", err)
+    }
+    if _, err = Decrypt(other, ct); err != ErrInvalidCipherText {
+        t.Error("original decrypted by another key", err)
+    }
+    if _, err = ReEncrypt(reKey, rct); err != ErrInvalidCipherText {
+        t.Error("re-encrypted twice", err)
+    }
+}
+
+func TestReEncryptInvalid(t *testing.T) {
+    keys := generateKeys(t, 2)
+
+    ct, err := Encrypt(&keys[0].PublicKey, []byte("id"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    reKey, err := ReKey(keys[0], &keys[1].PublicKey)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // s no longer matches E and V
+    bad := append([]byte(nil), ct...)
+    bad[1+2*pointLen] ^= 1
+    if _, err = ReEncrypt(reKey, bad); err != ErrInvalidCapsule {
+        t.Error("transformed a bad capsule", err)
+    }
+
+    if _, err = ReEncrypt(reKey[1:], ct); err != ErrInvalidReKey {
+        t.Error("used a short re-encryption key", err)
+    }
+    if _, err = ReEncrypt(reKey, ct[:cipherHeaderLen-1]); err != ErrInvalidCipherText {
+        t.Error("transformed a truncated cipher text", err)
+    }
+
+    sealed := append([]byte(nil), ct...)
+    sealed[len(sealed)-1] ^= 1
+    if _, err = Decrypt(keys[0], sealed); err != ErrInvalidCipherText {
+        t.Error("decrypted changed content", err)
+    }
+}
@@ -0,0 +1,217 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "crypto/rand"
+    "encoding/hex"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/dots/errkind"
+    "sync"
+    "time"
+)
+
+const (
+    SessionsTypeId = "af5f72bc-ed92-4aee-98ec-9944d4f4a0c7"
+
+    // OpAny allows a session to be used by every operation.
+    OpAny = "*"
+
+    defaultSessionTtl = 300  //seconds
+    maxSessionTtl     = 3600 //seconds
+)
+
+var (
+    ErrSessionNotFound     = errkind.New(errkind.Unauthenticated, "session is not found or expired")
+    ErrSessionAddress      = errkind.New(errkind.Unauthenticated, "session is not unlocked for the address")
+    ErrOperationNotAllowed = errkind.New(errkind.PermissionDenied, "operation is not allowed by the session")
+)
+
+// Session is an account unlocked for a set of operations until it expires,
+// callers use the token instead of sending the password with every call.
+type Session struct {
+    Token      string
+    Address    string
+    Operations []string
+    Expires    time.Time
+    password   string
+}
+
+func (s *Session) allows(operation string) bool {
+    for _, op := range s.Operations {
+        if op == OpAny || op == operation {
+            return true
+        }
+    }
+
+    return false
+}
+
+// Sessions keeps the unlocked sessions in memory, they are lost when the process exits.
+type Sessions struct {
+    config   sessionsConfig
+    mu       sync.Mutex
+    sessions map[string]*Session
+    Signers  *Signers `dot:"02b7d24f-9e05-4afc-ba8d-955265b231d6"`
+}
+
+type sessionsConfig struct {
+    Ttl    int64 `json:"ttl"`
+    MaxTtl int64 `json:"maxTtl"`
+}
+
+//construct dot
+func newSessionsDot(conf interface{}) (dot.Dot, error) {
+    dConf := &sessionsConfig{}
+    if bs, ok := conf.([]byte); ok && len(bs) > 0 {
+        if err := dot.UnMarshalConfig(bs, dConf); err != nil {
+            return nil, err
+        }
+    }
+
+    if dConf.Ttl <= 0 {
+        dConf.Ttl = defaultSessionTtl
+    }
+    if dConf.MaxTtl <= 0 {
+        dConf.MaxTtl = maxSessionTtl
+    }
+
+    d := &Sessions{config: *dConf, sessions: make(map[string]*Session)}
+
+    return d, nil
+}
+
+//Data structure needed when generating newer component
+func SessionsTypeLive() *dot.TypeLives {
+    return &dot.TypeLives{
+        Meta: dot.Metadata{TypeId: SessionsTypeId, NewDoter: func(conf interface{}) (dot.Dot, error) {
+            return newSessionsDot(conf)
+        }},
+    }
+}
+
+func (c *Sessions) Destroy(ignore bool) error {
+    c.mu.Lock()
+    c.sessions = make(map[string]*Session)
+    c.mu.Unlock()
+
+    return nil
+}
+
+// Unlock verifies the password of address and opens a session for operations, OpAny allows
+// all of them. ttl is clamped to the configured maximum, zero selects the default.
+func (c *Sessions) Unlock(ctx context.Context, address string, password string, operations []string, ttl time.Duration) (*Session, error) {
+    if len(operations) == 0 {
+        return nil, errors.New("no operation to unlock")
+    }
+
+    if err := c.Signers.Signer(address).Verify(ctx, address, password); err != nil {
+        return nil, err
+    }
+
+    if ttl <= 0 {
+        ttl = time.Duration(c.config.Ttl) * time.Second
+    }
+    if max := time.Duration(c.config.MaxTtl) * time.Second; ttl > max {
+        ttl = max
+    }
+
+    token, err := newToken()
+    if err != nil {
+        return nil, err
+    }
+
+    s := &Session{
+        Token:      token,
+        Address:    normalizeAddress(address),
+        Operations: append([]string(nil), operations...),
+        Expires:    time.Now().Add(ttl),
+        password:   password,
+    }
+
+    c.mu.Lock()
+    c.purge()
+    c.sessions[token] = s
+    c.mu.Unlock()
+
+    return s, nil
+}
+
+// Lock closes the session, locking an unknown token is not an error.
+func (c *Sessions) Lock(token string) {
+    c.mu.Lock()
+    delete(c.sessions, token)
+    c.mu.Unlock()
+}
+
+// Password returns the password of the session if it is unlocked for address and operation.
+func (c *Sessions) Password(token string, address string, operation string) (string, error) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    s, err := c.find(token)
+    if err != nil {
+        return "", err
+    }
+    if s.Address != normalizeAddress(address) {
+        return "", ErrSessionAddress
+    }
+    if !s.allows(operation) {
+        return "", ErrOperationNotAllowed
+    }
+
+    return s.password, nil
+}
+
+// Address returns the address of the session if it is unlocked for operation,
+// for operations which are not of an account.
+func (c *Sessions) Address(token string, operation string) (string, error) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    s, err := c.find(token)
+    if err != nil {
+        return "", err
+    }
+    if !s.allows(operation) {
+        return "", ErrOperationNotAllowed
+    }
+
+    return s.Address, nil
+}
+
+// find must be called with the lock held.
+func (c *Sessions) find(token string) (*Session, error) {
+    s, ok := c.sessions[token]
+    if !ok {
+        return nil, ErrSessionNotFound
+    }
+    if time.Now().After(s.Expires) {
+        delete(c.sessions, token)
+        return nil, ErrSessionNotFound
+    }
+
+    return s, nil
+}
+
+// purge must be called with the lock held.
+func (c *Sessions) purge() {
+    now := time.Now()
+    for t, s := range c.sessions {
+        if now.After(s.Expires) {
+            delete(c.sessions, t)
+        }
+    }
+}
+
+func newToken() (string, error) {
+    b := make([]byte, 32)
+    if _, err := rand.Read(b); err != nil {
+        return "", errors.Wrap(err, "failed to generate session token")
+    }
+
+    return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,83 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "io/ioutil"
+    "os"
+    "testing"
+    "time"
+)
+
+func TestSessions(t *testing.T) {
+    dir, err := ioutil.TempDir("", "sessions")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    store, err := keystore.Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+    addr, err := store.NewAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    d, err := newSessionsDot(nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    c := d.(*Sessions)
+    c.Signers = &Signers{
+        config:   signersConfig{Default: SignerKeystore},
+        keystore: &KeystoreSigner{store: store},
+    }
+
+    if _, err = c.Unlock(context.Background(), addr, "222222", []string{OpAny}, 0); err == nil {
+        t.Error("unlocked with a wrong password")
+    }
+
+    s, err := c.Unlock(context.Background(), addr, "111111", []string{"publish"}, 0)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if pwd, err := c.Password(s.Token, addr, "publish"); err != nil || pwd != "111111" {
+        t.Error("failed to get password of session", err)
+    }
+    if _, err = c.Password(s.Token, addr, "vote"); err != ErrOperationNotAllowed {
+        t.Errorf("operation out of scope, error: %v", err)
+    }
+    if _, err = c.Password(s.Token, "0x0000000000000000000000000000000000000001", "publish"); err != ErrSessionAddress {
+        t.Errorf("address out of scope, error: %v", err)
+    }
+
+    if a, err := c.Address(s.Token, "publish"); err != nil || a != normalizeAddress(addr) {
+        t.Error("wrong address of session", a, err)
+    }
+    if _, err = c.Address(s.Token, "vote"); err != ErrOperationNotAllowed {
+        t.Errorf("operation out of scope, error: %v", err)
+    }
+
+    c.Lock(s.Token)
+    if _, err = c.Address(s.Token, "publish"); err != ErrSessionNotFound {
+        t.Errorf("locked session, error: %v", err)
+    }
+    if _, err = c.Password(s.Token, addr, "publish"); err != ErrSessionNotFound {
+        t.Errorf("locked session, error: %v", err)
+    }
+
+    s, err = c.Unlock(context.Background(), addr, "111111", []string{OpAny}, time.Millisecond)
+    if err != nil {
+        t.Fatal(err)
+    }
+    time.Sleep(10 * time.Millisecond)
+    if _, err = c.Password(s.Token, addr, "vote"); err != ErrSessionNotFound {
+        t.Errorf("expired session, error: %v", err)
+    }
+}
@@ -0,0 +1,147 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package signdata
+
+import (
+    "crypto/ecdsa"
+    "fmt"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/pkg/errors"
+    "math/big"
+)
+
+const SignatureLength = 65
+
+var (
+    ErrInvalidSignature  = errors.New("invalid signature")
+    ErrSignatureMismatch = errors.New("signature is not made by the address")
+)
+
+// PersonalHash is the EIP-191 hash of a personal message:
+// keccak256("\x19Ethereum Signed Message:\n" + len(message) + message).
+func PersonalHash(message []byte) []byte {
+    msg := fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)
+    return crypto.Keccak256([]byte(msg))
+}
+
+// Sign signs hash with sign, and turns V of the signature into 27/28 as wallets do.
+func Sign(hash []byte, sign func(hash []byte) ([]byte, error)) ([]byte, error) {
+    sig, err := sign(hash)
+    if err != nil {
+        return nil, err
+    }
+    if len(sig) != SignatureLength {
+        return nil, ErrInvalidSignature
+    }
+
+    sig[64] += 27
+
+    return sig, nil
+}
+
+// Recover returns the address which signed hash, V of the signature may be 0/1 or 27/28.
+func Recover(hash []byte, signature []byte) (common.Address, error) {
+    pub, err := RecoverPublicKey(hash, signature)
+    if err != nil {
+        return common.Address{}, err
+    }
+
+    return crypto.PubkeyToAddress(*pub), nil
+}
+
+// RecoverPublicKey returns the public key which signed hash, V of the signature may be 0/1 or 27/28.
+func RecoverPublicKey(hash []byte, signature []byte) (*ecdsa.PublicKey, error) {
+    if len(signature) != SignatureLength {
+        return nil, ErrInvalidSignature
+    }
+
+    sig := make([]byte, SignatureLength)
+    copy(sig, signature)
+    if sig[64] >= 27 {
+        sig[64] -= 27
+    }
+    if sig[64] > 1 {
+        return nil, ErrInvalidSignature
+    }
+
+    pub, err := crypto.SigToPub(hash, sig)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to recover public key")
+    }
+
+    return pub, nil
+}
+
+// RecoverTxPublicKey returns the public key of the sender of a signed transaction,
+// so accounts of others can be encrypted for once they sent a transaction.
+func RecoverTxPublicKey(tx *types.Transaction) (*ecdsa.PublicKey, error) {
+    v, r, s := tx.RawSignatureValues()
+    if v == nil || r == nil || s == nil || r.BitLen() > 256 || s.BitLen() > 256 {
+        return nil, ErrInvalidSignature
+    }
+
+    var signer types.Signer = types.HomesteadSigner{}
+    if tx.Protected() {
+        signer = types.NewEIP155Signer(tx.ChainId())
+        v = new(big.Int).Sub(v, new(big.Int).Mul(tx.ChainId(), big.NewInt(2)))
+        v.Sub(v, big.NewInt(8))
+    }
+    if v.BitLen() > 8 {
+        return nil, ErrInvalidSignature
+    }
+
+    sig := make([]byte, SignatureLength)
+    copy(sig[32-len(r.Bytes()):32], r.Bytes())
+    copy(sig[64-len(s.Bytes()):64], s.Bytes())
+    sig[64] = byte(v.Uint64())
+
+    h := signer.Hash(tx)
+
+    return RecoverPublicKey(h[:], sig)
+}
+
+// RecoverMessage returns the address which signed the personal message.
+func RecoverMessage(message []byte, signature []byte) (common.Address, error) {
+    return Recover(PersonalHash(message), signature)
+}
+
+// RecoverTypedData returns the address which signed the typed data.
+func RecoverTypedData(data *TypedData, signature []byte) (common.Address, error) {
+    hash, err := data.Hash()
+    if err != nil {
+        return common.Address{}, err
+    }
+
+    return Recover(hash, signature)
+}
+
+// VerifyMessage recovers the signer of message, and checks it is address if address isn't empty.
+func VerifyMessage(message []byte, signature []byte, address string) (common.Address, error) {
+    signer, err := RecoverMessage(message, signature)
+    if err != nil {
+        return signer, err
+    }
+
+    return signer, match(signer, address)
+}
+
+// VerifyTypedData recovers the signer of data, and checks it is address if address isn't empty.
+func VerifyTypedData(data *TypedData, signature []byte, address string) (common.Address, error) {
+    signer, err := RecoverTypedData(data, signature)
+    if err != nil {
+        return signer, err
+    }
+
+    return signer, match(signer, address)
+}
+
+func match(signer common.Address, address string) error {
+    if address != "" && signer != common.HexToAddress(address) {
+        return ErrSignatureMismatch
+    }
+
+    return nil
+}
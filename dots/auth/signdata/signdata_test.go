@@ -0,0 +1,183 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package signdata
+
+import (
+    "bytes"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
+    "math/big"
+    "strings"
+    "testing"
+)
+
+// the example of EIP-712
+const mail = `{
+    "types": {
+        "EIP712Domain": [
+            {"name": "name", "type": "string"},
+            {"name": "version", "type": "string"},
+            {"name": "chainId", "type": "uint256"},
+            {"name": "verifyingContract", "type": "address"}
+        ],
+        "Person": [
+            {"name": "name", "type": "string"},
+            {"name": "wallet", "type": "address"}
+        ],
+        "Mail": [
+            {"name": "from", "type": "Person"},
+            {"name": "to", "type": "Person"},
+            {"name": "contents", "type": "string"}
+        ]
+    },
+    "primaryType": "Mail",
+    "domain": {
+        "name": "Ether Mail",
+        "version": "1",
+        "chainId": 1,
+        "verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+    },
+    "message": {
+        "from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+        "to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+        "contents": "Hello, Bob!"
+    }
+}`
+
+func TestTypedDataHash(t *testing.T) {
+    td, err := ParseTypedData([]byte(mail))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if s := td.EncodeType("Mail"); s != "Mail(Person from,Person to,string contents)Person(string name,address wallet)" {
+        t.Errorf("encoded type %s", s)
+    }
+
+    domain, err := td.HashStruct(DomainType, td.Domain)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if h := hexutil.Encode(domain); h != "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f" {
+        t.Errorf("domain separator %s", h)
+    }
+
+    hash, err := td.Hash()
+    if err != nil {
+        t.Fatal(err)
+    }
+    if h := hexutil.Encode(hash); h != "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2" {
+        t.Errorf("hash %s", h)
+    }
+
+    sig := hexutil.MustDecode("0x4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d" +
+        "07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b915621c")
+    signer, err := VerifyTypedData(td, sig, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if signer != common.HexToAddress("0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826") {
+        t.Errorf("recovered %s", signer.Hex())
+    }
+
+    td.Message["contents"] = "Hello, Alice!"
+    if _, err = VerifyTypedData(td, sig, "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"); err != ErrSignatureMismatch {
+        t.Error("verified a changed message", err)
+    }
+}
+
+func TestTypedDataValues(t *testing.T) {
+    td, err := ParseTypedData([]byte(`{
+        "types": {
+            "EIP712Domain": [{"name": "name", "type": "string"}],
+            "Offer": [
+                {"name": "ids", "type": "bytes32[]"},
+                {"name": "price", "type": "uint8"},
+                {"name": "delta", "type": "int16"},
+                {"name": "ok", "type": "bool"}
+            ]
+        },
+        "primaryType": "Offer",
+        "domain": {"name": "dp"},
+        "message": {"ids": ["0x01", "0x02"], "price": "0xff", "delta": -2, "ok": true}
+    }`))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if _, err = td.Hash(); err != nil {
+        t.Fatal(err)
+    }
+
+    td.Message["price"] = json.Number("256")
+    if _, err = td.Hash(); err == nil || !strings.Contains(err.Error(), "value 256 is out of range") {
+        t.Error("hashed an uint8 out of range", err)
+    }
+
+    td.Message["price"] = json.Number("1")
+    td.Message["delta"] = "-32769"
+    if _, err = td.Hash(); err == nil || !strings.Contains(err.Error(), "value -32769 is out of range") {
+        t.Error("hashed an int16 out of range", err)
+    }
+
+    td.Message["delta"] = json.Number("-32768")
+    if _, err = td.Hash(); err != nil {
+        t.Error("failed to hash the minimum of int16", err)
+    }
+}
+
+func TestPersonalMessage(t *testing.T) {
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    want := crypto.PubkeyToAddress(key.PublicKey)
+
+    msg := []byte("I deliver data 0x01 to the buyer")
+    sig, err := Sign(PersonalHash(msg), func(hash []byte) ([]byte, error) {
+        return crypto.Sign(hash, key)
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+    if sig[64] != 27 && sig[64] != 28 {
+        t.Errorf("v is %d", sig[64])
+    }
+
+    if signer, err := VerifyMessage(msg, sig, want.Hex()); err != nil || signer != want {
+        t.Errorf("recovered %s, error %v", signer.Hex(), err)
+    }
+    if _, err = VerifyMessage([]byte("something else"), sig, want.Hex()); err != ErrSignatureMismatch {
+        t.Error("verified another message", err)
+    }
+    if _, err = RecoverMessage(msg, sig[:64]); err != ErrInvalidSignature {
+        t.Error("recovered with a short signature", err)
+    }
+}
+
+func TestRecoverTxPublicKey(t *testing.T) {
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    signers := []types.Signer{types.HomesteadSigner{}, types.NewEIP155Signer(big.NewInt(1)), types.NewEIP155Signer(big.NewInt(1337))}
+    for _, signer := range signers {
+        tx, err := types.SignTx(types.NewTransaction(1, common.HexToAddress("0x01"), big.NewInt(1), 21000, big.NewInt(1), nil), signer, key)
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        pub, err := RecoverTxPublicKey(tx)
+        if err != nil || !bytes.Equal(crypto.FromECDSAPub(pub), crypto.FromECDSAPub(&key.PublicKey)) {
+            t.Errorf("wrong public key by %T, error %v", signer, err)
+        }
+    }
+
+    if _, err = RecoverTxPublicKey(types.NewTransaction(1, common.HexToAddress("0x01"), big.NewInt(1), 21000, big.NewInt(1), nil)); err == nil {
+        t.Error("recovered the public key of an unsigned transaction")
+    }
+}
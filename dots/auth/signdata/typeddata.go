@@ -0,0 +1,320 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package signdata
+
+import (
+    "bytes"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/common/math"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/pkg/errors"
+    "math/big"
+    "regexp"
+    "sort"
+    "strconv"
+    "strings"
+)
+
+const DomainType = "EIP712Domain"
+
+var (
+    arrayType = regexp.MustCompile(`^(.+)\[(\d*)\]$`)
+    intType   = regexp.MustCompile(`^(u?)int(\d*)$`)
+    bytesType = regexp.MustCompile(`^bytes(\d+)$`)
+)
+
+// Field is a member of an EIP-712 struct type.
+type Field struct {
+    Name string `json:"name"`
+    Type string `json:"type"`
+}
+
+// TypedData is EIP-712 typed data in the json form of eth_signTypedData.
+type TypedData struct {
+    Types       map[string][]Field     `json:"types"`
+    PrimaryType string                 `json:"primaryType"`
+    Domain      map[string]interface{} `json:"domain"`
+    Message     map[string]interface{} `json:"message"`
+}
+
+// ParseTypedData decodes typed data from json, numbers are kept exactly.
+func ParseTypedData(bs []byte) (*TypedData, error) {
+    d := json.NewDecoder(bytes.NewReader(bs))
+    d.UseNumber()
+
+    td := &TypedData{}
+    if err := d.Decode(td); err != nil {
+        return nil, errors.Wrap(err, "invalid typed data")
+    }
+
+    if _, ok := td.Types[DomainType]; !ok {
+        return nil, errors.New("invalid typed data, type " + DomainType + " is missing")
+    }
+    if _, ok := td.Types[td.PrimaryType]; !ok {
+        return nil, errors.New("invalid typed data, primary type '" + td.PrimaryType + "' is missing")
+    }
+
+    return td, nil
+}
+
+// Hash is the EIP-712 hash to sign:
+// keccak256("\x19\x01" ‖ hashStruct(domain) ‖ hashStruct(message)).
+func (c *TypedData) Hash() ([]byte, error) {
+    domain, err := c.HashStruct(DomainType, c.Domain)
+    if err != nil {
+        return nil, errors.WithMessage(err, "failed to hash domain")
+    }
+
+    raw := []byte{0x19, 0x01}
+    raw = append(raw, domain...)
+
+    if c.PrimaryType != DomainType {
+        msg, err := c.HashStruct(c.PrimaryType, c.Message)
+        if err != nil {
+            return nil, errors.WithMessage(err, "failed to hash message")
+        }
+        raw = append(raw, msg...)
+    }
+
+    return crypto.Keccak256(raw), nil
+}
+
+// HashStruct is keccak256(typeHash ‖ encodeData(data)).
+func (c *TypedData) HashStruct(typeName string, data map[string]interface{}) ([]byte, error) {
+    enc, err := c.encodeData(typeName, data)
+    if err != nil {
+        return nil, err
+    }
+
+    return crypto.Keccak256(enc), nil
+}
+
+// EncodeType encodes typeName and the struct types it refers to, such as
+// "Mail(Person from,Person to,string contents)Person(string name,address wallet)".
+func (c *TypedData) EncodeType(typeName string) string {
+    deps := c.dependencies(typeName, map[string]bool{})
+    sort.Strings(deps)
+
+    var b strings.Builder
+    for _, t := range append([]string{typeName}, deps...) {
+        b.WriteString(t)
+        b.WriteString("(")
+        for i, f := range c.Types[t] {
+            if i > 0 {
+                b.WriteString(",")
+            }
+            b.WriteString(f.Type)
+            b.WriteString(" ")
+            b.WriteString(f.Name)
+        }
+        b.WriteString(")")
+    }
+
+    return b.String()
+}
+
+func (c *TypedData) TypeHash(typeName string) []byte {
+    return crypto.Keccak256([]byte(c.EncodeType(typeName)))
+}
+
+// dependencies returns the struct types typeName refers to, not including itself.
+func (c *TypedData) dependencies(typeName string, found map[string]bool) []string {
+    found[typeName] = true
+
+    var deps []string
+    for _, f := range c.Types[typeName] {
+        t := baseType(f.Type)
+        if _, ok := c.Types[t]; !ok || found[t] {
+            continue
+        }
+        deps = append(deps, t)
+        deps = append(deps, c.dependencies(t, found)...)
+    }
+
+    return deps
+}
+
+func (c *TypedData) encodeData(typeName string, data map[string]interface{}) ([]byte, error) {
+    fields, ok := c.Types[typeName]
+    if !ok {
+        return nil, errors.New("unknown type '" + typeName + "'")
+    }
+
+    enc := c.TypeHash(typeName)
+    for _, f := range fields {
+        v, ok := data[f.Name]
+        if !ok {
+            return nil, errors.New("field '" + f.Name + "' of " + typeName + " is missing")
+        }
+
+        word, err := c.encodeValue(f.Type, v)
+        if err != nil {
+            return nil, errors.WithMessage(err, "field '"+f.Name+"' of "+typeName)
+        }
+        enc = append(enc, word...)
+    }
+
+    return enc, nil
+}
+
+// encodeValue encodes v of type t to a 32 bytes word.
+func (c *TypedData) encodeValue(t string, v interface{}) ([]byte, error) {
+    if m := arrayType.FindStringSubmatch(t); m != nil {
+        items, ok := v.([]interface{})
+        if !ok {
+            return nil, errors.New("value is not an array")
+        }
+        if m[2] != "" {
+            if n, _ := strconv.Atoi(m[2]); n != len(items) {
+                return nil, errors.New("array length is not " + m[2])
+            }
+        }
+
+        var enc []byte
+        for _, item := range items {
+            word, err := c.encodeValue(m[1], item)
+            if err != nil {
+                return nil, err
+            }
+            enc = append(enc, word...)
+        }
+
+        return crypto.Keccak256(enc), nil
+    }
+
+    if _, ok := c.Types[t]; ok {
+        m, ok := v.(map[string]interface{})
+        if !ok {
+            return nil, errors.New("value is not a struct")
+        }
+
+        return c.HashStruct(t, m)
+    }
+
+    switch t {
+    case "string":
+        s, ok := v.(string)
+        if !ok {
+            return nil, errors.New("value is not a string")
+        }
+        return crypto.Keccak256([]byte(s)), nil
+    case "bytes":
+        bs, err := toBytes(v)
+        if err != nil {
+            return nil, err
+        }
+        return crypto.Keccak256(bs), nil
+    case "bool":
+        b, ok := v.(bool)
+        if !ok {
+            return nil, errors.New("value is not a bool")
+        }
+        if b {
+            return math.PaddedBigBytes(big.NewInt(1), 32), nil
+        }
+        return make([]byte, 32), nil
+    case "address":
+        s, ok := v.(string)
+        if !ok || !common.IsHexAddress(s) {
+            return nil, errors.New("value is not an address")
+        }
+        return common.LeftPadBytes(common.HexToAddress(s).Bytes(), 32), nil
+    }
+
+    if m := bytesType.FindStringSubmatch(t); m != nil {
+        n, _ := strconv.Atoi(m[1])
+        if n < 1 || n > 32 {
+            return nil, errors.New("invalid type '" + t + "'")
+        }
+        bs, err := toBytes(v)
+        if err != nil {
+            return nil, err
+        }
+        if len(bs) > n {
+            return nil, errors.New("value is longer than " + m[1] + " bytes")
+        }
+        return common.RightPadBytes(bs, 32), nil
+    }
+
+    if m := intType.FindStringSubmatch(t); m != nil {
+        bits := 256
+        if m[2] != "" {
+            bits, _ = strconv.Atoi(m[2])
+        }
+        if bits < 8 || bits > 256 || bits%8 != 0 {
+            return nil, errors.New("invalid type '" + t + "'")
+        }
+        return encodeInt(v, m[1] == "u", bits)
+    }
+
+    return nil, errors.New("unknown type '" + t + "'")
+}
+
+func encodeInt(v interface{}, unsigned bool, bits int) ([]byte, error) {
+    n, err := toBigInt(v)
+    if err != nil {
+        return nil, err
+    }
+
+    if unsigned {
+        if n.Sign() < 0 || n.BitLen() > bits {
+            return nil, errors.New("value " + n.String() + " is out of range")
+        }
+    } else {
+        limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+        if n.Cmp(limit) >= 0 || n.Cmp(new(big.Int).Neg(limit)) < 0 {
+            return nil, errors.New("value " + n.String() + " is out of range")
+        }
+    }
+
+    return math.PaddedBigBytes(math.U256(n), 32), nil
+}
+
+func toBigInt(v interface{}) (*big.Int, error) {
+    var s string
+    switch n := v.(type) {
+    case json.Number:
+        s = n.String()
+    case string:
+        s = n
+    case float64:
+        s = strconv.FormatFloat(n, 'f', -1, 64)
+    default:
+        return nil, errors.New("value is not an integer")
+    }
+
+    n, ok := math.ParseBig256(s)
+    if !ok {
+        return nil, errors.New("value '" + s + "' is not an integer")
+    }
+
+    return n, nil
+}
+
+func toBytes(v interface{}) ([]byte, error) {
+    switch b := v.(type) {
+    case string:
+        bs, err := hexutil.Decode(b)
+        if err != nil {
+            return nil, errors.Wrap(err, "value is not hex bytes")
+        }
+        return bs, nil
+    case []byte:
+        return b, nil
+    }
+
+    return nil, errors.New("value is not bytes")
+}
+
+func baseType(t string) string {
+    for {
+        m := arrayType.FindStringSubmatch(t)
+        if m == nil {
+            return t
+        }
+        t = m[1]
+    }
+}
@@ -0,0 +1,498 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/auth/pre"
+    "github.com/scryinfo/dp/dots/errkind"
+    "github.com/scryinfo/dot/dot"
+    "go.uber.org/zap"
+    "strings"
+    "sync"
+)
+
+const (
+    SignersTypeId = "02b7d24f-9e05-4afc-ba8d-955265b231d6"
+
+    SignerKeyService = "keyService"
+    SignerKeystore   = "keystore"
+    SignerExternal   = "external"
+
+    // ReEncryptDecrypt re-encrypts by decrypting with the key of the seller and encrypting for the recipient,
+    // ReEncryptProxy by proxy re-encryption, the seller only issues re-encryption keys, see package pre.
+    ReEncryptDecrypt = "decrypt"
+    ReEncryptProxy   = "proxy"
+)
+
+// Signer signs transactions and encrypts or decrypts content for the accounts it manages.
+type Signer interface {
+    SignTx(ctx context.Context, signer types.Signer, address common.Address, tx *types.Transaction, password string) (*types.Transaction, error)
+    Encrypt(ctx context.Context, plainText []byte, address string) ([]byte, error)
+    Decrypt(ctx context.Context, cipherText []byte, address string, password string) ([]byte, error)
+    Verify(ctx context.Context, address string, password string) error
+}
+
+// ReKeyer is a Signer which issues proxy re-encryption keys of the accounts it manages.
+type ReKeyer interface {
+    PublicKey(ctx context.Context, address string) (*ecdsa.PublicKey, error)
+    ReEncryptionKey(ctx context.Context, address string, password string, delegatee *ecdsa.PublicKey) ([]byte, error)
+}
+
+// MessageSigner is a Signer which signs EIP-191 personal messages and EIP-712 typed data in json,
+// V of the signatures is 27/28.
+type MessageSigner interface {
+    SignMessage(ctx context.Context, message []byte, address string, password string) ([]byte, error)
+    SignTypedData(ctx context.Context, typedData []byte, address string, password string) ([]byte, error)
+}
+
+// AccountLister is a Signer which lists the accounts it signs for.
+type AccountLister interface {
+    Accounts(ctx context.Context) ([]string, error)
+}
+
+// AccountManager is a Signer which stores the accounts it signs for, and imports, exports or removes them.
+type AccountManager interface {
+    AccountLister
+    Import(ctx context.Context, keyJson []byte, keystorePassword string, password string) (string, error)
+    Export(ctx context.Context, address string, password string, exportPassword string) ([]byte, error)
+    ChangePassword(ctx context.Context, address string, oldPassword string, newPassword string) error
+    Delete(ctx context.Context, address string, password string) error
+}
+
+// keyServiceSigner is the Signer of the key service behind Account.
+type keyServiceSigner struct {
+    account *Account
+}
+
+// check if 'keyServiceSigner' implements 'Signer' interface.
+var _ Signer = keyServiceSigner{}
+var _ ReKeyer = keyServiceSigner{}
+var _ MessageSigner = keyServiceSigner{}
+var _ AccountManager = keyServiceSigner{}
+
+func (c keyServiceSigner) SignTx(
+    ctx context.Context,
+    signer types.Signer,
+    address common.Address,
+    tx *types.Transaction,
+    password string,
+) (*types.Transaction, error) {
+    return c.account.SignTxContext(ctx, signer, address, tx, password)
+}
+
+func (c keyServiceSigner) Encrypt(ctx context.Context, plainText []byte, address string) ([]byte, error) {
+    return c.account.EncryptContext(ctx, plainText, address)
+}
+
+func (c keyServiceSigner) Decrypt(ctx context.Context, cipherText []byte, address string, password string) ([]byte, error) {
+    return c.account.DecryptContext(ctx, cipherText, address, password)
+}
+
+func (c keyServiceSigner) Verify(ctx context.Context, address string, password string) error {
+    return c.account.VerifyContext(ctx, address, password)
+}
+
+func (c keyServiceSigner) SignMessage(ctx context.Context, message []byte, address string, password string) ([]byte, error) {
+    return c.account.SignMessageContext(ctx, message, address, password)
+}
+
+func (c keyServiceSigner) SignTypedData(ctx context.Context, typedData []byte, address string, password string) ([]byte, error) {
+    return c.account.SignTypedDataContext(ctx, typedData, address, password)
+}
+
+func (c keyServiceSigner) PublicKey(ctx context.Context, address string) (*ecdsa.PublicKey, error) {
+    bs, err := c.account.PublicKeyContext(ctx, address)
+    if err != nil {
+        return nil, err
+    }
+
+    return crypto.UnmarshalPubkey(bs)
+}
+
+func (c keyServiceSigner) ReEncryptionKey(ctx context.Context, address string, password string, delegatee *ecdsa.PublicKey) ([]byte, error) {
+    return c.account.ReEncryptionKeyContext(ctx, address, password, delegatee)
+}
+
+func (c keyServiceSigner) Accounts(ctx context.Context) ([]string, error) {
+    return c.account.ListUserAccountsContext(ctx)
+}
+
+func (c keyServiceSigner) Import(ctx context.Context, keyJson []byte, keystorePassword string, password string) (string, error) {
+    return c.account.ImportUserAccountContext(ctx, keyJson, keystorePassword, password)
+}
+
+func (c keyServiceSigner) Export(ctx context.Context, address string, password string, exportPassword string) ([]byte, error) {
+    return c.account.ExportUserAccountContext(ctx, address, password, exportPassword)
+}
+
+func (c keyServiceSigner) ChangePassword(ctx context.Context, address string, oldPassword string, newPassword string) error {
+    return c.account.ChangeUserPasswordContext(ctx, address, oldPassword, newPassword)
+}
+
+func (c keyServiceSigner) Delete(ctx context.Context, address string, password string) error {
+    return c.account.DeleteUserAccountContext(ctx, address, password)
+}
+
+// Signers selects the signer backend of every account.
+type Signers struct {
+    config   signersConfig
+    keystore *KeystoreSigner
+    external *ExternalSigner
+    Account  *Account `dot:"ca1c6ce4-182b-430a-9813-caeccf83f8ab"`
+
+    mu      sync.RWMutex
+    pubKeys map[string]*ecdsa.PublicKey //of accounts managed elsewhere, by normalized address
+}
+
+type signersConfig struct {
+    Default        string            `json:"default"`
+    KeystoreDir    string            `json:"keystoreDir"`
+    ExternalSigner string            `json:"externalSignerUrl"`
+    Accounts       map[string]string `json:"accounts"`
+    ReEncryption   string            `json:"reEncryption"`
+}
+
+//construct dot
+func newSignersDot(conf interface{}) (dot.Dot, error) {
+    dConf := &signersConfig{}
+    if bs, ok := conf.([]byte); ok && len(bs) > 0 {
+        if err := dot.UnMarshalConfig(bs, dConf); err != nil {
+            return nil, err
+        }
+    }
+
+    if dConf.Default == "" {
+        dConf.Default = SignerKeyService
+    }
+    if dConf.ReEncryption == "" {
+        dConf.ReEncryption = ReEncryptDecrypt
+    }
+
+    accs := make(map[string]string, len(dConf.Accounts))
+    for addr, s := range dConf.Accounts {
+        accs[normalizeAddress(addr)] = s
+    }
+    dConf.Accounts = accs
+
+    d := &Signers{config: *dConf, pubKeys: make(map[string]*ecdsa.PublicKey)}
+
+    return d, nil
+}
+
+//Data structure needed when generating newer component
+func SignersTypeLive() []*dot.TypeLives {
+    return []*dot.TypeLives{
+        &dot.TypeLives{
+            Meta: dot.Metadata{TypeId: SignersTypeId, NewDoter: func(conf interface{}) (dot.Dot, error) {
+                return newSignersDot(conf)
+            }},
+        },
+        AccountTypeLive(),
+        SessionsTypeLive(),
+    }
+}
+
+func (c *Signers) Create(l dot.Line) error {
+    var err error
+
+    if c.config.KeystoreDir != "" {
+        c.keystore, err = NewKeystoreSigner(c.config.KeystoreDir)
+        if err != nil {
+            dot.Logger().Errorln("failed to open keystore signer", zap.Error(err))
+            return err
+        }
+    }
+
+    if c.config.ExternalSigner != "" {
+        c.external, err = NewExternalSigner(c.config.ExternalSigner)
+        if err != nil {
+            dot.Logger().Errorln("failed to connect external signer", zap.Error(err))
+            return err
+        }
+    }
+
+    return nil
+}
+
+func (c *Signers) Start(ignore bool) error {
+    for addr, s := range c.config.Accounts {
+        if c.backend(s) == nil {
+            return errors.New("signer '" + s + "' of account " + addr + " is not configured")
+        }
+    }
+
+    if c.backend(c.config.Default) == nil {
+        return errors.New("default signer '" + c.config.Default + "' is not configured")
+    }
+
+    if c.config.ReEncryption != ReEncryptDecrypt && c.config.ReEncryption != ReEncryptProxy {
+        return errors.New("unknown re-encryption '" + c.config.ReEncryption + "'")
+    }
+
+    return nil
+}
+
+func (c *Signers) Stop(ignore bool) error {
+    return nil
+}
+
+func (c *Signers) Destroy(ignore bool) error {
+    if c.external != nil {
+        c.external.Close()
+    }
+
+    return nil
+}
+
+// Signer returns the signer configured for address, or the default one.
+func (c *Signers) Signer(address string) Signer {
+    if s, ok := c.config.Accounts[normalizeAddress(address)]; ok {
+        return c.backend(s)
+    }
+
+    return c.backend(c.config.Default)
+}
+
+// Keystore returns the in-process keystore signer, nil if it isn't configured.
+func (c *Signers) Keystore() *KeystoreSigner {
+    return c.keystore
+}
+
+// Seal encrypts plainText for address, with proxy re-encryption the cipher text is one of package pre,
+// so it can be re-encrypted for others without being decrypted.
+func (c *Signers) Seal(ctx context.Context, plainText []byte, address string) ([]byte, error) {
+    if c.config.ReEncryption != ReEncryptProxy {
+        return c.Signer(address).Encrypt(ctx, plainText, address)
+    }
+
+    rk, err := c.reKeyer(address)
+    if err != nil {
+        return nil, err
+    }
+
+    pub, err := rk.PublicKey(ctx, address)
+    if err != nil {
+        return nil, err
+    }
+
+    return pre.Encrypt(pub, plainText)
+}
+
+// ReEncrypt turns cipherText of address into one for the recipient, whose account needn't be managed here.
+// A cipher text of package pre is re-encrypted with a re-encryption key issued by address, so its plain text
+// is never seen, others are decrypted with the key of address and encrypted for the recipient.
+func (c *Signers) ReEncrypt(
+    ctx context.Context,
+    cipherText []byte,
+    address string,
+    password string,
+    recipient *ecdsa.PublicKey,
+) ([]byte, error) {
+    if recipient == nil {
+        return nil, errors.New("public key of the recipient is null")
+    }
+
+    if pre.IsCipherText(cipherText) {
+        rk, err := c.reKeyer(address)
+        if err != nil {
+            return nil, err
+        }
+
+        reKey, err := rk.ReEncryptionKey(ctx, address, password, recipient)
+        if err != nil {
+            return nil, err
+        }
+
+        return pre.ReEncrypt(reKey, cipherText)
+    }
+
+    plainText, err := c.Signer(address).Decrypt(ctx, cipherText, address, password)
+    if err != nil {
+        return nil, err
+    }
+
+    return keystore.EncryptFor(recipient, plainText)
+}
+
+// PublicKey returns the public key of address, from its signer if it can tell,
+// or the one recorded by RecordPublicKey.
+func (c *Signers) PublicKey(ctx context.Context, address string) (*ecdsa.PublicKey, error) {
+    c.mu.RLock()
+    pub, ok := c.pubKeys[normalizeAddress(address)]
+    c.mu.RUnlock()
+    if ok {
+        return pub, nil
+    }
+
+    if rk, ok := c.Signer(address).(ReKeyer); ok {
+        if pub, err := rk.PublicKey(ctx, address); err == nil {
+            return pub, nil
+        }
+    }
+
+    return nil, keystore.ErrUnknownPublicKey
+}
+
+// RecordPublicKey records the public key of an account managed elsewhere, e.g. recovered from its signature.
+func (c *Signers) RecordPublicKey(pub *ecdsa.PublicKey) {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    c.pubKeys[normalizeAddress(crypto.PubkeyToAddress(*pub).Hex())] = pub
+}
+
+// Accounts returns addresses of the accounts of all signer backends.
+func (c *Signers) Accounts(ctx context.Context) ([]string, error) {
+    var addrs []string
+    seen := make(map[string]bool)
+    for _, name := range []string{SignerKeyService, SignerKeystore, SignerExternal} {
+        l, ok := c.backend(name).(AccountLister)
+        if !ok {
+            continue
+        }
+
+        accs, err := l.Accounts(ctx)
+        if err != nil {
+            return nil, err
+        }
+        for _, addr := range accs {
+            if n := normalizeAddress(addr); !seen[n] {
+                seen[n] = true
+                addrs = append(addrs, addr)
+            }
+        }
+    }
+
+    return addrs, nil
+}
+
+// Import imports the account of a keystore file into the default signer, it is stored with password.
+func (c *Signers) Import(ctx context.Context, keyJson []byte, keystorePassword string, password string) (string, error) {
+    m, ok := c.backend(c.config.Default).(AccountManager)
+    if !ok {
+        return "", errkind.New(errkind.InvalidArgument, "default signer '"+c.config.Default+"' can't import accounts")
+    }
+
+    return m.Import(ctx, keyJson, keystorePassword, password)
+}
+
+// Export returns the keystore file of address encrypted with exportPassword.
+func (c *Signers) Export(ctx context.Context, address string, password string, exportPassword string) ([]byte, error) {
+    m, err := c.manager(address)
+    if err != nil {
+        return nil, err
+    }
+
+    return m.Export(ctx, address, password, exportPassword)
+}
+
+func (c *Signers) ChangePassword(ctx context.Context, address string, oldPassword string, newPassword string) error {
+    m, err := c.manager(address)
+    if err != nil {
+        return err
+    }
+
+    return m.ChangePassword(ctx, address, oldPassword, newPassword)
+}
+
+func (c *Signers) Delete(ctx context.Context, address string, password string) error {
+    m, err := c.manager(address)
+    if err != nil {
+        return err
+    }
+
+    return m.Delete(ctx, address, password)
+}
+
+// SignMessage signs an EIP-191 personal message by the signer of address, V of the signature is 27/28.
+func (c *Signers) SignMessage(ctx context.Context, message []byte, address string, password string) ([]byte, error) {
+    m, err := c.messageSigner(address)
+    if err != nil {
+        return nil, err
+    }
+
+    return m.SignMessage(ctx, message, address, password)
+}
+
+// SignTypedData signs EIP-712 typed data in json by the signer of address, V of the signature is 27/28.
+func (c *Signers) SignTypedData(ctx context.Context, typedData []byte, address string, password string) ([]byte, error) {
+    m, err := c.messageSigner(address)
+    if err != nil {
+        return nil, err
+    }
+
+    return m.SignTypedData(ctx, typedData, address, password)
+}
+
+func (c *Signers) messageSigner(address string) (MessageSigner, error) {
+    m, ok := c.Signer(address).(MessageSigner)
+    if !ok {
+        return nil, errkind.New(errkind.InvalidArgument, "signer of account "+address+" can't sign messages")
+    }
+
+    return m, nil
+}
+
+func (c *Signers) manager(address string) (AccountManager, error) {
+    m, ok := c.Signer(address).(AccountManager)
+    if !ok {
+        return nil, errkind.New(errkind.InvalidArgument, "signer of account "+address+" manages its accounts on its own")
+    }
+
+    return m, nil
+}
+
+func (c *Signers) reKeyer(address string) (ReKeyer, error) {
+    rk, ok := c.Signer(address).(ReKeyer)
+    if !ok {
+        return nil, errors.New("signer of account " + address + " can't issue re-encryption keys")
+    }
+
+    return rk, nil
+}
+
+// WrapKey returns the function which wraps data keys of envelopes for address, they are sealed
+// as Seal does, so they can be re-encrypted for others too.
+func (c *Signers) WrapKey(ctx context.Context, address string) func(dataKey []byte) ([]byte, error) {
+    return func(dataKey []byte) ([]byte, error) {
+        return c.Seal(ctx, dataKey, address)
+    }
+}
+
+// UnwrapKey returns the function which unwraps data keys of envelopes for address.
+func (c *Signers) UnwrapKey(ctx context.Context, address string, password string) func(wrappedKey []byte) ([]byte, error) {
+    return func(wrappedKey []byte) ([]byte, error) {
+        return c.Signer(address).Decrypt(ctx, wrappedKey, address, password)
+    }
+}
+
+func (c *Signers) backend(name string) Signer {
+    switch name {
+    case SignerKeyService:
+        if c.Account != nil {
+            return keyServiceSigner{account: c.Account}
+        }
+    case SignerKeystore:
+        if c.keystore != nil {
+            return c.keystore
+        }
+    case SignerExternal:
+        if c.external != nil {
+            return c.external
+        }
+    }
+
+    return nil
+}
+
+func normalizeAddress(address string) string {
+    return strings.ToLower(common.HexToAddress(address).Hex())
+}
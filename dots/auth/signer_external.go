@@ -0,0 +1,234 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/rlp"
+    "github.com/ethereum/go-ethereum/rpc"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/dots/errkind"
+    "go.uber.org/zap"
+)
+
+const (
+    extList            = "account_list"
+    extSignTransaction = "account_signTransaction"
+    extSignData        = "account_signData"
+    extSignTypedData   = "account_signTypedData"
+    // not part of clef, external signers without them can't encrypt or decrypt.
+    extEncrypt = "account_encrypt"
+    extDecrypt = "account_decrypt"
+    // not part of clef either, external signers without them can't seal for proxy re-encryption.
+    extPublicKey       = "account_publicKey"
+    extReEncryptionKey = "account_reEncryptionKey"
+)
+
+// ExternalSigner talks to a clef-style signer over JSON-RPC, the signer asks for
+// approval on its own, so passwords are not sent to it.
+type ExternalSigner struct {
+    client *rpc.Client
+}
+
+// check if 'ExternalSigner' implements 'Signer' interface.
+var _ Signer = (*ExternalSigner)(nil)
+var _ AccountLister = (*ExternalSigner)(nil)
+var _ MessageSigner = (*ExternalSigner)(nil)
+var _ ReKeyer = (*ExternalSigner)(nil)
+
+type extTxArgs struct {
+    From     common.MixedcaseAddress  `json:"from"`
+    To       *common.MixedcaseAddress `json:"to"`
+    Gas      hexutil.Uint64           `json:"gas"`
+    GasPrice hexutil.Big              `json:"gasPrice"`
+    Value    hexutil.Big              `json:"value"`
+    Nonce    hexutil.Uint64           `json:"nonce"`
+    Data     *hexutil.Bytes           `json:"data"`
+}
+
+type extSignTxResult struct {
+    Raw hexutil.Bytes `json:"raw"`
+}
+
+func NewExternalSigner(url string) (*ExternalSigner, error) {
+    client, err := rpc.Dial(url)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to dial external signer")
+    }
+
+    return &ExternalSigner{client: client}, nil
+}
+
+func (c *ExternalSigner) Close() {
+    c.client.Close()
+}
+
+func (c *ExternalSigner) Accounts(ctx context.Context) ([]string, error) {
+    var accs []common.Address
+    if err := c.client.CallContext(ctx, &accs, extList); err != nil {
+        err = errors.Wrap(err, "failed to list accounts of external signer")
+        dot.Logger().Errorln("ExternalSigner::Accounts", zap.Error(err))
+        return nil, err
+    }
+
+    addrs := make([]string, 0, len(accs))
+    for _, a := range accs {
+        addrs = append(addrs, a.Hex())
+    }
+
+    return addrs, nil
+}
+
+// SignTx asks the external signer to sign tx, the signed transaction must be tx signed by address.
+func (c *ExternalSigner) SignTx(
+    ctx context.Context,
+    signer types.Signer,
+    address common.Address,
+    tx *types.Transaction,
+    _ string,
+) (*types.Transaction, error) {
+    data := hexutil.Bytes(tx.Data())
+    args := extTxArgs{
+        From:     common.NewMixedcaseAddress(address),
+        Gas:      hexutil.Uint64(tx.Gas()),
+        GasPrice: hexutil.Big(*tx.GasPrice()),
+        Value:    hexutil.Big(*tx.Value()),
+        Nonce:    hexutil.Uint64(tx.Nonce()),
+        Data:     &data,
+    }
+    if tx.To() != nil {
+        to := common.NewMixedcaseAddress(*tx.To())
+        args.To = &to
+    }
+
+    var res extSignTxResult
+    if err := c.client.CallContext(ctx, &res, extSignTransaction, &args); err != nil {
+        err = errors.Wrap(err, "failed to signature transaction by external signer")
+        dot.Logger().Errorln("ExternalSigner::SignTx", zap.Error(err))
+        return nil, err
+    }
+
+    signed := new(types.Transaction)
+    if err := rlp.DecodeBytes(res.Raw, signed); err != nil {
+        err = errors.Wrap(err, "invalid transaction returned by external signer")
+        dot.Logger().Errorln("ExternalSigner::SignTx", zap.Error(err))
+        return nil, err
+    }
+
+    //the hash covers the nonce, price, gas, recipient, value, data and chain id, which must also be signed
+    _, eip155 := signer.(types.EIP155Signer)
+    if signer.Hash(signed) != signer.Hash(tx) || eip155 && !signer.Equal(types.NewEIP155Signer(signed.ChainId())) {
+        err := errors.New("transaction returned by external signer differs from the requested one")
+        dot.Logger().Errorln("ExternalSigner::SignTx", zap.Error(err))
+        return nil, err
+    }
+    if sender, err := types.Sender(signer, signed); err != nil || sender != address {
+        err = errors.New("transaction returned by external signer is not signed by " + address.String())
+        dot.Logger().Errorln("ExternalSigner::SignTx", zap.Error(err))
+        return nil, err
+    }
+
+    return signed, nil
+}
+
+// SignMessage signs message as text/plain data, which clef signs by its EIP-191 personal hash.
+func (c *ExternalSigner) SignMessage(ctx context.Context, message []byte, address string, _ string) ([]byte, error) {
+    var sig hexutil.Bytes
+    err := c.client.CallContext(ctx, &sig, extSignData, "text/plain", common.NewMixedcaseAddress(common.HexToAddress(address)), hexutil.Bytes(message))
+    if err != nil {
+        err = errors.Wrap(err, "failed to sign message by external signer")
+        dot.Logger().Errorln("ExternalSigner::SignMessage", zap.Error(err))
+        return nil, err
+    }
+
+    return sig, nil
+}
+
+func (c *ExternalSigner) SignTypedData(ctx context.Context, typedData []byte, address string, _ string) ([]byte, error) {
+    if !json.Valid(typedData) {
+        return nil, errkind.New(errkind.InvalidArgument, "typed data is not json")
+    }
+
+    var sig hexutil.Bytes
+    err := c.client.CallContext(ctx, &sig, extSignTypedData, common.NewMixedcaseAddress(common.HexToAddress(address)), json.RawMessage(typedData))
+    if err != nil {
+        err = errors.Wrap(err, "failed to sign typed data by external signer")
+        dot.Logger().Errorln("ExternalSigner::SignTypedData", zap.Error(err))
+        return nil, err
+    }
+
+    return sig, nil
+}
+
+func (c *ExternalSigner) Encrypt(ctx context.Context, plainText []byte, address string) ([]byte, error) {
+    var out hexutil.Bytes
+    if err := c.client.CallContext(ctx, &out, extEncrypt, common.HexToAddress(address), hexutil.Bytes(plainText)); err != nil {
+        err = errors.Wrap(err, "failed to encrypt data by external signer")
+        dot.Logger().Errorln("ExternalSigner::Encrypt", zap.Error(err))
+        return nil, err
+    }
+
+    return out, nil
+}
+
+func (c *ExternalSigner) Decrypt(ctx context.Context, cipherText []byte, address string, _ string) ([]byte, error) {
+    var out hexutil.Bytes
+    if err := c.client.CallContext(ctx, &out, extDecrypt, common.HexToAddress(address), hexutil.Bytes(cipherText)); err != nil {
+        err = errors.Wrap(err, "failed to decrypt data by external signer")
+        dot.Logger().Errorln("ExternalSigner::Decrypt", zap.Error(err))
+        return nil, err
+    }
+
+    return out, nil
+}
+
+// PublicKey returns the uncompressed public key of address from the external signer.
+func (c *ExternalSigner) PublicKey(ctx context.Context, address string) (*ecdsa.PublicKey, error) {
+    var out hexutil.Bytes
+    if err := c.client.CallContext(ctx, &out, extPublicKey, common.HexToAddress(address)); err != nil {
+        err = errors.Wrap(err, "failed to get public key from external signer")
+        dot.Logger().Errorln("ExternalSigner::PublicKey", zap.Error(err))
+        return nil, err
+    }
+
+    pub, err := crypto.UnmarshalPubkey(out)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid public key returned by external signer")
+    }
+    if crypto.PubkeyToAddress(*pub) != common.HexToAddress(address) {
+        return nil, errors.New("public key returned by external signer is not of " + address)
+    }
+
+    return pub, nil
+}
+
+// ReEncryptionKey asks the external signer for the proxy re-encryption key from address to the delegatee,
+// which is sent as its uncompressed public key.
+func (c *ExternalSigner) ReEncryptionKey(ctx context.Context, address string, _ string, delegatee *ecdsa.PublicKey) ([]byte, error) {
+    if delegatee == nil {
+        return nil, errors.New("public key of the delegatee is null")
+    }
+
+    var out hexutil.Bytes
+    err := c.client.CallContext(ctx, &out, extReEncryptionKey, common.HexToAddress(address), hexutil.Bytes(crypto.FromECDSAPub(delegatee)))
+    if err != nil {
+        err = errors.Wrap(err, "failed to issue re-encryption key by external signer")
+        dot.Logger().Errorln("ExternalSigner::ReEncryptionKey", zap.Error(err))
+        return nil, err
+    }
+
+    return out, nil
+}
+
+// Verify always succeeds, the external signer asks for approval of every request itself.
+func (c *ExternalSigner) Verify(_ context.Context, _ string, _ string) error {
+    return nil
+}
@@ -0,0 +1,142 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/hexutil"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/rlp"
+    "github.com/ethereum/go-ethereum/rpc"
+    "math/big"
+    "net/http/httptest"
+    "testing"
+)
+
+// StandInSigner answers account_signTransaction like clef does, without asking for approval,
+// go-ethereum rpc only serves exported types.
+type StandInSigner struct {
+    key    *ecdsa.PrivateKey
+    tamper func(args *StandInTxArgs) //changes the transaction before signing it
+}
+
+type StandInTxArgs extTxArgs
+
+type StandInTxResult extSignTxResult
+
+func (s *StandInSigner) SignTransaction(args StandInTxArgs) (*StandInTxResult, error) {
+    if s.tamper != nil {
+        s.tamper(&args)
+    }
+    tx := types.NewTransaction(uint64(args.Nonce), args.To.Address(), (*big.Int)(&args.Value),
+        uint64(args.Gas), (*big.Int)(&args.GasPrice), *args.Data)
+
+    signed, err := types.SignTx(tx, types.HomesteadSigner{}, s.key)
+    if err != nil {
+        return nil, err
+    }
+
+    raw, err := rlp.EncodeToBytes(signed)
+    if err != nil {
+        return nil, err
+    }
+
+    return &StandInTxResult{Raw: raw}, nil
+}
+
+func TestExternalSignerSignTx(t *testing.T) {
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    srv := rpc.NewServer()
+    if err = srv.RegisterName("account", &StandInSigner{key: key}); err != nil {
+        t.Fatal(err)
+    }
+    hs := httptest.NewServer(srv)
+    defer hs.Close()
+
+    s, err := NewExternalSigner(hs.URL)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer s.Close()
+
+    from := crypto.PubkeyToAddress(key.PublicKey)
+    tx := types.NewTransaction(7, common.HexToAddress("0x34306d87653011bbb3cdf35e742e12135ddb817a"),
+        big.NewInt(10), 21000, big.NewInt(1), []byte{1, 2, 3})
+
+    signed, err := s.SignTx(context.Background(), types.HomesteadSigner{}, from, tx, "")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    sender, err := types.Sender(types.HomesteadSigner{}, signed)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if sender != from {
+        t.Errorf("signed by %s, want %s", sender.String(), from.String())
+    }
+    if signed.Nonce() != tx.Nonce() || signed.To() == nil || *signed.To() != *tx.To() {
+        t.Error("signed transaction differs from the requested one")
+    }
+}
+
+func TestExternalSignerSignTxTampered(t *testing.T) {
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    other, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    standIn := &StandInSigner{key: key}
+    srv := rpc.NewServer()
+    if err = srv.RegisterName("account", standIn); err != nil {
+        t.Fatal(err)
+    }
+    hs := httptest.NewServer(srv)
+    defer hs.Close()
+
+    s, err := NewExternalSigner(hs.URL)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer s.Close()
+
+    from := crypto.PubkeyToAddress(key.PublicKey)
+    tx := types.NewTransaction(7, common.HexToAddress("0x34306d87653011bbb3cdf35e742e12135ddb817a"),
+        big.NewInt(10), 21000, big.NewInt(1), []byte{1, 2, 3})
+
+    for name, tamper := range map[string]func(args *StandInTxArgs){
+        "value": func(args *StandInTxArgs) { args.Value = hexutil.Big(*big.NewInt(1000)) },
+        "to": func(args *StandInTxArgs) {
+            to := common.NewMixedcaseAddress(common.HexToAddress("0x01"))
+            args.To = &to
+        },
+        "data":  func(args *StandInTxArgs) { *args.Data = hexutil.Bytes{4} },
+        "nonce": func(args *StandInTxArgs) { args.Nonce++ },
+    } {
+        standIn.tamper = tamper
+        if _, err = s.SignTx(context.Background(), types.HomesteadSigner{}, from, tx, ""); err == nil {
+            t.Error("accepted a transaction of tampered " + name)
+        }
+    }
+
+    standIn.tamper, standIn.key = nil, other
+    if _, err = s.SignTx(context.Background(), types.HomesteadSigner{}, from, tx, ""); err == nil {
+        t.Error("accepted a transaction signed by another account")
+    }
+    standIn.key = key
+    if _, err = s.SignTx(context.Background(), types.NewEIP155Signer(big.NewInt(3)), from, tx, ""); err == nil {
+        t.Error("accepted a transaction without the chain id")
+    }
+}
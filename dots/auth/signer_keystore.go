@@ -0,0 +1,151 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/auth/signdata"
+    "github.com/scryinfo/dp/dots/errkind"
+    "go.uber.org/zap"
+)
+
+// KeystoreSigner keeps keys in a local go-ethereum keystore directory, keys never leave the process.
+type KeystoreSigner struct {
+    store *keystore.Store
+}
+
+// check if 'KeystoreSigner' implements 'Signer' interface.
+var _ Signer = (*KeystoreSigner)(nil)
+var _ ReKeyer = (*KeystoreSigner)(nil)
+var _ AccountManager = (*KeystoreSigner)(nil)
+var _ MessageSigner = (*KeystoreSigner)(nil)
+
+func NewKeystoreSigner(dir string) (*KeystoreSigner, error) {
+    s, err := keystore.Open(dir, false)
+    if err != nil {
+        return nil, err
+    }
+
+    return &KeystoreSigner{store: s}, nil
+}
+
+func (c *KeystoreSigner) Store() *keystore.Store {
+    return c.store
+}
+
+func (c *KeystoreSigner) SignTx(
+    _ context.Context,
+    signer types.Signer,
+    address common.Address,
+    tx *types.Transaction,
+    password string,
+) (*types.Transaction, error) {
+    h := signer.Hash(tx)
+    sign, err := c.store.SignHash(h[:], address.String(), password)
+    if err != nil {
+        err = errors.Wrap(err, "failed to signature transaction")
+        dot.Logger().Errorln("KeystoreSigner::SignTx", zap.Error(err))
+        return nil, err
+    }
+
+    return tx.WithSignature(signer, sign)
+}
+
+func (c *KeystoreSigner) Encrypt(_ context.Context, plainText []byte, address string) ([]byte, error) {
+    out, err := c.store.Encrypt(plainText, address)
+    if err != nil {
+        err = errors.Wrap(err, "failed to encrypt data")
+        dot.Logger().Errorln("KeystoreSigner::Encrypt", zap.Error(err))
+        return nil, err
+    }
+
+    return out, nil
+}
+
+func (c *KeystoreSigner) Decrypt(_ context.Context, cipherText []byte, address string, password string) ([]byte, error) {
+    out, err := c.store.Decrypt(cipherText, address, password)
+    if err != nil {
+        err = errors.Wrap(err, "failed to decrypt data")
+        dot.Logger().Errorln("KeystoreSigner::Decrypt", zap.Error(err))
+        return nil, err
+    }
+
+    return out, nil
+}
+
+func (c *KeystoreSigner) Verify(_ context.Context, address string, password string) error {
+    return c.store.Verify(address, password)
+}
+
+func (c *KeystoreSigner) SignMessage(_ context.Context, message []byte, address string, password string) ([]byte, error) {
+    return c.signHash(signdata.PersonalHash(message), address, password)
+}
+
+func (c *KeystoreSigner) SignTypedData(_ context.Context, typedData []byte, address string, password string) ([]byte, error) {
+    td, err := signdata.ParseTypedData(typedData)
+    if err != nil {
+        return nil, errkind.Wrap(errkind.InvalidArgument, err)
+    }
+
+    hash, err := td.Hash()
+    if err != nil {
+        return nil, errkind.Wrap(errkind.InvalidArgument, err)
+    }
+
+    return c.signHash(hash, address, password)
+}
+
+func (c *KeystoreSigner) signHash(hash []byte, address string, password string) ([]byte, error) {
+    sig, err := signdata.Sign(hash, func(hash []byte) ([]byte, error) {
+        return c.store.SignHash(hash, address, password)
+    })
+    if err != nil {
+        err = errors.Wrap(err, "failed to sign data")
+        dot.Logger().Errorln("KeystoreSigner::signHash", zap.Error(err))
+        return nil, err
+    }
+
+    return sig, nil
+}
+
+func (c *KeystoreSigner) PublicKey(_ context.Context, address string) (*ecdsa.PublicKey, error) {
+    return c.store.PublicKey(address)
+}
+
+func (c *KeystoreSigner) ReEncryptionKey(_ context.Context, address string, password string, delegatee *ecdsa.PublicKey) ([]byte, error) {
+    out, err := c.store.ReKey(address, password, delegatee)
+    if err != nil {
+        err = errors.Wrap(err, "failed to issue re-encryption key")
+        dot.Logger().Errorln("KeystoreSigner::ReEncryptionKey", zap.Error(err))
+        return nil, err
+    }
+
+    return out, nil
+}
+
+func (c *KeystoreSigner) Accounts(_ context.Context) ([]string, error) {
+    return c.store.Accounts(), nil
+}
+
+func (c *KeystoreSigner) Import(_ context.Context, keyJson []byte, keystorePassword string, password string) (string, error) {
+    return c.store.Import(keyJson, keystorePassword, password)
+}
+
+func (c *KeystoreSigner) Export(_ context.Context, address string, password string, exportPassword string) ([]byte, error) {
+    return c.store.Export(address, password, exportPassword)
+}
+
+func (c *KeystoreSigner) ChangePassword(_ context.Context, address string, oldPassword string, newPassword string) error {
+    return c.store.ChangePassword(address, oldPassword, newPassword)
+}
+
+func (c *KeystoreSigner) Delete(_ context.Context, address string, password string) error {
+    return c.store.Delete(address, password)
+}
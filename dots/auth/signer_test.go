@@ -0,0 +1,195 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package auth
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/crypto/ecies"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/auth/pre"
+    "github.com/scryinfo/dp/dots/auth/signdata"
+    "github.com/scryinfo/dp/dots/errkind"
+    "io/ioutil"
+    "os"
+    "testing"
+)
+
+func newTestSigners(t *testing.T, dir string, reEncryption string) *Signers {
+    store, err := keystore.Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    return &Signers{
+        config:   signersConfig{Default: SignerKeystore, ReEncryption: reEncryption},
+        keystore: &KeystoreSigner{store: store},
+        pubKeys:  make(map[string]*ecdsa.PublicKey),
+    }
+}
+
+func TestSignersReEncrypt(t *testing.T) {
+    for _, mode := range []string{ReEncryptDecrypt, ReEncryptProxy} {
+        dir, err := ioutil.TempDir("", "signers")
+        if err != nil {
+            t.Fatal(err)
+        }
+        defer os.RemoveAll(dir)
+
+        s := newTestSigners(t, dir, mode)
+        seller, err := s.Keystore().Store().NewAccount("111111")
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        // the buyer is an account of others, only its public key is known
+        buyer, err := crypto.GenerateKey()
+        if err != nil {
+            t.Fatal(err)
+        }
+
+        ctx := context.Background()
+        ct, err := s.Seal(ctx, []byte("meta data id"), seller)
+        if err != nil {
+            t.Fatal(mode, err)
+        }
+        if pre.IsCipherText(ct) != (mode == ReEncryptProxy) {
+            t.Error(mode, "sealed with the wrong scheme")
+        }
+
+        rct, err := s.ReEncrypt(ctx, ct, seller, "111111", &buyer.PublicKey)
+        if err != nil {
+            t.Fatal(mode, err)
+        }
+        plain, err := keystoreDecrypt(buyer, rct)
+        if err != nil || string(plain) != "meta data id" {
+            t.Errorf("%s: buyer decrypted %q, error %v", mode, plain, err)
+        }
+
+        if _, err = s.ReEncrypt(ctx, ct, seller, "222222", &buyer.PublicKey); err == nil {
+            t.Error(mode, "re-encrypted with a wrong password")
+        }
+    }
+}
+
+//decrypts as the keystore of the buyer does
+func keystoreDecrypt(key *ecdsa.PrivateKey, cipherText []byte) ([]byte, error) {
+    if pre.IsCipherText(cipherText) {
+        return pre.Decrypt(key, cipherText)
+    }
+
+    return ecies.ImportECDSA(key).Decrypt(cipherText, nil, nil)
+}
+
+func TestSignersPublicKey(t *testing.T) {
+    dir, err := ioutil.TempDir("", "signers")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s := newTestSigners(t, dir, ReEncryptDecrypt)
+    local, err := s.Keystore().Store().NewAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    ctx := context.Background()
+    if pub, err := s.PublicKey(ctx, local); err != nil || crypto.PubkeyToAddress(*pub).Hex() != local {
+        t.Error("wrong public key of a local account", err)
+    }
+
+    other, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    address := crypto.PubkeyToAddress(other.PublicKey).Hex()
+    if _, err = s.PublicKey(ctx, address); err != keystore.ErrUnknownPublicKey {
+        t.Error("public key of another account is known", err)
+    }
+
+    s.RecordPublicKey(&other.PublicKey)
+    if pub, err := s.PublicKey(ctx, address); err != nil || crypto.PubkeyToAddress(*pub).Hex() != address {
+        t.Error("wrong recorded public key", err)
+    }
+}
+
+func TestSignersAccounts(t *testing.T) {
+    dir, err := ioutil.TempDir("", "signers")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s := newTestSigners(t, dir, ReEncryptDecrypt)
+    ctx := context.Background()
+    addr, err := s.Keystore().Store().NewAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if addrs, err := s.Accounts(ctx); err != nil || len(addrs) != 1 || addrs[0] != addr {
+        t.Error("wrong accounts", addrs, err)
+    }
+
+    keyJson, err := s.Export(ctx, addr, "111111", "export")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if err = s.ChangePassword(ctx, addr, "111111", "222222"); err != nil {
+        t.Fatal(err)
+    }
+    if err = s.Delete(ctx, addr, "111111"); err == nil {
+        t.Error("deleted with the old password")
+    }
+    if err = s.Delete(ctx, addr, "222222"); err != nil {
+        t.Fatal(err)
+    }
+    if addrs, _ := s.Accounts(ctx); len(addrs) != 0 {
+        t.Error("account is not deleted", addrs)
+    }
+
+    if imported, err := s.Import(ctx, keyJson, "export", "333333"); err != nil || imported != addr {
+        t.Error("failed to import", imported, err)
+    }
+
+    // accounts of external signers are managed by them
+    s.config.Accounts = map[string]string{normalizeAddress(addr): SignerExternal}
+    s.external = &ExternalSigner{}
+    if err = s.Delete(ctx, addr, "333333"); errkind.Of(err) != errkind.InvalidArgument {
+        t.Error("deleted an account of the external signer", err)
+    }
+}
+
+func TestSignersSignMessage(t *testing.T) {
+    dir, err := ioutil.TempDir("", "signers")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s := newTestSigners(t, dir, ReEncryptDecrypt)
+    addr, err := s.Keystore().Store().NewAccount("111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    ctx := context.Background()
+    sig, err := s.SignMessage(ctx, []byte("hello"), addr, "111111")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if _, err = signdata.VerifyMessage([]byte("hello"), sig, addr); err != nil || sig[64] < 27 {
+        t.Error("wrong signature of message", err)
+    }
+
+    if _, err = s.SignMessage(ctx, []byte("hello"), addr, "222222"); err == nil {
+        t.Error("signed with a wrong password")
+    }
+    if _, err = s.SignTypedData(ctx, []byte("{"), addr, "111111"); errkind.Of(err) != errkind.InvalidArgument {
+        t.Error("signed invalid typed data", err)
+    }
+}
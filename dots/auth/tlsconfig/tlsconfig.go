@@ -0,0 +1,229 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package tlsconfig
+
+import (
+    "context"
+    "crypto/tls"
+    "crypto/x509"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "go.uber.org/zap"
+    "google.golang.org/grpc/credentials"
+    "io/ioutil"
+    "net"
+    "os"
+    "sync"
+    "time"
+)
+
+// Config is the TLS configuration of a grpc client or server, all paths are PEM files.
+// A client with only CaFile uses TLS, with CertFile and KeyFile too it uses mutual TLS.
+// A server needs CertFile and KeyFile, and with CaFile it requires client certificates.
+type Config struct {
+    CaFile     string `json:"caFile"`
+    CertFile   string `json:"certFile"`
+    KeyFile    string `json:"keyFile"`
+    ServerName string `json:"serverName"`
+}
+
+func (c *Config) Enabled() bool {
+    return c.CaFile != "" || c.CertFile != "" || c.KeyFile != ""
+}
+
+// Credentials loads the files of Config and reloads them when they change on disk,
+// so certificates can be rotated without restarting.
+type Credentials struct {
+    config Config
+    server bool
+
+    mu      sync.RWMutex
+    modTime map[string]time.Time
+    cert    *tls.Certificate
+    pool    *x509.CertPool
+}
+
+// check if 'Credentials' implements 'TransportCredentials' interface.
+var _ credentials.TransportCredentials = (*Credentials)(nil)
+
+func NewClientCredentials(conf Config) (*Credentials, error) {
+    return newCredentials(conf, false)
+}
+
+func NewServerCredentials(conf Config) (*Credentials, error) {
+    if conf.CertFile == "" || conf.KeyFile == "" {
+        return nil, errors.New("certificate and key are required by tls server")
+    }
+
+    return newCredentials(conf, true)
+}
+
+func newCredentials(conf Config, server bool) (*Credentials, error) {
+    if (conf.CertFile == "") != (conf.KeyFile == "") {
+        return nil, errors.New("certificate and key must be configured together")
+    }
+
+    c := &Credentials{config: conf, server: server}
+    if err := c.load(); err != nil {
+        return nil, err
+    }
+
+    return c, nil
+}
+
+func (c *Credentials) files() []string {
+    var fs []string
+    for _, f := range []string{c.config.CaFile, c.config.CertFile, c.config.KeyFile} {
+        if f != "" {
+            fs = append(fs, f)
+        }
+    }
+
+    return fs
+}
+
+func (c *Credentials) load() error {
+    modTime := make(map[string]time.Time)
+    for _, f := range c.files() {
+        fi, err := os.Stat(f)
+        if err != nil {
+            return errors.Wrap(err, "failed to read "+f)
+        }
+        modTime[f] = fi.ModTime()
+    }
+
+    var cert *tls.Certificate
+    if c.config.CertFile != "" {
+        kp, err := tls.LoadX509KeyPair(c.config.CertFile, c.config.KeyFile)
+        if err != nil {
+            return errors.Wrap(err, "certificate and key do not match")
+        }
+
+        leaf, err := x509.ParseCertificate(kp.Certificate[0])
+        if err != nil {
+            return errors.Wrap(err, "invalid certificate "+c.config.CertFile)
+        }
+        if now := time.Now(); now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
+            return errors.New("certificate " + c.config.CertFile + " is expired or not valid yet")
+        }
+
+        kp.Leaf = leaf
+        cert = &kp
+    }
+
+    var pool *x509.CertPool
+    if c.config.CaFile != "" {
+        bs, err := ioutil.ReadFile(c.config.CaFile)
+        if err != nil {
+            return errors.Wrap(err, "failed to read "+c.config.CaFile)
+        }
+
+        pool = x509.NewCertPool()
+        if !pool.AppendCertsFromPEM(bs) {
+            return errors.New("no certificate is found in " + c.config.CaFile)
+        }
+    }
+
+    c.mu.Lock()
+    c.modTime, c.cert, c.pool = modTime, cert, pool
+    c.mu.Unlock()
+
+    return nil
+}
+
+// reload loads the files again if any of them is changed, the old ones are kept on failure.
+func (c *Credentials) reload() {
+    c.mu.RLock()
+    changed := false
+    for f, t := range c.modTime {
+        if fi, err := os.Stat(f); err == nil && !fi.ModTime().Equal(t) {
+            changed = true
+            break
+        }
+    }
+    c.mu.RUnlock()
+
+    if !changed {
+        return
+    }
+
+    if err := c.load(); err != nil {
+        dot.Logger().Errorln("failed to reload tls certificates, keep the old ones", zap.Error(err))
+        return
+    }
+
+    dot.Logger().Infoln("tls certificates are reloaded")
+}
+
+// TlsConfig returns the tls configuration made of the current certificates.
+func (c *Credentials) TlsConfig() *tls.Config {
+    c.reload()
+
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    conf := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: c.config.ServerName}
+    if c.cert != nil {
+        conf.Certificates = []tls.Certificate{*c.cert}
+    }
+
+    if c.server {
+        if c.pool != nil {
+            conf.ClientCAs = c.pool
+            conf.ClientAuth = tls.RequireAndVerifyClientCert
+        }
+    } else {
+        conf.RootCAs = c.pool
+    }
+
+    return conf
+}
+
+func (c *Credentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+    tc, info, err := credentials.NewTLS(c.TlsConfig()).ClientHandshake(ctx, authority, conn)
+    if err != nil {
+        return nil, nil, handshakeError{err}
+    }
+
+    return tc, info, nil
+}
+
+func (c *Credentials) ServerHandshake(conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+    return credentials.NewTLS(c.TlsConfig()).ServerHandshake(conn)
+}
+
+// Info leaves SecurityVersion empty, the version is negotiated by every connection
+// and is in its credentials.TLSInfo.
+func (c *Credentials) Info() credentials.ProtocolInfo {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    return credentials.ProtocolInfo{SecurityProtocol: "tls", ServerName: c.config.ServerName}
+}
+
+func (c *Credentials) Clone() credentials.TransportCredentials {
+    c.mu.RLock()
+    defer c.mu.RUnlock()
+
+    return &Credentials{config: c.config, server: c.server, modTime: c.modTime, cert: c.cert, pool: c.pool}
+}
+
+func (c *Credentials) OverrideServerName(serverNameOverride string) error {
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    c.config.ServerName = serverNameOverride
+    return nil
+}
+
+// handshakeError makes grpc give up dialing with grpc.FailOnNonTempDialError when certificates are
+// rejected, instead of retrying until the deadline, only network errors may be temporary.
+type handshakeError struct {
+    error
+}
+
+func (e handshakeError) Temporary() bool {
+    ne, ok := e.error.(net.Error)
+    return ok && ne.Temporary()
+}
@@ -0,0 +1,178 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package tlsconfig
+
+import (
+    "context"
+    "crypto/ecdsa"
+    "crypto/elliptic"
+    "crypto/rand"
+    "crypto/x509"
+    "crypto/x509/pkix"
+    "encoding/pem"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/health"
+    healthpb "google.golang.org/grpc/health/grpc_health_v1"
+    "io/ioutil"
+    "math/big"
+    "net"
+    "os"
+    "path/filepath"
+    "testing"
+    "time"
+)
+
+type testCert struct {
+    cert *x509.Certificate
+    key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert, serial int64) *testCert {
+    key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    tmpl := &x509.Certificate{
+        SerialNumber: big.NewInt(serial),
+        Subject:      pkix.Name{CommonName: cn},
+        NotBefore:    time.Now().Add(-time.Hour),
+        NotAfter:     time.Now().Add(time.Hour),
+        DNSNames:     []string{cn},
+        KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+        ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+    }
+
+    signer, signerKey := tmpl, key
+    if parent == nil {
+        tmpl.IsCA, tmpl.BasicConstraintsValid = true, true
+    } else {
+        signer, signerKey = parent.cert, parent.key
+    }
+
+    der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+    if err != nil {
+        t.Fatal(err)
+    }
+    cert, err := x509.ParseCertificate(der)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) write(t *testing.T, certFile string, keyFile string) {
+    err := ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0600)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if keyFile == "" {
+        return
+    }
+    der, err := x509.MarshalECPrivateKey(c.key)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600); err != nil {
+        t.Fatal(err)
+    }
+}
+
+func check(t *testing.T, addr string, conf Config) error {
+    creds, err := NewClientCredentials(conf)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    cn, err := grpc.Dial(addr, grpc.WithTransportCredentials(creds))
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer cn.Close()
+
+    ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+    defer cancel()
+
+    _, err = healthpb.NewHealthClient(cn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.FailFast(true))
+    return err
+}
+
+func TestMutualTls(t *testing.T) {
+    dir, err := ioutil.TempDir("", "tlsconfig")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+    path := func(name string) string { return filepath.Join(dir, name) }
+
+    ca := newTestCert(t, "ca", nil, 1)
+    ca.write(t, path("ca.pem"), "")
+    newTestCert(t, "keyservice", ca, 2).write(t, path("server.pem"), path("server.key"))
+    newTestCert(t, "binary", ca, 3).write(t, path("client.pem"), path("client.key"))
+
+    // key of another certificate must fail at startup
+    if _, err = NewServerCredentials(Config{CertFile: path("server.pem"), KeyFile: path("client.key")}); err == nil {
+        t.Error("loaded a certificate with a mismatched key")
+    }
+
+    creds, err := NewServerCredentials(Config{CaFile: path("ca.pem"), CertFile: path("server.pem"), KeyFile: path("server.key")})
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    lis, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := grpc.NewServer(grpc.Creds(creds))
+    healthpb.RegisterHealthServer(s, health.NewServer())
+    go s.Serve(lis)
+    defer s.Stop()
+    addr := lis.Addr().String()
+
+    client := Config{CaFile: path("ca.pem"), CertFile: path("client.pem"), KeyFile: path("client.key"), ServerName: "keyservice"}
+    if err = check(t, addr, client); err != nil {
+        t.Fatal("mutual tls failed", err)
+    }
+
+    if err = check(t, addr, Config{CaFile: path("ca.pem"), ServerName: "keyservice"}); err == nil {
+        t.Error("connected without client certificate")
+    }
+
+    // rotate the server certificate to one signed by another ca
+    other := newTestCert(t, "other", nil, 4)
+    other.write(t, path("other.pem"), "")
+    later := time.Now().Add(time.Second)
+    newTestCert(t, "keyservice", other, 5).write(t, path("server.pem"), path("server.key"))
+    os.Chtimes(path("server.pem"), later, later)
+    os.Chtimes(path("server.key"), later, later)
+
+    if err = check(t, addr, client); err == nil {
+        t.Error("reloaded certificate is not used")
+    }
+    client.CaFile = path("other.pem")
+    if err = check(t, addr, client); err != nil {
+        t.Error("failed to connect with the reloaded certificate", err)
+    }
+
+    // a blocking dial gives up on a rejected certificate at once
+    client.ServerName = "binary"
+    creds, err = NewClientCredentials(client)
+    if err != nil {
+        t.Fatal(err)
+    }
+    ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+    defer cancel()
+    begin := time.Now()
+    cn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds), grpc.WithBlock(), grpc.FailOnNonTempDialError(true))
+    if err == nil {
+        cn.Close()
+        t.Fatal("connected with a mismatched server name")
+    }
+    if err == context.DeadlineExceeded || time.Since(begin) > 5*time.Second {
+        t.Error("dial waited for the deadline", err)
+    }
+}
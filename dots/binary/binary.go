@@ -10,6 +10,7 @@ import (
     "github.com/pkg/errors"
     "github.com/scryinfo/dot/dot"
     "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
     "github.com/scryinfo/dp/dots/binary/scry"
     "github.com/scryinfo/dp/dots/eth/currency"
     "github.com/scryinfo/dp/dots/eth/event"
@@ -19,6 +20,9 @@ import (
     "github.com/scryinfo/dp/dots/grpc"
     "github.com/scryinfo/dp/dots/storage"
     "go.uber.org/zap"
+    "google.golang.org/grpc/connectivity"
+    "math/big"
+    "time"
 )
 
 const (
@@ -43,18 +47,21 @@ type Binary struct {
     Executor     *execute.Executor    `dot:""`
     Listener     *listen.Listener     `dot:""`
     Account      *auth.Account        `dot:""`
-    Storage      *storage.Ipfs        `dot:""`
+    Signers      *auth.Signers        `dot:""`
+    Storage      storage.Storage      `dot:"0d3dd0df-241e-4fc3-a615-c5713f9f8db7"`
+    Pins         *storage.Pins        `dot:""`
     Subscriber   *subscribe.Subscribe `dot:""`
     Grpc         *grpc.BinaryGrpcServer `dot:""`
 }
 
 type BinaryConfig struct {
-    AppId                string `json:"appId"`
-    EthSrvAddr           string `json:"ethServiceAddr"`
-    KeySrvAddr           string `json:"keyServiceAddr"`
-    StorageSrvAddr       string `json:"storageServiceAddr"`
-    ProtocolContractAddr string `json:"protocolContractAddr"`
-    TokenContractAddr    string `json:"tokenContractAddr"`
+    AppId                string           `json:"appId"`
+    EthSrvAddr           string           `json:"ethServiceAddr"`
+    KeySrvAddr           string           `json:"keyServiceAddr"`
+    KeySrvTls            tlsconfig.Config `json:"keyServiceTls"`
+    StorageSrvAddr       string           `json:"storageServiceAddr"`
+    ProtocolContractAddr string           `json:"protocolContractAddr"`
+    TokenContractAddr    string           `json:"tokenContractAddr"`
 }
 
 //construct dot
@@ -88,12 +95,13 @@ func BinTypeLive(grpcSwitch bool) []*dot.TypeLives {
         },
         execute.ExecutorTypeLive(),
         listen.ListenerTypeLive(),
-        auth.AccountTypeLive(),
-        storage.IpfsTypeLive(),
+        storage.StorageTypeLive(),
+        storage.PinsTypeLive(),
         subscribe.SubsTypeLive(),
     }
 
     t = append(t, currency.CurrTypeLive()...)
+    t = append(t, auth.SignersTypeLive()...)
 
     if grpcSwitch {
         t = append(t, grpc.BinaryGrpcServerTypeLive()...)
@@ -117,6 +125,7 @@ func (c *Binary) Config() BinaryConfig {
 
 func (c *Binary) Start(ignore bool) error {
     c.Subscriber.SetRepo(c.subsRepo)
+    c.observePins()
 
     conn, err := c.StartEngine()
     if err != nil {
@@ -133,7 +142,7 @@ func (c *Binary) Start(ignore bool) error {
         return errors.New(initContractWrapperFailed)
     }
 
-    err = c.Account.Initialize(c.config.KeySrvAddr)
+    err = c.Account.Initialize(c.config.KeySrvAddr, c.config.KeySrvTls)
     if err != nil {
         return errors.New(initAuthServiceFailed)
     }
@@ -145,6 +154,7 @@ func (c *Binary) Start(ignore bool) error {
 
     if c.Grpc != nil {
         c.Grpc.SetChainWrapper(c.chainWrapper)
+        c.Grpc.SetStatusSource(c.Status)
     }
 
     return nil
@@ -162,6 +172,69 @@ func (c *Binary) ChainWrapper() scry.ChainWrapper {
     return c.chainWrapper
 }
 
+// Status checks the node, the key service and the storage, and reports the progress of events and the cache.
+func (c *Binary) Status(ctx context.Context) grpc.BinaryStatus {
+    st := grpc.BinaryStatus{
+        Dependencies: make(map[string]error),
+        ScannedBlock: c.Listener.ScannedBlock(),
+        QueuedEvents: len(c.dataChannel),
+    }
+
+    header, err := c.chainWrapper.Conn().HeaderByNumber(ctx, nil)
+    if err == nil {
+        st.HeadBlock = header.Number.Uint64()
+    }
+    st.Dependencies["node"] = errors.Wrap(err, "failed to get the newest block")
+
+    st.Dependencies["keyService"] = nil
+    if state := c.Account.State(); state != connectivity.Ready {
+        st.Dependencies["keyService"] = errors.New("connection is " + state.String())
+    }
+
+    st.Dependencies["storage"] = nil
+    if p, ok := c.Storage.(storage.Pinger); ok {
+        st.Dependencies["storage"] = p.Ping(ctx)
+    }
+    if cache, ok := c.Storage.(*storage.Cache); ok {
+        stats := cache.Stats()
+        st.Cache = &stats
+    }
+
+    return st
+}
+
+// observePins keeps published data pinned while transactions of it are open.
+func (c *Binary) observePins() {
+    c.Executor.Observe("TransactionCreate", func(e event.Event) bool {
+        publishId, ok1 := e.Data.Get("publishId").(string)
+        txId, ok2 := e.Data.Get("transactionId").(*big.Int)
+        if !ok1 || !ok2 {
+            return false
+        }
+
+        if err := c.Pins.TransactionCreated(context.Background(), publishId, txId.String()); err != nil {
+            dot.Logger().Errorln("failed to record transaction of pinned data", zap.Error(err))
+            return false
+        }
+
+        return true
+    })
+
+    c.Executor.Observe("TransactionClose", func(e event.Event) bool {
+        txId, ok := e.Data.Get("transactionId").(*big.Int)
+        if !ok {
+            return false
+        }
+
+        if err := c.Pins.TransactionClosed(txId.String(), time.Now()); err != nil {
+            dot.Logger().Errorln("failed to record transaction of pinned data", zap.Error(err))
+            return false
+        }
+
+        return true
+    })
+}
+
 func (c *Binary) getContracts(
     protocolAddr string,
     tokenAddr string,
@@ -0,0 +1,190 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package remote
+
+import (
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/amount"
+    "github.com/scryinfo/dp/dots/binary/scry"
+    tx "github.com/scryinfo/dp/dots/eth/transaction"
+    "math/big"
+)
+
+// check if 'Remote' implements 'ChainWrapper' interface.
+var _ scry.ChainWrapper = (*Remote)(nil)
+
+// Conn returns nil, the node is only connected by the service.
+func (r *Remote) Conn() *ethclient.Client {
+    return nil
+}
+
+func (r *Remote) Publish(txParams *tx.TxParams, price *big.Int, metaDataID []byte, proofDataIDs []string,
+    proofNum int32, detailsID string, supportVerify bool) (string, error) {
+    priceDecimal, priceCompat := amount.Format(price)
+    rs, err := r.service.Publish(txParams.Ctx(), &api.PublishParams{
+        TxParam:       makeTxParams(txParams),
+        Price:         priceCompat,
+        PriceDecimal:  priceDecimal,
+        MetaDataID:    metaDataID,
+        ProofDataIDs:  proofDataIDs,
+        ProofNum:      proofNum,
+        DetailsID:     detailsID,
+        SupportVerify: supportVerify,
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return "", err
+    }
+
+    return rs.PublishId, nil
+}
+
+func (r *Remote) PrepareToBuy(txParams *tx.TxParams, publishId string, startVerify bool) error {
+    rs, err := r.service.PrepareToBuy(txParams.Ctx(), &api.PrepareParams{
+        TxParam:     makeTxParams(txParams),
+        PublishId:   publishId,
+        StartVerify: startVerify,
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) BuyData(txParams *tx.TxParams, txId *big.Int) error {
+    id, err := makeTxId(txId)
+    if err != nil {
+        return err
+    }
+
+    rs, err := r.service.BuyData(txParams.Ctx(), &api.BuyParams{TxParam: makeTxParams(txParams), TxId: id})
+
+    return callError(rs, err)
+}
+
+func (r *Remote) CancelTransaction(txParams *tx.TxParams, txId *big.Int) error {
+    id, err := makeTxId(txId)
+    if err != nil {
+        return err
+    }
+
+    rs, err := r.service.CancelTransaction(txParams.Ctx(), &api.CancelTxParams{TxParam: makeTxParams(txParams), TxId: id})
+
+    return callError(rs, err)
+}
+
+func (r *Remote) ReEncryptMetaDataId(txParams *tx.TxParams, txId *big.Int, encodedData []byte) error {
+    id, err := makeTxId(txId)
+    if err != nil {
+        return err
+    }
+
+    rs, err := r.service.ReEncryptMetaDataId(txParams.Ctx(), &api.ReEncryptDataParams{
+        TxParam:               makeTxParams(txParams),
+        TxId:                  id,
+        EncodedDataWithSeller: encodedData,
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) ConfirmDataTruth(txParams *tx.TxParams, txId *big.Int, truth bool) error {
+    id, err := makeTxId(txId)
+    if err != nil {
+        return err
+    }
+
+    rs, err := r.service.ConfirmDataTruth(txParams.Ctx(), &api.DataConfirmParams{
+        TxParam: makeTxParams(txParams),
+        TxId:    id,
+        Truth:   truth,
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) ApproveTransfer(txParams *tx.TxParams, spender common.Address, value *big.Int) error {
+    valueDecimal, valueCompat := amount.Format(value)
+    rs, err := r.service.ApproveTransfer(txParams.Ctx(), &api.ApproveTransferParams{
+        TxParam:      makeTxParams(txParams),
+        SpenderAddr:  spender.Hex(),
+        Value:        valueCompat,
+        ValueDecimal: valueDecimal,
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) Vote(txParams *tx.TxParams, txId *big.Int, judge bool, comments string) error {
+    id, err := makeTxId(txId)
+    if err != nil {
+        return err
+    }
+
+    rs, err := r.service.Vote(txParams.Ctx(), &api.VoteParams{
+        TxParam:  makeTxParams(txParams),
+        TxId:     id,
+        Judge:    judge,
+        Comments: comments,
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) RegisterAsVerifier(txParams *tx.TxParams) error {
+    rs, err := r.service.RegisterAsVerifier(txParams.Ctx(), &api.RegisterVerifierParams{TxParam: makeTxParams(txParams)})
+
+    return callError(rs, err)
+}
+
+func (r *Remote) CreditsToVerifier(txParams *tx.TxParams, txId *big.Int, index uint8, credit uint8) error {
+    id, err := makeTxId(txId)
+    if err != nil {
+        return err
+    }
+
+    rs, err := r.service.CreditsToVerifier(txParams.Ctx(), &api.CreditVerifierParams{
+        TxParam: makeTxParams(txParams),
+        TxId:    id,
+        Index:   uint32(index),
+        Credit:  uint32(credit),
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) Arbitrate(txParams *tx.TxParams, txId *big.Int, judge bool) error {
+    return ErrUnsupported
+}
+
+func (r *Remote) GetBuyer(txParams *tx.TxParams, txId *big.Int) (string, error) {
+    return "", ErrUnsupported
+}
+
+func (r *Remote) GetArbitrators(txParams *tx.TxParams, txId *big.Int) ([]string, error) {
+    return nil, ErrUnsupported
+}
+
+func (r *Remote) TransferTokens(txParams *tx.TxParams, to common.Address, value *big.Int) error {
+    valueDecimal, valueCompat := amount.Format(value)
+    rs, err := r.service.TransferTokens(txParams.Ctx(), &api.TransferTokenParams{
+        TxParam:      makeTxParams(txParams),
+        To:           to.Hex(),
+        Value:        valueCompat,
+        ValueDecimal: valueDecimal,
+    })
+
+    return callError(rs, err)
+}
+
+func (r *Remote) GetTokenBalance(txParams *tx.TxParams, owner common.Address) (*big.Int, error) {
+    rs, err := r.service.GetTokenBalance(txParams.Ctx(), &api.TokenBalanceParams{
+        TxParam: makeTxParams(txParams),
+        Owner:   owner.Hex(),
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return amount.Parse(rs.BalanceDecimal, rs.Balance)
+}
@@ -0,0 +1,405 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package remote
+
+import (
+    "context"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/amount"
+    "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/binary/scry"
+    "github.com/scryinfo/dp/dots/errkind"
+    "github.com/scryinfo/dp/dots/eth/event"
+    "github.com/scryinfo/dp/dots/storage"
+    "go.uber.org/zap"
+    "math/big"
+    "strings"
+    "sync"
+)
+
+type clientImp struct {
+    remote      *Remote
+    userAccount *auth.UserAccount
+
+    mu        sync.Mutex
+    sub       *Subscription
+    callbacks map[string]event.Callback
+}
+
+// check if 'clientImp' implements 'Client' interface.
+var _ scry.Client = (*clientImp)(nil)
+
+// Client returns the client of the account at address.
+func (r *Remote) Client(address string) scry.Client {
+    return &clientImp{
+        remote:      r,
+        userAccount: &auth.UserAccount{Addr: address},
+        callbacks:   make(map[string]event.Callback),
+    }
+}
+
+// CreateClient creates an account stored with password, and returns its client.
+func (r *Remote) CreateClient(ctx context.Context, password string) (scry.Client, error) {
+    rs, err := r.service.CreateAccount(ctx, &api.CreateAccountParams{Password: password})
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return r.Client(rs.AccountId), nil
+}
+
+// ImportClient imports the account of a keystore file, it is stored with password.
+func (r *Remote) ImportClient(ctx context.Context, keyJson []byte, keystorePassword string, password string) (scry.Client, error) {
+    rs, err := r.service.ImportAccount(ctx, &api.ImportAccountParams{
+        Keystore:         keyJson,
+        KeystorePassword: keystorePassword,
+        Password:         password,
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return r.Client(rs.AccountId), nil
+}
+
+// ListAccounts returns addresses of the accounts of all signers, token is of a session unlocked for listAccounts.
+func (r *Remote) ListAccounts(ctx context.Context, token string) ([]string, error) {
+    rs, err := r.service.ListAccounts(ctx, &api.ListAccountsParams{Token: token})
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return rs.AccountIds, nil
+}
+
+func (c *clientImp) Account() *auth.UserAccount {
+    return c.userAccount
+}
+
+// SubscribeEvent calls callback with the events named eventName, the event stream of the account is opened
+// by the first subscription. Data of the events has the types of the embedded binary, as makeEvent converts them.
+func (c *clientImp) SubscribeEvent(eventName string, callback event.Callback) error {
+    if callback == nil || eventName == "" {
+        return errkind.New(errkind.InvalidArgument, "couldn't subscribe event because of null eventCallback or empty event name")
+    }
+
+    c.mu.Lock()
+    defer c.mu.Unlock()
+
+    if c.sub == nil {
+        sub, err := c.remote.Subscribe(context.Background(), c.Account().Addr, true, c.dispatch)
+        if err != nil {
+            return err
+        }
+        c.sub = sub
+    }
+
+    c.callbacks[eventName] = callback
+    if err := c.sub.Subscribe(context.Background(), eventName); err != nil {
+        delete(c.callbacks, eventName)
+        return err
+    }
+
+    return nil
+}
+
+// UnSubscribeEvent removes the subscription of eventName, the event stream is closed with the last one.
+func (c *clientImp) UnSubscribeEvent(eventName string) error {
+    c.mu.Lock()
+    if _, ok := c.callbacks[eventName]; !ok {
+        c.mu.Unlock()
+        return errkind.New(errkind.NotFound, "couldn't find corresponding event to unsubscribe:"+eventName)
+    }
+
+    delete(c.callbacks, eventName)
+    sub := c.sub
+    last := len(c.callbacks) == 0
+    if last {
+        c.sub = nil
+    }
+    c.mu.Unlock()
+
+    //closing waits for the callback being called, which may unsubscribe too
+    if last {
+        return sub.Close()
+    }
+
+    return sub.UnSubscribe(context.Background(), eventName)
+}
+
+func (c *clientImp) dispatch(e *api.Event) {
+    c.mu.Lock()
+    callback := c.callbacks[e.Name]
+    c.mu.Unlock()
+
+    if callback == nil {
+        return
+    }
+
+    ev, err := makeEvent(e)
+    if err != nil {
+        dot.Logger().Errorln("clientImp::dispatch", zap.String("event", e.Name), zap.Error(err))
+        return
+    }
+
+    callback(ev)
+}
+
+func (c *clientImp) Authenticate(password string) (bool, error) {
+    rs, err := c.remote.service.Authenticate(context.Background(), &api.ClientInfo{Address: c.Account().Addr, Password: password})
+    if err = callError(rs, err); err != nil {
+        return false, err
+    }
+
+    return true, nil
+}
+
+// TransferEthFrom transfers eth from 'from' to the account, ec is ignored since the service uses its own node.
+func (c *clientImp) TransferEthFrom(from common.Address, password string, value *big.Int, ec *ethclient.Client) error {
+    valueDecimal, valueCompat := amount.Format(value)
+    rs, err := c.remote.service.TransferEth(context.Background(), &api.TransferEthParams{
+        From:         from.Hex(),
+        Password:     password,
+        To:           c.Account().Addr,
+        Value:        valueCompat,
+        ValueDecimal: valueDecimal,
+    })
+
+    return callError(rs, err)
+}
+
+// GetEth returns the eth balance of owner, ec is ignored since the service uses its own node.
+func (c *clientImp) GetEth(owner common.Address, ec *ethclient.Client) (*big.Int, error) {
+    rs, err := c.remote.service.GetEthBalance(context.Background(), &api.EthBalanceParams{Owner: owner.Hex()})
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return amount.Parse(rs.BalanceDecimal, rs.Balance)
+}
+
+func (c *clientImp) ExportAccount(password string, exportPassword string) ([]byte, error) {
+    rs, err := c.remote.service.ExportAccount(context.Background(), &api.ExportAccountParams{
+        Address:        c.Account().Addr,
+        Password:       password,
+        ExportPassword: exportPassword,
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return rs.KeyJson, nil
+}
+
+func (c *clientImp) ChangePassword(oldPassword string, newPassword string) error {
+    rs, err := c.remote.service.ChangePassword(context.Background(), &api.ChangePasswordParams{
+        Address:     c.Account().Addr,
+        OldPassword: oldPassword,
+        NewPassword: newPassword,
+    })
+
+    return callError(rs, err)
+}
+
+func (c *clientImp) DeleteAccount(password string) error {
+    rs, err := c.remote.service.DeleteAccount(context.Background(), &api.ClientInfo{Address: c.Account().Addr, Password: password})
+
+    return callError(rs, err)
+}
+
+func (c *clientImp) SignMessage(message []byte, password string) ([]byte, error) {
+    return c.SignMessageContext(context.Background(), message, password)
+}
+
+func (c *clientImp) SignTypedData(typedData []byte, password string) ([]byte, error) {
+    return c.SignTypedDataContext(context.Background(), typedData, password)
+}
+
+func (c *clientImp) SignMessageContext(ctx context.Context, message []byte, password string) ([]byte, error) {
+    rs, err := c.remote.service.SignMessage(ctx, &api.SignMessageParams{
+        Address:  c.Account().Addr,
+        Password: password,
+        Message:  message,
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return rs.Signature, nil
+}
+
+func (c *clientImp) SignTypedDataContext(ctx context.Context, typedData []byte, password string) ([]byte, error) {
+    rs, err := c.remote.service.SignTypedData(ctx, &api.SignTypedDataParams{
+        Address:   c.Account().Addr,
+        Password:  password,
+        TypedData: string(typedData),
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return rs.Signature, nil
+}
+
+func (c *clientImp) Decrypt(cipherText []byte, password string) ([]byte, error) {
+    return c.DecryptContext(context.Background(), cipherText, password)
+}
+
+func (c *clientImp) PurchasedMetaData(metaDataIdEncBuyer []byte, password string, maxSize int64) (string, []byte, error) {
+    return c.PurchasedMetaDataContext(context.Background(), metaDataIdEncBuyer, password, maxSize)
+}
+
+func (c *clientImp) DecryptContext(ctx context.Context, cipherText []byte, password string) ([]byte, error) {
+    rs, err := c.remote.service.Decrypt(ctx, &api.DecryptParams{
+        Address:    c.Account().Addr,
+        Password:   password,
+        CipherText: cipherText,
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return nil, err
+    }
+
+    return rs.PlainText, nil
+}
+
+// PurchasedMetaDataContext returns the meta data bought by the account, maxSize is ignored since the
+// service limits the size by its configuration.
+func (c *clientImp) PurchasedMetaDataContext(ctx context.Context, metaDataIdEncBuyer []byte, password string, maxSize int64) (string, []byte, error) {
+    rs, err := c.remote.service.GetPurchasedMetaData(ctx, &api.PurchasedMetaDataParams{
+        Address:            c.Account().Addr,
+        Password:           password,
+        MetaDataIdEncBuyer: metaDataIdEncBuyer,
+    })
+    if err = callError(rs.GetResult(), err); err != nil {
+        return "", nil, err
+    }
+
+    return rs.MetaDataId, rs.Content, nil
+}
+
+// makeEvent converts an event back to the one of the embedded binary, its data has the same types, typed
+// payloads are converted and events without one are decoded from json, whose numbers are json.Number.
+func makeEvent(e *api.Event) (event.Event, error) {
+    ev := event.Event{
+        BlockNumber: e.BlockNumber,
+        TxHash:      common.HexToHash(e.TxHash),
+        LogIndex:    uint(e.LogIndex),
+        Address:     common.HexToAddress(e.ContractAddress),
+        Name:        e.Name,
+        Data:        event.NewJSONObj(),
+    }
+
+    if e.Payload == nil {
+        return ev, decodeEventJson(e.JsonData, ev.Data)
+    }
+
+    if len(e.Users) > 0 {
+        users := make([]common.Address, 0, len(e.Users))
+        for _, u := range e.Users {
+            users = append(users, common.HexToAddress(u))
+        }
+        ev.Data.Set("users", users)
+    }
+
+    d := ev.Data
+    var err error
+    switch p := e.Payload.(type) {
+    case *api.Event_DataPublish:
+        d.Set("publishId", p.DataPublish.PublishId)
+        d.Set("despDataId", p.DataPublish.DespDataId)
+        d.Set("supportVerify", p.DataPublish.SupportVerify)
+        var price *big.Int
+        if price, err = amount.Parse(p.DataPublish.Price, 0); err == nil {
+            d.Set("price", price)
+        }
+    case *api.Event_TransactionCreate:
+        d.Set("transactionId", new(big.Int).SetUint64(p.TransactionCreate.TransactionId))
+        d.Set("publishId", p.TransactionCreate.PublishId)
+        d.Set("needVerify", p.TransactionCreate.NeedVerify)
+        d.Set("state", uint8(p.TransactionCreate.State))
+        err = setProofIds(d, p.TransactionCreate.ProofIds)
+    case *api.Event_VerifiersChosen:
+        d.Set("transactionId", new(big.Int).SetUint64(p.VerifiersChosen.TransactionId))
+        d.Set("publishId", p.VerifiersChosen.PublishId)
+        d.Set("state", uint8(p.VerifiersChosen.State))
+        err = setProofIds(d, p.VerifiersChosen.ProofIds)
+    case *api.Event_Vote:
+        d.Set("transactionId", new(big.Int).SetUint64(p.Vote.TransactionId))
+        d.Set("judge", p.Vote.Judge)
+        d.Set("comments", p.Vote.Comments)
+        d.Set("state", uint8(p.Vote.State))
+        d.Set("index", uint8(p.Vote.Index))
+    case *api.Event_Buy:
+        d.Set("transactionId", new(big.Int).SetUint64(p.Buy.TransactionId))
+        d.Set("publishId", p.Buy.PublishId)
+        d.Set("metaDataIdEncSeller", p.Buy.MetaDataIdEncSeller)
+        d.Set("state", uint8(p.Buy.State))
+        d.Set("index", uint8(p.Buy.Index))
+    case *api.Event_ReadyForDownload:
+        d.Set("transactionId", new(big.Int).SetUint64(p.ReadyForDownload.TransactionId))
+        d.Set("metaDataIdEncBuyer", p.ReadyForDownload.MetaDataIdEncBuyer)
+        d.Set("state", uint8(p.ReadyForDownload.State))
+        d.Set("index", uint8(p.ReadyForDownload.Index))
+    case *api.Event_TransactionClose:
+        d.Set("transactionId", new(big.Int).SetUint64(p.TransactionClose.TransactionId))
+        d.Set("state", uint8(p.TransactionClose.State))
+        d.Set("index", uint8(p.TransactionClose.Index))
+    case *api.Event_VerifierDisable:
+        d.Set("verifier", common.HexToAddress(p.VerifierDisable.Verifier))
+    case *api.Event_ArbitrationBegin:
+        d.Set("transactionId", new(big.Int).SetUint64(p.ArbitrationBegin.TransactionId))
+        d.Set("publishId", p.ArbitrationBegin.PublishId)
+        d.Set("metaDataIdEncArbitrator", p.ArbitrationBegin.MetaDataIdEncArbitrator)
+        err = setProofIds(d, p.ArbitrationBegin.ProofIds)
+    case *api.Event_ArbitrationResult:
+        d.Set("transactionId", new(big.Int).SetUint64(p.ArbitrationResult.TransactionId))
+        d.Set("judge", p.ArbitrationResult.Judge)
+        d.Set("identify", uint8(p.ArbitrationResult.Identify))
+    case *api.Event_Approval:
+        d.Set("owner", common.HexToAddress(p.Approval.Owner))
+        d.Set("spender", common.HexToAddress(p.Approval.Spender))
+        var value *big.Int
+        if value, err = amount.Parse(p.Approval.Value, 0); err == nil {
+            d.Set("value", value)
+        }
+    }
+    if err != nil {
+        return ev, errors.Wrap(err, "invalid event data")
+    }
+
+    return ev, nil
+}
+
+// proof IDs are content IDs recorded on chain in slots of 32 bytes.
+func setProofIds(d event.JSONObj, ids []string) error {
+    slots, err := storage.CidsToBytes32(ids)
+    if err != nil {
+        return err
+    }
+    d.Set("proofIds", slots)
+
+    return nil
+}
+
+func decodeEventJson(jsonData string, data event.JSONObj) error {
+    var obj struct {
+        EventData string
+    }
+    if err := json.Unmarshal([]byte(jsonData), &obj); err != nil {
+        return errors.Wrap(err, "invalid json of event")
+    }
+
+    d := json.NewDecoder(strings.NewReader(obj.EventData))
+    d.UseNumber()
+    if err := d.Decode(&data); err != nil {
+        return errors.Wrap(err, "invalid json of event data")
+    }
+
+    return nil
+}
@@ -0,0 +1,104 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+// Package remote implements scry.ChainWrapper and scry.Client over the gRPC BinaryService, so code
+// written for the embedded binary can use a remote one instead.
+package remote
+
+import (
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/amount"
+    "github.com/scryinfo/dp/dots/errkind"
+    tx "github.com/scryinfo/dp/dots/eth/transaction"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/status"
+    "math/big"
+)
+
+// ErrUnsupported is returned by operations which the BinaryService doesn't provide.
+var ErrUnsupported = errors.New("operation is not supported by the binary service")
+
+// Remote is a connection to a BinaryService.
+type Remote struct {
+    conn    *grpc.ClientConn
+    service api.BinaryServiceClient
+}
+
+// Dial connects the BinaryService at target, grpc.WithInsecure() must be in opts if it isn't served with tls.
+func Dial(target string, opts ...grpc.DialOption) (*Remote, error) {
+    cn, err := grpc.Dial(target, opts...)
+    if err != nil {
+        return nil, errkind.Wrap(errkind.NodeUnavailable, errors.Wrap(err, "failed to connect the binary service"))
+    }
+
+    return New(cn), nil
+}
+
+// New uses a connection made by the caller, it is closed by Close.
+func New(cn *grpc.ClientConn) *Remote {
+    return &Remote{conn: cn, service: api.NewBinaryServiceClient(cn)}
+}
+
+func (r *Remote) Close() error {
+    return r.conn.Close()
+}
+
+// Service returns the generated client, for the operations which are not wrapped.
+func (r *Remote) Service() api.BinaryServiceClient {
+    return r.service
+}
+
+// callError converts the status returned by the service to an error of the kind in its ErrorDetail,
+// the failure in result is returned if the call succeeded without one.
+func callError(result *api.Result, err error) error {
+    if err != nil {
+        s, ok := status.FromError(err)
+        if !ok {
+            return err
+        }
+        for _, d := range s.Details() {
+            if detail, ok := d.(*api.ErrorDetail); ok {
+                return errkind.New(errkind.Parse(detail.Kind), s.Message())
+            }
+        }
+
+        return errors.New(s.Message())
+    }
+
+    if result != nil && !result.Success {
+        return errors.New(result.ErrMsg)
+    }
+
+    return nil
+}
+
+func makeTxParams(p *tx.TxParams) *api.TxParams {
+    if p == nil {
+        return nil
+    }
+
+    value, valueCompat := amount.Format(p.Value)
+    gasPrice, gasPriceCompat := amount.Format(p.GasPrice)
+
+    return &api.TxParams{
+        From:            p.From.Hex(),
+        Password:        p.Password,
+        Value:           valueCompat,
+        Token:           p.Token,
+        ValueDecimal:    value,
+        Pending:         p.Pending,
+        GasPrice:        gasPriceCompat,
+        GasPriceDecimal: gasPrice,
+        GasLimit:        p.GasLimit,
+    }
+}
+
+// txIds are int64 in the api.
+func makeTxId(txId *big.Int) (int64, error) {
+    if txId == nil || !txId.IsInt64() {
+        return 0, errkind.New(errkind.InvalidArgument, "invalid transaction id")
+    }
+
+    return txId.Int64(), nil
+}
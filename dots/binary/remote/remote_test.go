@@ -0,0 +1,252 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package remote
+
+import (
+    "context"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/errkind"
+    "github.com/scryinfo/dp/dots/eth/event"
+    tx "github.com/scryinfo/dp/dots/eth/transaction"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "math/big"
+    "net"
+    "sync"
+    "testing"
+    "time"
+)
+
+// testService implements the methods used by the tests, the others panic.
+type testService struct {
+    api.BinaryServiceServer
+
+    mu         sync.Mutex
+    publish    *api.PublishParams
+    cursors    []*api.EventCursor
+    subscribed []string
+    typed      bool
+    streams    [][]*api.Event //events sent by each stream, the last one is kept open
+}
+
+func (s *testService) Publish(ctx context.Context, p *api.PublishParams) (*api.PublishResult, error) {
+    s.mu.Lock()
+    s.publish = p
+    s.mu.Unlock()
+
+    return &api.PublishResult{PublishId: "publish", Result: &api.Result{Success: true}}, nil
+}
+
+func (s *testService) BuyData(ctx context.Context, p *api.BuyParams) (*api.Result, error) {
+    st, _ := status.New(codes.FailedPrecondition, "no tokens").WithDetails(&api.ErrorDetail{Kind: "INSUFFICIENT_BALANCE"})
+    return nil, st.Err()
+}
+
+func (s *testService) GetTokenBalance(ctx context.Context, p *api.TokenBalanceParams) (*api.TokenBalanceResult, error) {
+    return &api.TokenBalanceResult{Result: &api.Result{Success: true}, BalanceDecimal: "123456789012345678901234567890"}, nil
+}
+
+func (s *testService) SubscribeEvent(ctx context.Context, info *api.SubscribeInfo) (*api.Result, error) {
+    s.mu.Lock()
+    s.subscribed = append(s.subscribed, info.Event...)
+    s.mu.Unlock()
+
+    return &api.Result{Success: true}, nil
+}
+
+func (s *testService) UnSubscribeEvent(ctx context.Context, info *api.SubscribeInfo) (*api.Result, error) {
+    return &api.Result{Success: true}, nil
+}
+
+func (s *testService) RecvEvents(info *api.ClientInfo, srv api.BinaryService_RecvEventsServer) error {
+    s.mu.Lock()
+    s.cursors = append(s.cursors, info.Cursor)
+    s.typed = info.TypedEvents
+    events := s.streams[0]
+    last := len(s.streams) == 1
+    if !last {
+        s.streams = s.streams[1:]
+    }
+    s.mu.Unlock()
+
+    srv.Send(&api.Event{Name: "ChannelCreated"})
+    for _, e := range events {
+        srv.Send(e)
+    }
+
+    if !last {
+        return status.Error(codes.Aborted, "lagged")
+    }
+    <-srv.Context().Done()
+
+    return nil
+}
+
+func startTestService(t *testing.T, s *testService) *Remote {
+    l, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+    gs := grpc.NewServer()
+    api.RegisterBinaryServiceServer(gs, s)
+    go gs.Serve(l)
+
+    r, err := Dial(l.Addr().String(), grpc.WithInsecure())
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    return r
+}
+
+func TestChainWrapper(t *testing.T) {
+    s := &testService{}
+    r := startTestService(t, s)
+    defer r.Close()
+
+    price, _ := new(big.Int).SetString("10000000000000000000000", 10)
+    txParams := &tx.TxParams{From: common.HexToAddress("0x01"), Password: "pwd", GasLimit: 21000}
+
+    id, err := r.Publish(txParams, price, []byte("meta"), []string{"proof"}, 1, "details", true)
+    if err != nil || id != "publish" {
+        t.Fatal("failed to publish", id, err)
+    }
+    if p := s.publish; p.PriceDecimal != price.String() || p.TxParam.From != txParams.From.Hex() ||
+        p.TxParam.GasLimit != 21000 || p.TxParam.ValueDecimal != "" || !p.SupportVerify {
+        t.Error("wrong publish parameters", p)
+    }
+
+    err = r.BuyData(txParams, big.NewInt(1))
+    if errkind.Of(err) != errkind.InsufficientBalance || err.Error() != "no tokens" {
+        t.Error("wrong error", err)
+    }
+
+    if err = r.BuyData(txParams, new(big.Int).Lsh(big.NewInt(1), 64)); errkind.Of(err) != errkind.InvalidArgument {
+        t.Error("sent overflowed transaction id", err)
+    }
+
+    b, err := r.GetTokenBalance(txParams, common.HexToAddress("0x02"))
+    if err != nil || b.String() != "123456789012345678901234567890" {
+        t.Error("wrong balance", b, err)
+    }
+}
+
+func TestSubscriptionResume(t *testing.T) {
+    s := &testService{streams: [][]*api.Event{
+        {{Name: "Vote", BlockNumber: 1}, {Name: "Vote", BlockNumber: 2, LogIndex: 1}},
+        {{Name: "Vote", BlockNumber: 2, LogIndex: 1}, {Name: "Vote", BlockNumber: 3}},
+    }}
+    r := startTestService(t, s)
+    defer r.Close()
+
+    received := make(chan *api.Event, 10)
+    sub, err := r.Subscribe(context.Background(), "0x01", true, func(e *api.Event) { received <- e }, "Vote")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    var blocks []uint64
+    for len(blocks) < 3 {
+        select {
+        case e := <-received:
+            blocks = append(blocks, e.BlockNumber)
+        case <-time.After(5 * time.Second):
+            t.Fatal("events are not received", blocks)
+        }
+    }
+
+    if blocks[0] != 1 || blocks[1] != 2 || blocks[2] != 3 {
+        t.Error("wrong events", blocks)
+    }
+
+    s.mu.Lock()
+    cursors, subscribed := s.cursors, s.subscribed
+    s.mu.Unlock()
+    if len(cursors) != 2 || cursors[0] != nil || cursors[1].BlockNumber != 2 || cursors[1].LogIndex != 1 {
+        t.Error("wrong cursors", cursors)
+    }
+    if len(subscribed) != 2 {
+        t.Error("events are not subscribed again", subscribed)
+    }
+
+    if err = sub.Close(); err != nil {
+        t.Error(err)
+    }
+    if sub.Err() != nil {
+        t.Error(sub.Err())
+    }
+}
+
+func TestClientEvents(t *testing.T) {
+    data := event.NewJSONObj()
+    data.Set("comments", "ok")
+    jsonData, _ := json.Marshal(map[string]interface{}{"EventName": "Custom", "EventData": data.String()})
+
+    s := &testService{streams: [][]*api.Event{{
+        {Name: "Vote", BlockNumber: 1, Users: []string{"0x01"}, Payload: &api.Event_Vote{Vote: &api.VoteEvent{
+            TransactionId: 7,
+            Judge:         true,
+            Comments:      "ok",
+            State:         2,
+            Index:         1,
+        }}},
+        {Name: "Custom", BlockNumber: 2, JsonData: string(jsonData)},
+    }}}
+    r := startTestService(t, s)
+    defer r.Close()
+
+    received := make(chan event.Event, 2)
+    callback := func(e event.Event) bool {
+        received <- e
+        return true
+    }
+    c := r.Client("0x01")
+    for _, name := range []string{"Vote", "Custom"} {
+        if err := c.SubscribeEvent(name, callback); err != nil {
+            t.Fatal(err)
+        }
+    }
+
+    for i := 0; i < 2; i++ {
+        select {
+        case e := <-received:
+            switch e.Name {
+            case "Vote":
+                // data has the types of the embedded binary
+                id, _ := e.Data.Get("transactionId").(*big.Int)
+                users, _ := e.Data.Get("users").([]common.Address)
+                if id == nil || id.Int64() != 7 || e.Data.Get("state") != uint8(2) || e.Data.Get("judge") != true ||
+                    len(users) != 1 || users[0] != common.HexToAddress("0x01") {
+                    t.Error("wrong typed event", e)
+                }
+            case "Custom":
+                if e.BlockNumber != 2 || e.Data.Get("comments") != "ok" {
+                    t.Error("wrong json event", e)
+                }
+            }
+        case <-time.After(5 * time.Second):
+            t.Fatal("event is not received")
+        }
+    }
+    s.mu.Lock()
+    typed := s.typed
+    s.mu.Unlock()
+    if !typed {
+        t.Error("typed events are not requested")
+    }
+
+    if err := c.UnSubscribeEvent("Custom"); err != nil {
+        t.Error(err)
+    }
+
+    if err := c.UnSubscribeEvent("Vote"); err != nil {
+        t.Error(err)
+    }
+    if err := c.UnSubscribeEvent("Vote"); errkind.Of(err) != errkind.NotFound {
+        t.Error("unsubscribed twice", err)
+    }
+}
@@ -0,0 +1,297 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package remote
+
+import (
+    "context"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/errkind"
+    "go.uber.org/zap"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "sync"
+    "time"
+)
+
+const (
+    minRetryDelay = 500 * time.Millisecond
+    maxRetryDelay = 30 * time.Second
+)
+
+// Subscription receives the events of an address. Its stream is reopened when it breaks, from the
+// last event received, so no event buffered by the service is lost or received twice.
+type Subscription struct {
+    remote  *Remote
+    address string
+    typed   bool
+    handler func(*api.Event)
+
+    mu     sync.Mutex
+    names  map[string]bool
+    cursor *api.EventCursor
+    err    error
+
+    ctx    context.Context
+    cancel context.CancelFunc
+    done   chan struct{}
+}
+
+// Subscribe opens the event stream of address and subscribes names, ctx bounds opening it only. handler is called
+// with the events in order, from one goroutine. Events have their typed payload only if typed is true, their json otherwise.
+func (r *Remote) Subscribe(ctx context.Context, address string, typed bool, handler func(*api.Event), names ...string) (*Subscription, error) {
+    if address == "" || handler == nil {
+        return nil, errkind.New(errkind.InvalidArgument, "address and handler of a subscription can not be empty")
+    }
+
+    s := &Subscription{
+        remote:  r,
+        address: address,
+        typed:   typed,
+        handler: handler,
+        names:   make(map[string]bool),
+        done:    make(chan struct{}),
+    }
+    s.ctx, s.cancel = context.WithCancel(context.Background())
+
+    //the service subscribes events only for addresses which have a stream
+    ready := make(chan error, 1)
+    go s.run(ready)
+    select {
+    case err := <-ready:
+        if err != nil {
+            s.cancel()
+            return nil, err
+        }
+    case <-ctx.Done():
+        s.cancel()
+        <-s.done
+        return nil, ctx.Err()
+    }
+
+    if err := s.Subscribe(ctx, names...); err != nil {
+        s.Close()
+        return nil, err
+    }
+
+    return s, nil
+}
+
+// Subscribe adds names to the subscription.
+func (s *Subscription) Subscribe(ctx context.Context, names ...string) error {
+    if len(names) == 0 {
+        return nil
+    }
+
+    rs, err := s.remote.service.SubscribeEvent(ctx, &api.SubscribeInfo{Address: s.address, Event: names})
+    if err = callError(rs, err); err != nil {
+        return err
+    }
+
+    s.mu.Lock()
+    for _, name := range names {
+        s.names[name] = true
+    }
+    s.mu.Unlock()
+
+    return nil
+}
+
+// UnSubscribe removes names from the subscription.
+func (s *Subscription) UnSubscribe(ctx context.Context, names ...string) error {
+    if len(names) == 0 {
+        return nil
+    }
+
+    s.mu.Lock()
+    for _, name := range names {
+        delete(s.names, name)
+    }
+    s.mu.Unlock()
+
+    rs, err := s.remote.service.UnSubscribeEvent(ctx, &api.SubscribeInfo{Address: s.address, Event: names})
+
+    return callError(rs, err)
+}
+
+// Cursor returns the cursor of the last event received, nil if there isn't one.
+func (s *Subscription) Cursor() *api.EventCursor {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    return s.cursor
+}
+
+// Ack tells the service that the events received are handled, it drops them from its buffer.
+// token is of a session of the address unlocked for ackEvents.
+func (s *Subscription) Ack(ctx context.Context, token string) error {
+    cursor := s.Cursor()
+    if cursor == nil {
+        return nil
+    }
+
+    rs, err := s.remote.service.AckEvents(ctx, &api.AckEventsParams{Address: s.address, Cursor: cursor, Token: token})
+
+    return callError(rs, err)
+}
+
+// Done is closed when the subscription ends, by Close or by an error which it can't recover from.
+func (s *Subscription) Done() <-chan struct{} {
+    return s.done
+}
+
+// Err returns the error which ended the subscription, nil if it isn't ended or it is closed.
+func (s *Subscription) Err() error {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    return s.err
+}
+
+// Close unsubscribes the names of the subscription and closes its stream.
+func (s *Subscription) Close() error {
+    names := s.subscribed()
+
+    var err error
+    select {
+    case <-s.done:
+    default:
+        err = s.UnSubscribe(s.ctx, names...)
+    }
+
+    s.cancel()
+    <-s.done
+
+    return err
+}
+
+func (s *Subscription) run(ready chan<- error) {
+    defer close(s.done)
+
+    delay := minRetryDelay
+    for {
+        stream, err := s.open()
+        if err == nil {
+            if ready != nil {
+                ready <- nil
+                ready = nil
+            } else {
+                s.resubscribe()
+            }
+            delay = minRetryDelay
+
+            err = s.recv(stream)
+        }
+
+        if s.ctx.Err() != nil {
+            return
+        }
+        if ready != nil {
+            ready <- callError(nil, err)
+            return
+        }
+        if !retryable(err) {
+            s.mu.Lock()
+            s.err = callError(nil, err)
+            s.mu.Unlock()
+            return
+        }
+
+        dot.Logger().Warnln("Subscription::run", zap.String("address", s.address), zap.Duration("retry", delay), zap.Error(err))
+
+        select {
+        case <-time.After(delay):
+        case <-s.ctx.Done():
+            return
+        }
+        if delay *= 2; delay > maxRetryDelay {
+            delay = maxRetryDelay
+        }
+    }
+}
+
+//the stream is open when its ChannelCreated event is received
+func (s *Subscription) open() (api.BinaryService_RecvEventsClient, error) {
+    stream, err := s.remote.service.RecvEvents(s.ctx, &api.ClientInfo{
+        Address:     s.address,
+        TypedEvents: s.typed,
+        Cursor:      s.Cursor(),
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    e, err := stream.Recv()
+    if err != nil {
+        return nil, err
+    }
+    s.deliver(e)
+
+    return stream, nil
+}
+
+func (s *Subscription) recv(stream api.BinaryService_RecvEventsClient) error {
+    for {
+        e, err := stream.Recv()
+        if err != nil {
+            return err
+        }
+        s.deliver(e)
+    }
+}
+
+//events after the cursor are delivered only, events which are not on chain have no cursor
+func (s *Subscription) deliver(e *api.Event) {
+    if e.Name == "ChannelCreated" {
+        return
+    }
+
+    if e.BlockNumber > 0 {
+        s.mu.Lock()
+        if c := s.cursor; c != nil && (e.BlockNumber < c.BlockNumber ||
+            e.BlockNumber == c.BlockNumber && e.LogIndex <= c.LogIndex) {
+            s.mu.Unlock()
+            return
+        }
+        s.cursor = &api.EventCursor{BlockNumber: e.BlockNumber, LogIndex: e.LogIndex}
+        s.mu.Unlock()
+    }
+
+    s.handler(e)
+}
+
+//subscriptions are lost if the service is restarted, subscribing names again is harmless otherwise
+func (s *Subscription) resubscribe() {
+    names := s.subscribed()
+    if len(names) == 0 {
+        return
+    }
+
+    rs, err := s.remote.service.SubscribeEvent(s.ctx, &api.SubscribeInfo{Address: s.address, Event: names})
+    if err = callError(rs, err); err != nil {
+        dot.Logger().Errorln("Subscription::resubscribe", zap.String("address", s.address), zap.Error(err))
+    }
+}
+
+func (s *Subscription) subscribed() []string {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    names := make([]string, 0, len(s.names))
+    for name := range s.names {
+        names = append(names, name)
+    }
+
+    return names
+}
+
+func retryable(err error) bool {
+    switch status.Code(errors.Cause(err)) {
+    case codes.InvalidArgument, codes.Unauthenticated, codes.PermissionDenied, codes.Unimplemented:
+        return false
+    }
+
+    return true
+}
@@ -10,6 +10,27 @@ import (
     "math/big"
 )
 
+// operations which sessions can be unlocked for.
+const (
+    OpPublish             = "publish"
+    OpPrepareToBuy        = "prepareToBuy"
+    OpBuyData             = "buyData"
+    OpCancelTransaction   = "cancelTransaction"
+    OpReEncryptMetaDataId = "reEncryptMetaDataId"
+    OpConfirmDataTruth    = "confirmDataTruth"
+    OpApproveTransfer     = "approveTransfer"
+    OpVote                = "vote"
+    OpRegisterAsVerifier  = "registerAsVerifier"
+    OpCreditsToVerifier   = "creditsToVerifier"
+    OpArbitrate           = "arbitrate"
+    OpTransferTokens      = "transferTokens"
+    OpTransferEth         = "transferEth"
+    OpDecrypt             = "decrypt"
+    OpSign                = "sign"
+    OpListAccounts        = "listAccounts"
+    OpAckEvents           = "ackEvents"
+)
+
 type ChainWrapper interface {
     Conn() *ethclient.Client
     Publish(txParams *tx.TxParams, price *big.Int, metaDataID []byte, proofDataIDs []string,
@@ -4,25 +4,35 @@
 package scry
 
 import (
-    "errors"
-    "github.com/btcsuite/btcutil/base58"
+    "bufio"
+    "context"
+    "crypto/ecdsa"
+    "github.com/pkg/errors"
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
     "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
     "github.com/ethereum/go-ethereum/ethclient"
     "github.com/scryinfo/dot/dot"
     "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/auth/signdata"
     "github.com/scryinfo/dp/dots/binary/stub/contract"
+    "github.com/scryinfo/dp/dots/errkind"
     tx "github.com/scryinfo/dp/dots/eth/transaction"
+    "github.com/scryinfo/dp/dots/storage"
     "github.com/scryinfo/dp/util"
     "go.uber.org/zap"
     "math/big"
 )
 
 type chainWrapperImp struct {
-    conn     *ethclient.Client
-    protocol *contract.ScryProtocol
+    conn         *ethclient.Client
+    protocol     *contract.ScryProtocol
+    protocolAddr common.Address
     token    *contract.ScryToken
     Tx       *tx.Transaction `dot:"a3e1a88e-f84e-4285-b5ff-54a16fdcd44c"`
-    Account  *auth.Account   `dot:"ca1c6ce4-182b-430a-9813-caeccf83f8ab"`
+    Signers  *auth.Signers   `dot:"02b7d24f-9e05-4afc-ba8d-955265b231d6"`
+    Pins     *storage.Pins   `dot:""`
+    Storage  storage.Storage `dot:"0d3dd0df-241e-4fc3-a615-c5713f9f8db7"`
     appId    string
 }
 
@@ -50,6 +60,7 @@ func NewChainWrapper(protocolContractAddress common.Address,
     }
 
     c.conn = clientConn
+    c.protocolAddr = protocolContractAddress
     c.appId = appId
 
     //load components
@@ -72,58 +83,70 @@ func (c *chainWrapperImp) Publish(txParams *tx.TxParams, price *big.Int, metaDat
         }
     }()
 
+    txParams, err := c.Tx.Authorize(txParams, OpPublish)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::Publish", zap.Error(err))
+        return "", err
+    }
+
     //generate publishId
     publishId := util.GenerateUUID()
 
-    pdIDs := make([][32]byte, proofNum)
-    var err error = nil
-    for i := int32(0); i < proofNum; i++ {
-        pdIDs[i], err = ipfsHashToBytes32(proofDataIDs[i])
-        if err != nil {
-            logger.Errorln("failed to convert ipfs hash to bytes32")
-            return "", err
-        }
+    if proofNum < 0 || int(proofNum) > len(proofDataIDs) {
+        logger.Errorln("", zap.Int32("invalid number of proof data IDs", proofNum))
+        return "", errkind.New(errkind.InvalidArgument, "invalid number of proof data IDs")
     }
 
-    encMetaId, err := c.Account.Encrypt(metaDataID, txParams.From.String())
+    pdIDs, err := storage.CidsToBytes32(proofDataIDs[:proofNum])
     if err != nil {
-        logger.Errorln("", zap.NamedError("failed to encrypt meta data hash, error: ", err))
+        logger.Errorln("failed to convert ipfs hash to bytes32", zap.Error(err))
         return "", err
     }
 
-    t, err := c.protocol.PublishDataInfo(c.Tx.BuildTransactOpts(txParams), c.appId, publishId, price,
-        encMetaId, pdIDs, detailsID, supportVerify)
+    metaId, dataKey, err := c.sealMetaData(txParams, string(metaDataID))
     if err != nil {
-        logger.Errorln("", zap.NamedError("failed to publish data information, error: ", err))
+        logger.Errorln("failed to seal meta data", zap.Error(err))
         return "", err
     }
 
-    logger.Debugln("publish Tx: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
-
-    return publishId, nil
-}
+    encMetaId, err := c.Signers.Seal(txParams.Ctx(), []byte(metaId), txParams.From.String())
+    if err != nil {
+        logger.Errorln("", zap.NamedError("failed to encrypt meta data hash, error: ", err))
+        return "", err
+    }
 
-func ipfsHashToBytes32(src string) ([32]byte, error) {
-    hashArray1 := base58.Decode(src)
-    if len(hashArray1) != 34 {
-        var nilArray [32]byte
-        return nilArray, errors.New("invalid ipfs hash")
+    //the seller's record keeps the wrapped data key, so it is re-wrapped for buyers without decrypting the id
+    if dataKey != nil {
+        encMetaId, err = EncodeRecipients([]Recipient{{Address: txParams.From, CipherText: encMetaId, DataKey: dataKey}})
+        if err != nil {
+            logger.Errorln("failed to encode meta data hash", zap.Error(err))
+            return "", err
+        }
     }
 
-    var hashArray2 [32]byte
-    copy(hashArray2[:], hashArray1[2:])
-    return hashArray2, nil
-}
+    if c.Pins != nil {
+        ids := append([]string{metaId, detailsID}, proofDataIDs[:proofNum]...)
+        if err = c.Pins.Track(txParams.Ctx(), publishId, ids...); err != nil {
+            logger.Errorln("failed to pin published data", zap.Error(err))
+            return "", err
+        }
+    }
 
-func Bytes32ToIpfsHash(value [32]byte) (string, error) {
-    byteArray := [34]byte{18, 32}
-    copy(byteArray[2:], value[:])
-    if len(byteArray) != 34 {
-        return "", errors.New("invalid bytes32 value")
+    t, err := c.protocol.PublishDataInfo(c.Tx.BuildTransactOpts(txParams), c.appId, publishId, price,
+        encMetaId, pdIDs, detailsID, supportVerify)
+    if err != nil {
+        logger.Errorln("", zap.NamedError("failed to publish data information, error: ", err))
+        if c.Pins != nil {
+            if er := c.Pins.Forget(txParams.Ctx(), publishId); er != nil {
+                logger.Errorln("failed to unpin data which failed to publish", zap.Error(er))
+            }
+        }
+        return "", ethError(err)
     }
 
-    hash := base58.Encode(byteArray[:])
-    return hash, nil
+    logger.Debugln("publish Tx: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
+
+    return publishId, nil
 }
 
 func (c *chainWrapperImp) PrepareToBuy(txParams *tx.TxParams, publishId string, startVerify bool) error {
@@ -133,30 +156,50 @@ func (c *chainWrapperImp) PrepareToBuy(txParams *tx.TxParams, publishId string,
         }
     }()
 
+    txParams, err := c.Tx.Authorize(txParams, OpPrepareToBuy)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::PrepareToBuy", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.CreateTransaction(c.Tx.BuildTransactOpts(txParams), c.appId, publishId, startVerify)
     if err == nil {
         dot.Logger().Debugln("CreateTransaction: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
+    } else if errkind.Of(ethError(err)) == errkind.ContractReverted {
+        return c.diagnose(txParams, err)
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) BuyData(txParams *tx.TxParams, txId *big.Int) error {
+    txParams, err := c.Tx.Authorize(txParams, OpBuyData)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::BuyData", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.BuyData(c.Tx.BuildTransactOpts(txParams), c.appId, txId)
     if err == nil {
         dot.Logger().Debugln("BuyData: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) CancelTransaction(txParams *tx.TxParams, txId *big.Int) error {
+    txParams, err := c.Tx.Authorize(txParams, OpCancelTransaction)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::CancelTransaction", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.CancelTransaction(c.Tx.BuildTransactOpts(txParams), c.appId, txId)
     if err == nil {
         dot.Logger().Debugln("CancelTransaction tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) ReEncryptMetaDataId(
@@ -164,19 +207,37 @@ func (c *chainWrapperImp) ReEncryptMetaDataId(
     txId *big.Int,
     encodedData []byte,
 ) error {
-    buyer, err := c.protocol.GetBuyer(c.Tx.BuildCallOpts(txParams), txId)
+    txParams, err := c.Tx.Authorize(txParams, OpReEncryptMetaDataId)
     if err != nil {
         dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
         return err
     }
 
+    buyer, err := c.protocol.GetBuyer(c.Tx.BuildCallOpts(txParams), txId)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
+        return ethError(err)
+    }
+
     if buyer == common.HexToAddress("0x0"){
         e := "invalid buyer address"
         dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.String("error:", e))
         return errors.New(e)
     }
 
-    edb, err := c.Account.ReEncrypt(encodedData, txParams.From.String(), buyer.String(), txParams.Password)
+    seller, err := RecipientFor(encodedData, txParams.From.String())
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
+        return errkind.Wrap(errkind.InvalidArgument, err)
+    }
+
+    rb, err := c.recipient(txParams, seller, buyer, txId)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
+        return err
+    }
+
+    edb, err := EncodeRecipients([]Recipient{rb})
     if err != nil {
         dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
         return err
@@ -184,7 +245,12 @@ func (c *chainWrapperImp) ReEncryptMetaDataId(
 
     //re-encrypt with arbitrators public key
     arbitrators, err := c.protocol.GetArbitrators(c.Tx.BuildCallOpts(txParams), txId)
-    var edaList []byte
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
+        return ethError(err)
+    }
+
+    eas := make([]Recipient, 0, len(arbitrators))
     for _, ab := range arbitrators {
         if ab == common.HexToAddress("0x0"){
             e := "invalid arbitrator address"
@@ -192,13 +258,19 @@ func (c *chainWrapperImp) ReEncryptMetaDataId(
             return errors.New(e)
         }
 
-        eda, err := c.Account.ReEncrypt(encodedData, txParams.From.String(), ab.String(), txParams.Password)
+        ra, err := c.recipient(txParams, seller, ab, nil)
         if err != nil {
             dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
             return err
         }
 
-        edaList = append(edaList, eda...)
+        eas = append(eas, ra)
+    }
+
+    edaList, err := EncodeRecipients(eas)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ReEncryptMetaDataId", zap.Error(err))
+        return err
     }
 
     //submit
@@ -207,16 +279,94 @@ func (c *chainWrapperImp) ReEncryptMetaDataId(
         dot.Logger().Debugln("ReEncryptMetaDataIdBySeller: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
+}
+
+// sealMetaData stores the meta data of id as an envelope whose data key is wrapped for the seller, and
+// returns the id of the envelope and the wrapped key, which is nil if the meta data isn't sealed.
+func (c *chainWrapperImp) sealMetaData(txParams *tx.TxParams, id string) (string, []byte, error) {
+    if c.Storage == nil {
+        return id, nil, nil
+    }
+
+    rc, err := c.Storage.Open(txParams.Ctx(), id)
+    if err != nil {
+        return "", nil, err
+    }
+    defer rc.Close()
+
+    br := bufio.NewReader(rc)
+    if head, _ := br.Peek(4); storage.IsEnvelope(head) {
+        dataKey, err := storage.WrappedKey(br)
+        return id, dataKey, err
+    }
+
+    var dataKey []byte
+    wrap := c.Signers.WrapKey(txParams.Ctx(), txParams.From.String())
+    sealed, err := c.Storage.SaveEncrypted(br, func(key []byte) ([]byte, error) {
+        var er error
+        dataKey, er = wrap(key)
+        return dataKey, er
+    })
+
+    return sealed, dataKey, err
+}
+
+// recipient re-encrypts the record of the seller for address, who sent the transaction txId
+// or registered as verifier if it is nil.
+func (c *chainWrapperImp) recipient(
+    txParams *tx.TxParams,
+    seller Recipient,
+    address common.Address,
+    txId *big.Int,
+) (Recipient, error) {
+    pub, err := c.publicKey(txParams, address, txId)
+    if err != nil {
+        return Recipient{}, err
+    }
+
+    return reEncryptRecipient(txParams.Ctx(), c.Signers, seller, txParams.Password, address, pub)
+}
+
+// reEncryptRecipient re-encrypts the meta data id and the data key of the seller for the recipient, with
+// proxy re-encryption neither is decrypted on the way.
+func reEncryptRecipient(
+    ctx context.Context,
+    signers *auth.Signers,
+    seller Recipient,
+    password string,
+    address common.Address,
+    pub *ecdsa.PublicKey,
+) (Recipient, error) {
+    from := seller.Address.String()
+    r := Recipient{Address: address}
+
+    var err error
+    if r.CipherText, err = signers.ReEncrypt(ctx, seller.CipherText, from, password, pub); err != nil {
+        return Recipient{}, err
+    }
+    if seller.DataKey != nil {
+        if r.DataKey, err = signers.ReEncrypt(ctx, seller.DataKey, from, password, pub); err != nil {
+            return Recipient{}, err
+        }
+    }
+
+    return r, nil
 }
 
 func (c *chainWrapperImp) Arbitrate(txParams *tx.TxParams, txId *big.Int, judge bool) error {
+    txParams, err := c.Tx.Authorize(txParams, OpArbitrate)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::Arbitrate", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.Arbitrate(c.Tx.BuildTransactOpts(txParams), c.appId, txId, judge)
     if err == nil {
         dot.Logger().Debugln("Arbitrate: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) GetBuyer(txParams *tx.TxParams, txId *big.Int) (string, error) {
@@ -225,7 +375,7 @@ func (c *chainWrapperImp) GetBuyer(txParams *tx.TxParams, txId *big.Int) (string
         dot.Logger().Debugln("Get buyer, buyer: " + buyer.String())
     }
 
-    return buyer.String(), err
+    return buyer.String(), ethError(err)
 }
 
 func (c *chainWrapperImp) GetArbitrators(txParams *tx.TxParams, txId *big.Int) ([]string, error) {
@@ -240,64 +390,201 @@ func (c *chainWrapperImp) GetArbitrators(txParams *tx.TxParams, txId *big.Int) (
         dot.Logger().Debugln("Get arbitrator:", zap.Strings("arbitrators", arbitrators))
     }
 
-    return arbitrators, err
+    return arbitrators, ethError(err)
 }
 
 func (c *chainWrapperImp) ConfirmDataTruth(txParams *tx.TxParams, txId *big.Int, truth bool) error {
+    txParams, err := c.Tx.Authorize(txParams, OpConfirmDataTruth)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ConfirmDataTruth", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.ConfirmDataTruth(c.Tx.BuildTransactOpts(txParams), c.appId, txId, truth)
     if err == nil {
         dot.Logger().Debugln("ConfirmDataTruth: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) ApproveTransfer(txParams *tx.TxParams, spender common.Address, value *big.Int) error {
+    txParams, err := c.Tx.Authorize(txParams, OpApproveTransfer)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::ApproveTransfer", zap.Error(err))
+        return err
+    }
+
     t, err := c.token.Approve(c.Tx.BuildTransactOpts(txParams), spender, value)
     if err == nil {
         dot.Logger().Debugln("ApproveTransfer: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) Vote(txParams *tx.TxParams, txId *big.Int, judge bool, comments string) error {
+    txParams, err := c.Tx.Authorize(txParams, OpVote)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::Vote", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.Vote(c.Tx.BuildTransactOpts(txParams), c.appId, txId, judge, comments)
     if err == nil {
         dot.Logger().Debugln("Vote: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
 
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) RegisterAsVerifier(txParams *tx.TxParams) error {
+    txParams, err := c.Tx.Authorize(txParams, OpRegisterAsVerifier)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::RegisterAsVerifier", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.RegisterAsVerifier(c.Tx.BuildTransactOpts(txParams), c.appId)
     if err == nil {
         dot.Logger().Debugln("RegisterAsVerifier: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) CreditsToVerifier(txParams *tx.TxParams, txId *big.Int, index uint8, credit uint8) error {
+    txParams, err := c.Tx.Authorize(txParams, OpCreditsToVerifier)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::CreditsToVerifier", zap.Error(err))
+        return err
+    }
+
     t, err := c.protocol.CreditsToVerifier(c.Tx.BuildTransactOpts(txParams), c.appId, txId, index, credit)
     if err == nil {
         dot.Logger().Debugln("CreditsToVerifier: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) TransferTokens(txParams *tx.TxParams, to common.Address, value *big.Int) error {
+    txParams, err := c.Tx.Authorize(txParams, OpTransferTokens)
+    if err != nil {
+        dot.Logger().Errorln("chainWrapperImp::TransferTokens", zap.Error(err))
+        return err
+    }
+
+    //the token reverts transfers to the zero address and of more than the balance, the balance is
+    //only checked when the transfer reverts
+    if to == (common.Address{}) {
+        return errkind.New(errkind.InvalidArgument, "tokens can not be transferred to the zero address")
+    }
+
     t, err := c.token.Transfer(c.Tx.BuildTransactOpts(txParams), to, value)
     if err == nil {
         dot.Logger().Debugln("TransferTokens: tx hash:"+t.Hash().String(), zap.Binary(" tx data:", t.Data()))
+    } else if errkind.Of(ethError(err)) == errkind.ContractReverted {
+        balance, er := c.token.BalanceOf(c.Tx.BuildCallOpts(txParams), txParams.From)
+        if er == nil && balance.Cmp(value) < 0 {
+            return errkind.Wrap(errkind.InsufficientBalance, errors.Wrap(err, "token balance is less than "+value.String()))
+        }
     }
 
-    return err
+    return ethError(err)
 }
 
 func (c *chainWrapperImp) GetTokenBalance(txParams *tx.TxParams, owner common.Address) (*big.Int, error) {
-    return c.token.BalanceOf(c.Tx.BuildCallOpts(txParams), owner)
+    balance, err := c.token.BalanceOf(c.Tx.BuildCallOpts(txParams), owner)
+    return balance, ethError(err)
+}
+
+// diagnose finds out why creating a transaction is reverted, when the buyer has no tokens
+// or hasn't approved the protocol to transfer them.
+func (c *chainWrapperImp) diagnose(txParams *tx.TxParams, err error) error {
+    opts := c.Tx.BuildCallOpts(txParams)
+
+    balance, er := c.token.BalanceOf(opts, txParams.From)
+    if er == nil && balance.Sign() == 0 {
+        return errkind.Wrap(errkind.InsufficientBalance, errors.Wrap(err, "no token balance"))
+    }
+
+    allowance, er := c.token.Allowance(opts, txParams.From, c.protocolAddr)
+    if er == nil && allowance.Sign() == 0 {
+        return errkind.Wrap(errkind.InsufficientAllowance, errors.Wrap(err, "no tokens are approved to the protocol"))
+    }
+
+    return ethError(err)
+}
+
+// publicKey returns the public key of a buyer (txId isn't nil) or an arbitrator, whose accounts are usually
+// managed elsewhere. It is recovered from a transaction sent by them, the buy of txId or the registration
+// as verifier, and recorded for later.
+func (c *chainWrapperImp) publicKey(txParams *tx.TxParams, address common.Address, txId *big.Int) (*ecdsa.PublicKey, error) {
+    ctx := txParams.Ctx()
+    if pub, err := c.Signers.PublicKey(ctx, address.String()); err == nil {
+        return pub, nil
+    }
+
+    txHash, err := c.sentBy(ctx, address, txId)
+    if err != nil {
+        return nil, err
+    }
+
+    t, _, err := c.conn.TransactionByHash(ctx, txHash)
+    if err != nil {
+        return nil, ethError(err)
+    }
+
+    pub, err := signdata.RecoverTxPublicKey(t)
+    if err != nil {
+        return nil, err
+    }
+    if crypto.PubkeyToAddress(*pub) != address {
+        return nil, errors.New("transaction " + txHash.Hex() + " is not sent by " + address.String())
+    }
+    c.Signers.RecordPublicKey(pub)
+
+    return pub, nil
+}
+
+func (c *chainWrapperImp) sentBy(ctx context.Context, address common.Address, txId *big.Int) (common.Hash, error) {
+    opts := &bind.FilterOpts{Context: ctx}
+
+    if txId != nil {
+        it, err := c.protocol.FilterBuy(opts)
+        if err != nil {
+            return common.Hash{}, ethError(err)
+        }
+        defer it.Close()
+
+        //the event of index 1 is the one for the buyer
+        for it.Next() {
+            e := it.Event
+            if e.TransactionId.Cmp(txId) == 0 && e.Index == 1 && len(e.Users) == 1 && e.Users[0] == address {
+                return e.Raw.TxHash, nil
+            }
+        }
+        if err = it.Error(); err != nil {
+            return common.Hash{}, ethError(err)
+        }
+    } else {
+        it, err := c.protocol.FilterRegisterVerifier(opts)
+        if err != nil {
+            return common.Hash{}, ethError(err)
+        }
+        defer it.Close()
+
+        for it.Next() {
+            if e := it.Event; len(e.Users) == 1 && e.Users[0] == address {
+                return e.Raw.TxHash, nil
+            }
+        }
+        if err = it.Error(); err != nil {
+            return common.Hash{}, ethError(err)
+        }
+    }
+
+    return common.Hash{}, errkind.New(errkind.NotFound, "no transaction of "+address.String()+" to recover its public key from")
 }
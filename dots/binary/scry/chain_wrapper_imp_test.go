@@ -0,0 +1,120 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package scry
+
+import (
+    "context"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/scryinfo/dp/dots/auth"
+    dpKeystore "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/auth/keyservice"
+    "github.com/scryinfo/dp/dots/auth/pre"
+    authStub "github.com/scryinfo/dp/dots/auth/stub"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "io/ioutil"
+    "net"
+    "os"
+    "sync/atomic"
+    "testing"
+)
+
+// noDecryptKeyService fails every ContentDecrypt, plain texts must never reach it with proxy re-encryption.
+type noDecryptKeyService struct {
+    *keyservice.KeyService
+    decrypts int32
+}
+
+func (s *noDecryptKeyService) ContentDecrypt(ctx context.Context, in *authStub.CipherParameter) (*authStub.CipherText, error) {
+    atomic.AddInt32(&s.decrypts, 1)
+    return nil, status.Error(codes.PermissionDenied, "decryption isn't allowed")
+}
+
+func TestReEncryptRecipientProxy(t *testing.T) {
+    dir, err := ioutil.TempDir("", "reencrypt")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    store, err := dpKeystore.Open(dir, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+    ks := &noDecryptKeyService{KeyService: keyservice.NewKeyService(store)}
+
+    lis, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+    gs := grpc.NewServer()
+    authStub.RegisterKeyServiceServer(gs, ks)
+    go gs.Serve(lis)
+    defer gs.Stop()
+
+    d, err := auth.AccountTypeLive().Meta.NewDoter(nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    acc := d.(*auth.Account)
+    if err = acc.Initialize(lis.Addr().String(), tlsconfig.Config{}); err != nil {
+        t.Fatal(err)
+    }
+    defer acc.Destroy(true)
+
+    d, err = auth.SignersTypeLive()[0].Meta.NewDoter([]byte(`{"default":"keyService","reEncryption":"proxy"}`))
+    if err != nil {
+        t.Fatal(err)
+    }
+    signers := d.(*auth.Signers)
+    if err = signers.Create(nil); err != nil {
+        t.Fatal(err)
+    }
+    signers.Account = acc
+
+    seller, err := store.NewAccount("seller")
+    if err != nil {
+        t.Fatal(err)
+    }
+    buyer, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    ctx := context.Background()
+    encMetaId, err := signers.Seal(ctx, []byte("meta data id"), seller)
+    if err != nil {
+        t.Fatal(err)
+    }
+    dataKey, err := signers.WrapKey(ctx, seller)([]byte("data key"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    data, err := EncodeRecipients([]Recipient{{Address: common.HexToAddress(seller), CipherText: encMetaId, DataKey: dataKey}})
+    if err != nil {
+        t.Fatal(err)
+    }
+    sellerRecord, err := RecipientFor(data, seller)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    r, err := reEncryptRecipient(ctx, signers, sellerRecord, "seller", crypto.PubkeyToAddress(buyer.PublicKey), &buyer.PublicKey)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if n := atomic.LoadInt32(&ks.decrypts); n != 0 {
+        t.Fatal("the key service decrypted during re-encryption", n)
+    }
+
+    if plain, err := pre.Decrypt(buyer, r.CipherText); err != nil || string(plain) != "meta data id" {
+        t.Error("buyer can't decrypt the meta data id", string(plain), err)
+    }
+    if plain, err := pre.Decrypt(buyer, r.DataKey); err != nil || string(plain) != "data key" {
+        t.Error("buyer can't unwrap the data key", string(plain), err)
+    }
+}
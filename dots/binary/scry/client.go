@@ -4,6 +4,7 @@
 package scry
 
 import (
+    "context"
     "github.com/ethereum/go-ethereum/common"
     "github.com/ethereum/go-ethereum/ethclient"
     "github.com/scryinfo/dp/dots/auth"
@@ -18,4 +19,15 @@ type Client interface {
     Authenticate(password string) (bool, error)
     TransferEthFrom(from common.Address, password string, value *big.Int, ec *ethclient.Client) error
     GetEth(owner common.Address, ec *ethclient.Client) (*big.Int, error)
+    ExportAccount(password string, exportPassword string) ([]byte, error)
+    ChangePassword(oldPassword string, newPassword string) error
+    DeleteAccount(password string) error
+    SignMessage(message []byte, password string) ([]byte, error)
+    SignTypedData(typedData []byte, password string) ([]byte, error)
+    SignMessageContext(ctx context.Context, message []byte, password string) ([]byte, error)
+    SignTypedDataContext(ctx context.Context, typedData []byte, password string) ([]byte, error)
+    Decrypt(cipherText []byte, password string) ([]byte, error)
+    PurchasedMetaData(metaDataIdEncBuyer []byte, password string, maxSize int64) (string, []byte, error)
+    DecryptContext(ctx context.Context, cipherText []byte, password string) ([]byte, error)
+    PurchasedMetaDataContext(ctx context.Context, metaDataIdEncBuyer []byte, password string, maxSize int64) (string, []byte, error)
 }
@@ -4,14 +4,18 @@
 package scry
 
 import (
+    "bytes"
+    "context"
     "errors"
     "github.com/ethereum/go-ethereum/common"
     "github.com/ethereum/go-ethereum/ethclient"
     "github.com/scryinfo/dot/dot"
     "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/errkind"
     curr "github.com/scryinfo/dp/dots/eth/currency"
     "github.com/scryinfo/dp/dots/eth/event"
     "github.com/scryinfo/dp/dots/eth/event/subscribe"
+    "github.com/scryinfo/dp/dots/storage"
     "go.uber.org/zap"
     "math/big"
 )
@@ -22,6 +26,8 @@ type clientImp struct {
     Subscriber   *subscribe.Subscribe `dot:"5535a065-0d90-46f4-9776-26630676c4c5"`
     Currency     *curr.Currency       `dot:"f76a1aac-ff18-479b-9d51-0166a858bec9"`
     Acct         *auth.Account        `dot:"ca1c6ce4-182b-430a-9813-caeccf83f8ab"`
+    Signers      *auth.Signers        `dot:"02b7d24f-9e05-4afc-ba8d-955265b231d6"`
+    Storage      storage.Storage      `dot:"0d3dd0df-241e-4fc3-a615-c5713f9f8db7"`
 }
 
 // check if 'clientImp' implements 'Client' interface.
@@ -64,6 +70,22 @@ func getAccountComponent() (*auth.Account, error) {
     }
 }
 
+func getSignersComponent() (*auth.Signers, error) {
+    d, err := dot.GetDefaultLine().ToInjecter().GetByLiveId(dot.LiveId(auth.SignersTypeId))
+    if err != nil {
+        dot.Logger().Errorln("loading Signers component failed", zap.Error(err))
+        return nil, errors.New("loading Signers component failed")
+    }
+
+    s, ok := d.(*auth.Signers)
+    if !ok {
+        dot.Logger().Errorln("loading Signers component failed")
+        return nil, errors.New("loading Signers component failed")
+    }
+
+    return s, nil
+}
+
 func CreateScryClient(password string, chainWrapper ChainWrapper) (Client, error) {
     a, err := getAccountComponent()
     if err != nil {
@@ -90,6 +112,80 @@ func CreateScryClient(password string, chainWrapper ChainWrapper) (Client, error
     return c, nil
 }
 
+// ImportScryClient imports the account of a keystore file into the default signer, it is stored with password.
+func ImportScryClient(keyJson []byte, keystorePassword string, password string, chainWrapper ChainWrapper) (Client, error) {
+    if len(keyJson) == 0 {
+        return nil, errkind.New(errkind.InvalidArgument, "keystore can not be empty")
+    }
+
+    s, err := getSignersComponent()
+    if err != nil {
+        return nil, err
+    }
+
+    address, err := s.Import(context.Background(), keyJson, keystorePassword, password)
+    if err != nil {
+        dot.Logger().Errorln("", zap.NamedError("failed to import client, error:", err))
+        return nil, err
+    }
+
+    c := &clientImp{
+        userAccount:  &auth.UserAccount{Addr: address},
+        chainWrapper: chainWrapper,
+    }
+
+    err = dot.GetDefaultLine().ToInjecter().Inject(&c)
+    if err != nil {
+        dot.Logger().Errorln("", zap.NamedError("failed to import client, error:", err))
+        return nil, err
+    }
+
+    return c, nil
+}
+
+// CreateHDScryClients creates clients of the accounts derived from a mnemonic, a new mnemonic is generated
+// if params.Mnemonic is empty, it is returned with the clients and must be kept to recover the accounts.
+func CreateHDScryClients(params auth.HDParams, password string, chainWrapper ChainWrapper) ([]Client, string, error) {
+    a, err := getAccountComponent()
+    if err != nil {
+        return nil, "", err
+    }
+
+    mnemonic, uas, err := a.DeriveUserAccounts(params, password)
+    if err != nil {
+        dot.Logger().Errorln("", zap.NamedError("failed to create clients, error:", err))
+        return nil, "", err
+    }
+
+    clients := make([]Client, 0, len(uas))
+    for _, ua := range uas {
+        c := &clientImp{
+            userAccount:  ua,
+            chainWrapper: chainWrapper,
+        }
+
+        err = dot.GetDefaultLine().ToInjecter().Inject(&c)
+        if err != nil {
+            dot.Logger().Errorln("", zap.NamedError("failed to create client, error:", err))
+            return nil, "", err
+        }
+
+        clients = append(clients, c)
+    }
+
+    return clients, mnemonic, nil
+}
+
+// ListAccounts returns addresses of the accounts of all signers.
+func ListAccounts() ([]string, error) {
+    s, err := getSignersComponent()
+    if err != nil {
+        return nil, err
+    }
+
+    return s.Accounts(context.Background())
+}
+
 func (c *clientImp) Account() *auth.UserAccount {
     return c.userAccount
 }
@@ -119,3 +215,98 @@ func (c *clientImp) GetEth(owner common.Address, ec *ethclient.Client) (*big.Int
     return c.Currency.GetEthBalance(owner, ec)
 }
 
+func (c *clientImp) ExportAccount(password string, exportPassword string) ([]byte, error) {
+    return c.Signers.Export(context.Background(), c.Account().Addr, password, exportPassword)
+}
+
+func (c *clientImp) ChangePassword(oldPassword string, newPassword string) error {
+    return c.Signers.ChangePassword(context.Background(), c.Account().Addr, oldPassword, newPassword)
+}
+
+func (c *clientImp) DeleteAccount(password string) error {
+    return c.Signers.Delete(context.Background(), c.Account().Addr, password)
+}
+
+func (c *clientImp) SignMessage(message []byte, password string) ([]byte, error) {
+    return c.SignMessageContext(context.Background(), message, password)
+}
+
+func (c *clientImp) SignTypedData(typedData []byte, password string) ([]byte, error) {
+    return c.SignTypedDataContext(context.Background(), typedData, password)
+}
+
+func (c *clientImp) SignMessageContext(ctx context.Context, message []byte, password string) ([]byte, error) {
+    return c.Signers.SignMessage(ctx, message, c.Account().Addr, password)
+}
+
+func (c *clientImp) SignTypedDataContext(ctx context.Context, typedData []byte, password string) ([]byte, error) {
+    return c.Signers.SignTypedData(ctx, typedData, c.Account().Addr, password)
+}
+
+func (c *clientImp) Decrypt(cipherText []byte, password string) ([]byte, error) {
+    return c.DecryptContext(context.Background(), cipherText, password)
+}
+
+func (c *clientImp) PurchasedMetaData(metaDataIdEncBuyer []byte, password string, maxSize int64) (string, []byte, error) {
+    return c.PurchasedMetaDataContext(context.Background(), metaDataIdEncBuyer, password, maxSize)
+}
+
+// DecryptContext decrypts a cipher text of the account, or its part of cipher texts addressed to several recipients.
+func (c *clientImp) DecryptContext(ctx context.Context, cipherText []byte, password string) ([]byte, error) {
+    addr := c.Account().Addr
+
+    r, err := recipientFor(cipherText, addr)
+    if err != nil {
+        return nil, err
+    }
+
+    return c.Signers.Signer(addr).Decrypt(ctx, r.CipherText, addr, password)
+}
+
+// PurchasedMetaDataContext decrypts the meta data id sent to the buyer when data is ready for download, then
+// returns the id and the meta data, which is verified against the id. Meta data is published sealed in an
+// envelope, the seller re-wraps its data key for the buyer, so it is decrypted with the buyer's key.
+func (c *clientImp) PurchasedMetaDataContext(ctx context.Context, metaDataIdEncBuyer []byte, password string, maxSize int64) (string, []byte, error) {
+    addr := c.Account().Addr
+
+    r, err := recipientFor(metaDataIdEncBuyer, addr)
+    if err != nil {
+        return "", nil, err
+    }
+
+    bs, err := c.Signers.Signer(addr).Decrypt(ctx, r.CipherText, addr, password)
+    if err != nil {
+        return "", nil, err
+    }
+    id := string(bs)
+
+    // envelopes are authenticated by decryption, so they are read even if their layout can't be verified
+    content, err := storage.ReadVerified(ctx, c.Storage, id, storage.MaxSize(maxSize))
+    if err == storage.ErrUnverifiable && storage.IsEnvelope(content) {
+        err = nil
+    }
+    if err != nil {
+        return id, nil, err
+    }
+
+    if storage.IsEnvelope(content) {
+        var plain bytes.Buffer
+        if err = storage.Decrypt(&plain, bytes.NewReader(content), r.UnwrapKey(ctx, c.Signers, password)); err != nil {
+            return id, nil, err
+        }
+        content = plain.Bytes()
+    }
+
+    return id, content, nil
+}
+
+func recipientFor(data []byte, address string) (Recipient, error) {
+    r, err := RecipientFor(data, address)
+    if err == ErrNotRecipient {
+        return r, errkind.Wrap(errkind.PermissionDenied, err)
+    } else if err != nil {
+        return r, errkind.Wrap(errkind.InvalidArgument, err)
+    }
+
+    return r, nil
+}
@@ -0,0 +1,191 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package scry
+
+import (
+    "bytes"
+    "context"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/errkind"
+    "github.com/scryinfo/dp/dots/storage"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+// testLine injects the signers and the storage into clients, in place of the default line.
+type testLine struct {
+    dot.Line
+    injecter *testInjecter
+}
+
+func (c *testLine) ToInjecter() dot.Injecter {
+    return c.injecter
+}
+
+type testInjecter struct {
+    dot.Injecter
+    signers *auth.Signers
+    storage storage.Storage
+}
+
+func (c *testInjecter) Inject(obj interface{}) error {
+    p, ok := obj.(**clientImp)
+    if !ok {
+        return errors.Errorf("unexpected object %T", obj)
+    }
+    (*p).Signers = c.signers
+    (*p).Storage = c.storage
+
+    return nil
+}
+
+func (c *testInjecter) GetByLiveId(id dot.LiveId) (dot.Dot, error) {
+    if id == dot.LiveId(auth.SignersTypeId) {
+        return c.signers, nil
+    }
+
+    return nil, errors.Errorf("no dot of live id %s", id)
+}
+
+func newTestLine(t *testing.T, dir string) *testLine {
+    d, err := auth.SignersTypeLive()[0].Meta.NewDoter([]byte(
+        `{"default":"keystore","keystoreDir":"` + filepath.ToSlash(filepath.Join(dir, "keystore")) + `"}`,
+    ))
+    if err != nil {
+        t.Fatal(err)
+    }
+    signers := d.(*auth.Signers)
+    if err = signers.Create(nil); err != nil {
+        t.Fatal(err)
+    }
+
+    local, err := storage.NewLocal(filepath.Join(dir, "storage"))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    return &testLine{injecter: &testInjecter{signers: signers, storage: local}}
+}
+
+func TestClientPurchasedMetaData(t *testing.T) {
+    dir, err := ioutil.TempDir("", "client")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    line := newTestLine(t, dir)
+    defaultLine := dot.GetDefaultLine()
+    dot.SetDefaultLine(line)
+    defer dot.SetDefaultLine(defaultLine)
+
+    // the buyer's account comes from a keystore file of another wallet
+    other, err := keystore.Open(filepath.Join(dir, "other"), true)
+    if err != nil {
+        t.Fatal(err)
+    }
+    addr, err := other.NewAccount("old")
+    if err != nil {
+        t.Fatal(err)
+    }
+    keyJson, err := other.Export(addr, "old", "exported")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    if _, err = ImportScryClient(keyJson, "wrong", "buyer", nil); err == nil {
+        t.Error("imported with a wrong keystore password")
+    }
+    buyer, err := ImportScryClient(keyJson, "exported", "buyer", nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !bytes.Equal(common.HexToAddress(buyer.Account().Addr).Bytes(), common.HexToAddress(addr).Bytes()) {
+        t.Fatal("imported a different account", buyer.Account().Addr, addr)
+    }
+
+    signers := line.injecter.signers
+    seller, err := signers.Keystore().Store().NewAccount("seller")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // the seller publishes the meta data sealed for itself, then re-wraps its data key for the buyer
+    ctx := context.Background()
+    meta := []byte("meta data of the buyer")
+    id, err := line.injecter.storage.SaveEncrypted(bytes.NewReader(meta), signers.WrapKey(ctx, seller))
+    if err != nil {
+        t.Fatal(err)
+    }
+    rc, err := line.injecter.storage.Open(ctx, id)
+    if err != nil {
+        t.Fatal(err)
+    }
+    wrapped, err := storage.WrappedKey(rc)
+    rc.Close()
+    if err != nil {
+        t.Fatal(err)
+    }
+    buyerPub, err := signers.Keystore().PublicKey(ctx, buyer.Account().Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    dataKey, err := signers.ReEncrypt(ctx, wrapped, seller, "seller", buyerPub)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    idEncBuyer, err := signers.Seal(ctx, []byte(id), buyer.Account().Addr)
+    if err != nil {
+        t.Fatal(err)
+    }
+    idEncSeller, err := signers.Seal(ctx, []byte(id), seller)
+    if err != nil {
+        t.Fatal(err)
+    }
+    data, err := EncodeRecipients([]Recipient{
+        {Address: common.HexToAddress(seller), CipherText: idEncSeller},
+        {Address: common.HexToAddress(buyer.Account().Addr), CipherText: idEncBuyer, DataKey: dataKey},
+    })
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    plain, err := buyer.Decrypt(data, "buyer")
+    if err != nil || string(plain) != id {
+        t.Error("failed to decrypt the recipients record", string(plain), err)
+    }
+    if plain, err = buyer.Decrypt(idEncBuyer, "buyer"); err != nil || string(plain) != id {
+        t.Error("failed to decrypt a plain cipher text", string(plain), err)
+    }
+    if _, err = buyer.Decrypt(data, "wrong"); err == nil {
+        t.Error("decrypted with a wrong password")
+    }
+
+    gotId, got, err := buyer.PurchasedMetaData(data, "buyer", 1<<20)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if gotId != id || !bytes.Equal(got, meta) {
+        t.Error("wrong meta data", gotId, string(got))
+    }
+    if _, _, err = buyer.PurchasedMetaData(data, "buyer", 4); errors.Cause(err) != storage.ErrTooLarge {
+        t.Error("read meta data larger than the max size", err)
+    }
+
+    // only recipients of the record may read it
+    other2 := NewScryClient(common.HexToAddress("0x01").Hex(), nil)
+    if _, err = other2.Decrypt(data, "buyer"); errkind.Of(err) != errkind.PermissionDenied {
+        t.Error("decrypted a record of others", err)
+    }
+    if _, _, err = other2.PurchasedMetaData(data, "buyer", 1<<20); errkind.Of(err) != errkind.PermissionDenied {
+        t.Error("read meta data of others", err)
+    }
+}
@@ -0,0 +1,39 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package scry
+
+import (
+    "context"
+    "github.com/scryinfo/dp/dots/errkind"
+    "net"
+    "net/url"
+    "strings"
+)
+
+// ethError classifies the errors of the ethereum node, errors which are classified already are kept.
+func ethError(err error) error {
+    if err == nil || errkind.Of(err) != errkind.Unknown {
+        return err
+    }
+
+    if err == context.DeadlineExceeded {
+        return errkind.Wrap(errkind.NodeUnavailable, err)
+    }
+    switch err.(type) {
+    case net.Error, *url.Error:
+        return errkind.Wrap(errkind.NodeUnavailable, err)
+    }
+
+    msg := err.Error()
+    switch {
+    case strings.Contains(msg, "connection refused"), strings.Contains(msg, "no such host"):
+        return errkind.Wrap(errkind.NodeUnavailable, err)
+    case strings.Contains(msg, "insufficient funds"):
+        return errkind.Wrap(errkind.InsufficientBalance, err)
+    case strings.Contains(msg, "always failing transaction"), strings.Contains(msg, "revert"):
+        return errkind.Wrap(errkind.ContractReverted, err)
+    }
+
+    return err
+}
@@ -0,0 +1,177 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package scry
+
+import (
+    "context"
+    "encoding/binary"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/storage"
+)
+
+// Re-encrypted meta data IDs are sent to the contract as records of
+//   tag(1) version(1) size(2) address(20) length(2) cipherText(length) keyLength(2) dataKey(keyLength) padding
+// every record of a list is padded to the same size, as the contract splits the list
+// of arbitrators into equal parts, so each arbitrator still gets exactly its record.
+// Records of version 1 have no data key.
+const (
+    RecipientsVersion = 2
+
+    recipientTag       = 0xd0
+    recipientHeaderLen = 26
+    maxCipherTextLen   = 0xffff - recipientHeaderLen
+)
+
+var (
+    ErrRecipientsVersion = errors.New("unsupported version of recipients")
+    ErrRecipientsCorrupt = errors.New("recipients are corrupt")
+    ErrNotRecipient      = errors.New("address is not a recipient")
+)
+
+// Recipient is a cipher text of the meta data ID for an address, and the data key of the envelope
+// of the meta data wrapped for it, if the meta data is sealed.
+type Recipient struct {
+    Address    common.Address
+    CipherText []byte
+    DataKey    []byte
+}
+
+// UnwrapKey returns the function which unwraps the data key of the envelope of the meta data for the recipient,
+// which is its DataKey, or the key in the envelope if it has none.
+func (r Recipient) UnwrapKey(ctx context.Context, signers *auth.Signers, password string) storage.UnwrapKey {
+    if r.DataKey == nil {
+        return signers.UnwrapKey(ctx, r.Address.Hex(), password)
+    }
+
+    return func([]byte) ([]byte, error) {
+        return signers.UnwrapKey(ctx, r.Address.Hex(), password)(r.DataKey)
+    }
+}
+
+// EncodeRecipients encodes rs as records of the same size.
+func EncodeRecipients(rs []Recipient) ([]byte, error) {
+    size := recipientHeaderLen
+    for _, r := range rs {
+        if len(r.CipherText)+2+len(r.DataKey) > maxCipherTextLen {
+            return nil, errors.New("cipher text of " + r.Address.String() + " is too long")
+        }
+        if n := recipientHeaderLen + len(r.CipherText) + 2 + len(r.DataKey); n > size {
+            size = n
+        }
+    }
+
+    out := make([]byte, 0, size*len(rs))
+    for _, r := range rs {
+        rec := make([]byte, size)
+        rec[0] = recipientTag
+        rec[1] = RecipientsVersion
+        binary.BigEndian.PutUint16(rec[2:4], uint16(size))
+        copy(rec[4:24], r.Address.Bytes())
+        binary.BigEndian.PutUint16(rec[24:26], uint16(len(r.CipherText)))
+        n := copy(rec[recipientHeaderLen:], r.CipherText) + recipientHeaderLen
+        binary.BigEndian.PutUint16(rec[n:n+2], uint16(len(r.DataKey)))
+        copy(rec[n+2:], r.DataKey)
+
+        out = append(out, rec...)
+    }
+
+    return out, nil
+}
+
+// DecodeRecipients decodes the records in data, which may be a whole list or a part of it.
+func DecodeRecipients(data []byte) ([]Recipient, error) {
+    var rs []Recipient
+    for len(data) > 0 {
+        if len(data) < recipientHeaderLen || data[0] != recipientTag {
+            return nil, ErrRecipientsCorrupt
+        }
+        version := data[1]
+        if version != 1 && version != RecipientsVersion {
+            return nil, ErrRecipientsVersion
+        }
+
+        size := int(binary.BigEndian.Uint16(data[2:4]))
+        length := int(binary.BigEndian.Uint16(data[24:26]))
+        end := recipientHeaderLen + length
+        if size < end || size > len(data) {
+            return nil, ErrRecipientsCorrupt
+        }
+
+        r := Recipient{
+            Address:    common.BytesToAddress(data[4:24]),
+            CipherText: append([]byte(nil), data[recipientHeaderLen:end]...),
+        }
+        if version > 1 {
+            if size < end+2 {
+                return nil, ErrRecipientsCorrupt
+            }
+            keyLen := int(binary.BigEndian.Uint16(data[end : end+2]))
+            if size < end+2+keyLen {
+                return nil, ErrRecipientsCorrupt
+            }
+            if keyLen > 0 {
+                r.DataKey = append([]byte(nil), data[end+2:end+2+keyLen]...)
+            }
+        }
+
+        rs = append(rs, r)
+        data = data[size:]
+    }
+
+    return rs, nil
+}
+
+// IsRecipients reports if data is encoded recipients rather than a bare cipher text.
+func IsRecipients(data []byte) bool {
+    return len(data) > 0 && data[0] == recipientTag
+}
+
+// CipherTextFor returns the cipher text of address in data, a bare cipher text,
+// which is sent before recipients are encoded, is returned as it is.
+func CipherTextFor(data []byte, address string) ([]byte, error) {
+    r, err := RecipientFor(data, address)
+    if err != nil {
+        return nil, err
+    }
+
+    return r.CipherText, nil
+}
+
+// RecipientFor returns the record of address in data, a bare cipher text is the cipher text of a record.
+func RecipientFor(data []byte, address string) (Recipient, error) {
+    addr := common.HexToAddress(address)
+    if !IsRecipients(data) {
+        return Recipient{Address: addr, CipherText: data}, nil
+    }
+
+    rs, err := DecodeRecipients(data)
+    if err != nil {
+        return Recipient{}, err
+    }
+
+    for _, r := range rs {
+        if r.Address == addr {
+            return r, nil
+        }
+    }
+
+    return Recipient{}, ErrNotRecipient
+}
+
+// SplitRecipients splits data into n equal parts as the contract does.
+func SplitRecipients(data []byte, n int) ([][]byte, error) {
+    if n <= 0 || len(data)%n != 0 {
+        return nil, ErrRecipientsCorrupt
+    }
+
+    size := len(data) / n
+    parts := make([][]byte, 0, n)
+    for i := 0; i < n; i++ {
+        parts = append(parts, data[i*size:(i+1)*size])
+    }
+
+    return parts, nil
+}
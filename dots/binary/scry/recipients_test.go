@@ -0,0 +1,121 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package scry
+
+import (
+    "bytes"
+    "crypto/ecdsa"
+    "crypto/rand"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/crypto/ecies"
+    "testing"
+)
+
+func TestRecipientsRoundTrip(t *testing.T) {
+    metaDataID := []byte("QmNRCQWfgze6AbBCaT1rkrkV5tJ2aP4oTNPb5JZcXYywve")
+
+    keys := make([]*ecdsa.PrivateKey, 3)
+    rs := make([]Recipient, 3)
+    for i := range keys {
+        key, err := crypto.GenerateKey()
+        if err != nil {
+            t.Fatal(err)
+        }
+        keys[i] = key
+
+        // cipher texts of different sizes, as if the encryption scheme changed for one of them
+        plain := append(metaDataID, bytes.Repeat([]byte{0}, i*7)...)
+        ct, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(&key.PublicKey), plain, nil, nil)
+        if err != nil {
+            t.Fatal(err)
+        }
+        rs[i] = Recipient{Address: crypto.PubkeyToAddress(key.PublicKey), CipherText: ct}
+        if i > 0 {
+            rs[i].DataKey = bytes.Repeat([]byte{byte(i)}, 100+i)
+        }
+    }
+
+    data, err := EncodeRecipients(rs)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    decoded, err := DecodeRecipients(data)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(decoded) != len(rs) {
+        t.Fatalf("decoded %d recipients", len(decoded))
+    }
+
+    // the contract gives every arbitrator an equal part of the list
+    parts, err := SplitRecipients(data, len(rs))
+    if err != nil {
+        t.Fatal(err)
+    }
+    for i, part := range parts {
+        if decoded[i].Address != rs[i].Address || !bytes.Equal(decoded[i].CipherText, rs[i].CipherText) ||
+            !bytes.Equal(decoded[i].DataKey, rs[i].DataKey) {
+            t.Errorf("recipient %d changed", i)
+        }
+
+        ct, err := CipherTextFor(part, rs[i].Address.Hex())
+        if err != nil {
+            t.Fatal(err)
+        }
+        plain, err := ecies.ImportECDSA(keys[i]).Decrypt(ct, nil, nil)
+        if err != nil {
+            t.Fatal(err)
+        }
+        if !bytes.HasPrefix(plain, metaDataID) {
+            t.Errorf("recipient %d decrypted %q", i, plain)
+        }
+
+        if _, err = CipherTextFor(part, rs[(i+1)%len(rs)].Address.Hex()); err != ErrNotRecipient {
+            t.Errorf("part %d opened for another arbitrator: %v", i, err)
+        }
+    }
+}
+
+func TestRecipientsCompat(t *testing.T) {
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    ct, err := ecies.Encrypt(rand.Reader, ecies.ImportECDSAPublic(&key.PublicKey), []byte("id"), nil, nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // bare cipher texts sent before recipients were encoded
+    if IsRecipients(ct) {
+        t.Fatal("bare cipher text taken as recipients")
+    }
+    if got, err := CipherTextFor(ct, crypto.PubkeyToAddress(key.PublicKey).Hex()); err != nil || !bytes.Equal(got, ct) {
+        t.Error("bare cipher text changed", err)
+    }
+
+    data, err := EncodeRecipients([]Recipient{{Address: crypto.PubkeyToAddress(key.PublicKey), CipherText: ct}})
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // records of version 1 end with the cipher text
+    v1 := append([]byte(nil), data[:recipientHeaderLen+len(ct)]...)
+    v1[1] = 1
+    v1[2], v1[3] = byte(len(v1)>>8), byte(len(v1))
+    if r, err := RecipientFor(v1, crypto.PubkeyToAddress(key.PublicKey).Hex()); err != nil ||
+        !bytes.Equal(r.CipherText, ct) || r.DataKey != nil {
+        t.Error("failed to decode version 1", err)
+    }
+
+    newer := append([]byte(nil), data...)
+    newer[1] = RecipientsVersion + 1
+    if _, err = DecodeRecipients(newer); err != ErrRecipientsVersion {
+        t.Error("decoded an unknown version", err)
+    }
+    if _, err = DecodeRecipients(data[:len(data)-1]); err != ErrRecipientsCorrupt {
+        t.Error("decoded a truncated record", err)
+    }
+}
@@ -0,0 +1,104 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+// Package errkind classifies errors by their cause, so services can report them by kind
+// instead of by message.
+package errkind
+
+import (
+    "github.com/pkg/errors"
+)
+
+type Kind int
+
+const (
+    Unknown Kind = iota
+    InvalidArgument
+    Unauthenticated
+    PermissionDenied
+    NotFound
+    InsufficientBalance
+    InsufficientAllowance
+    ContractReverted
+    NodeUnavailable
+    KeyServiceUnavailable
+    Aborted
+)
+
+var kindNames = map[Kind]string{
+    Unknown:               "UNKNOWN",
+    InvalidArgument:       "INVALID_ARGUMENT",
+    Unauthenticated:       "UNAUTHENTICATED",
+    PermissionDenied:      "PERMISSION_DENIED",
+    NotFound:              "NOT_FOUND",
+    InsufficientBalance:   "INSUFFICIENT_BALANCE",
+    InsufficientAllowance: "INSUFFICIENT_ALLOWANCE",
+    ContractReverted:      "CONTRACT_REVERTED",
+    NodeUnavailable:       "NODE_UNAVAILABLE",
+    KeyServiceUnavailable: "KEY_SERVICE_UNAVAILABLE",
+    Aborted:               "ABORTED",
+}
+
+func (k Kind) String() string {
+    if s, ok := kindNames[k]; ok {
+        return s
+    }
+
+    return kindNames[Unknown]
+}
+
+// Parse returns the kind named s, Unknown if there isn't one.
+func Parse(s string) Kind {
+    for k, n := range kindNames {
+        if n == s {
+            return k
+        }
+    }
+
+    return Unknown
+}
+
+// Error is an error of a kind, wrapping it keeps the kind.
+type Error struct {
+    Kind Kind
+    err  error
+}
+
+func (e *Error) Error() string {
+    return e.err.Error()
+}
+
+// Cause returns the error which is classified.
+func (e *Error) Cause() error {
+    return e.err
+}
+
+func New(kind Kind, msg string) error {
+    return &Error{Kind: kind, err: errors.New(msg)}
+}
+
+// Wrap classifies err as kind, nil if err is nil.
+func Wrap(kind Kind, err error) error {
+    if err == nil {
+        return nil
+    }
+
+    return &Error{Kind: kind, err: err}
+}
+
+// Of returns the kind of err, the outermost one if it is classified more than once.
+func Of(err error) Kind {
+    for err != nil {
+        if e, ok := err.(*Error); ok {
+            return e.Kind
+        }
+
+        c, ok := err.(interface{ Cause() error })
+        if !ok {
+            return Unknown
+        }
+        err = c.Cause()
+    }
+
+    return Unknown
+}
@@ -0,0 +1,49 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package errkind
+
+import (
+    "github.com/pkg/errors"
+    "testing"
+)
+
+func TestOf(t *testing.T) {
+    balance := New(InsufficientBalance, "no tokens")
+
+    cases := []struct {
+        err  error
+        kind Kind
+    }{
+        {nil, Unknown},
+        {errors.New("plain"), Unknown},
+        {balance, InsufficientBalance},
+        {errors.Wrap(balance, "failed to buy"), InsufficientBalance},
+        {Wrap(ContractReverted, errors.Wrap(balance, "reverted")), ContractReverted},
+    }
+
+    for _, c := range cases {
+        if k := Of(c.err); k != c.kind {
+            t.Error("wrong kind of", c.err, k)
+        }
+    }
+
+    if Wrap(Unknown, nil) != nil {
+        t.Error("wrapped nil error")
+    }
+    if errors.Cause(errors.Wrap(balance, "failed")).Error() != "no tokens" {
+        t.Error("wrong cause")
+    }
+}
+
+func TestParse(t *testing.T) {
+    for k := range kindNames {
+        if Parse(k.String()) != k {
+            t.Error("wrong kind of", k.String())
+        }
+    }
+
+    if Parse("NO_SUCH_KIND") != Unknown {
+        t.Error("parsed unknown kind")
+    }
+}
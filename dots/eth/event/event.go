@@ -11,6 +11,7 @@ import (
 type Event struct {
     BlockNumber uint64
     TxHash      common.Hash
+    LogIndex    uint
     Address     common.Address
     Name        string
     Data        JSONObj
@@ -24,6 +24,8 @@ type Executor struct {
     eventChan chan event.Event
     repo      *event.Repository
     appId     string
+    mutex     sync.RWMutex
+    observers map[string][]event.Callback
 }
 
 //construct dot
@@ -48,6 +50,17 @@ func (c *Executor) Create(l dot.Line) error {
     return nil
 }
 
+// Observe calls cb with every event named eventName of the app, whoever its users are.
+func (c *Executor) Observe(eventName string, cb event.Callback) {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    if c.observers == nil {
+        c.observers = make(map[string][]event.Callback)
+    }
+    c.observers[eventName] = append(c.observers[eventName], cb)
+}
+
 func (c *Executor) ExecuteEvents(ce chan event.Event, r *event.Repository, appId string) {
     defer func() {
         if er := recover(); er != nil {
@@ -75,17 +88,19 @@ func (c *Executor) executeEvent(e event.Event) bool {
         }
     }()
 
-    var subs sync.Map
+    seqNo := e.Data.Get(AppSeqNo)
+    if seqNo != c.appId && e.Name != TokenEvtApproval {
+        return true
+    }
+
+    c.executeObservers(e)
+
+    var subs *sync.Map
     if rv, ok := c.repo.MapEventCallback.Load(e.Name); !ok {
         dot.Logger().Warnln("no event was executed, event:" + e.Name)
         return false
     } else {
-        subs = rv.(sync.Map)
-    }
-
-    seqNo := e.Data.Get(AppSeqNo)
-    if seqNo != c.appId && e.Name != TokenEvtApproval {
-        return true
+        subs = rv.(*sync.Map)
     }
 
     objUsers := e.Data.Get(TargetUsers)
@@ -112,7 +127,7 @@ func (c *Executor) executeEvent(e event.Event) bool {
 
 func (c *Executor) executeMatchedEvent(
     //sim map[common.Address]event.Callback,
-    m sync.Map,
+    m *sync.Map,
     users []common.Address, e event.Event,
 ) {
     m.Range(func(k, v interface{}) bool {
@@ -131,7 +146,7 @@ func (c *Executor) executeMatchedEvent(
 }
 
 func (c *Executor) executeAllEvent(
-    m sync.Map,
+    m *sync.Map,
     e event.Event,
 ) {
     m.Range(func(k, v interface{}) bool {
@@ -147,6 +162,18 @@ func (c *Executor) executeAllEvent(
     })
 }
 
+func (c *Executor) executeObservers(e event.Event) {
+    c.mutex.RLock()
+    cbs := c.observers[e.Name]
+    c.mutex.RUnlock()
+
+    for _, cb := range cbs {
+        if !cb(e) {
+            dot.Logger().Warnln("event observer error, event:" + e.Name)
+        }
+    }
+}
+
 func (c *Executor) containUser(ul []common.Address, user common.Address) bool {
     for _, u := range ul {
         if u == user {
@@ -9,6 +9,7 @@ import (
     "github.com/scryinfo/dot/dot"
     "github.com/scryinfo/dp/dots/eth/event"
     "go.uber.org/zap"
+    "sync/atomic"
     "time"
 )
 
@@ -17,6 +18,7 @@ const (
 )
 
 type Listener struct {
+    scanned uint64 //accessed atomically, first for its alignment
     builder *Builder
 }
 
@@ -69,11 +71,13 @@ func (c *Listener) ListenEvent(
         c.builder.SetContract(common.HexToAddress(v.Address), v.Abi, v.Events...)
     }
 
+    progress := make(chan Progress, 1)
     r, err := c.builder.SetClient(conn).
         SetFrom(fromBlock).
         SetTo(0).
         SetGracefulExit(true).
         SetDataChan(dataChannel, errorChannel).
+        SetProgressChan(progress).
         SetInterval(interval).
         BuildAndRun()
     if err != nil {
@@ -81,11 +85,28 @@ func (c *Listener) ListenEvent(
         return false
     }
 
+    //the scanner keeps running after ListenEvent returns, progress is sent until it stops
+    go func() {
+        for {
+            select {
+            case p := <-progress:
+                atomic.StoreUint64(&c.scanned, p.To)
+            case <-r.WaitChan():
+                return
+            }
+        }
+    }()
+
     r.WaitChan()
 
     return true
 }
 
+// ScannedBlock returns the last block scanned for events, 0 before the first scan.
+func (c *Listener) ScannedBlock() uint64 {
+    return atomic.LoadUint64(&c.scanned)
+}
+
 func (c *Listener) SetFromBlock(from uint64) {
     if c.builder != nil {
         c.builder.SetFrom(from)
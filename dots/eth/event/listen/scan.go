@@ -622,6 +622,7 @@ func (es *eventScanner) scan(ctx *RedoCtx) {
         es.sendData(event.Event{
             BlockNumber: lg.BlockNumber,
             TxHash:      lg.TxHash,
+            LogIndex:    lg.Index,
             Address:     lg.Address,
             Name:        name,
             Data:        e,
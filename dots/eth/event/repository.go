@@ -10,7 +10,7 @@ import (
 type Callback func(event Event) bool
 
 type Repository struct {
-    MapEventCallback sync.Map
+    MapEventCallback sync.Map //event name -> *sync.Map of client address -> Callback
 }
 
 func NewRepository() *Repository {
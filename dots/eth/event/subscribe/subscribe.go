@@ -53,14 +53,8 @@ func (c *Subscribe) Subscribe(
         return errors.New("couldn't subscribe event because of null eventCallback or empty event name")
     }
 
-    var subscribeInfoMap sync.Map
-    if rv, ok := c.eventRepo.MapEventCallback.Load(eventName); !ok {
-        subscribeInfoMap.Store(clientAddr, eventCallback)
-        c.eventRepo.MapEventCallback.Store(eventName, subscribeInfoMap)
-    } else {
-        subscribeInfoMap = rv.(sync.Map)
-        subscribeInfoMap.Store(clientAddr, eventCallback)
-    }
+    rv, _ := c.eventRepo.MapEventCallback.LoadOrStore(eventName, &sync.Map{})
+    rv.(*sync.Map).Store(clientAddr, eventCallback)
 
     return nil
 }
@@ -73,11 +67,11 @@ func (c *Subscribe) UnSubscribe(
         return errors.New("couldn't unsubscribe event because of empty event name")
     }
 
-    var subscribeInfoMap sync.Map
+    var subscribeInfoMap *sync.Map
     if rv, ok := c.eventRepo.MapEventCallback.Load(eventName); !ok {
         return errors.New("couldn't find corresponding client to unsubscribe:" + eventName)
     } else {
-        subscribeInfoMap = rv.(sync.Map)
+        subscribeInfoMap = rv.(*sync.Map)
         if rv, ok = subscribeInfoMap.Load(clientAddr); !ok {
             return errors.New("couldn't find corresponding event to unsubscribe:" + clientAddr.String())
         }
@@ -91,7 +85,22 @@ func (c *Subscribe) UnSubscribe(
     return nil
 }
 
-func getMapLen(m sync.Map) int {
+// Counts returns the number of subscribers of each event.
+func (c *Subscribe) Counts() map[string]int {
+    counts := make(map[string]int)
+    if c.eventRepo == nil {
+        return counts
+    }
+
+    c.eventRepo.MapEventCallback.Range(func(key, value interface{}) bool {
+        counts[key.(string)] = getMapLen(value.(*sync.Map))
+        return true
+    })
+
+    return counts
+}
+
+func getMapLen(m *sync.Map) int {
     l := 0
     m.Range(func(key, value interface{}) bool {
         l++
@@ -23,7 +23,8 @@ const (
 
 type Transaction struct {
     Config  configTransaction
-    Account *auth.Account `dot:"ca1c6ce4-182b-430a-9813-caeccf83f8ab"`
+    Signers  *auth.Signers  `dot:"02b7d24f-9e05-4afc-ba8d-955265b231d6"`
+    Sessions *auth.Sessions `dot:"af5f72bc-ed92-4aee-98ec-9944d4f4a0c7"`
 }
 
 type configTransaction struct {
@@ -34,10 +35,21 @@ type configTransaction struct {
 type TxParams struct {
     From     common.Address
     Password string
+    Token    string // session token, used instead of Password when it is set
     Value    *big.Int
     Pending  bool
     GasPrice *big.Int
     GasLimit uint64
+    Context  context.Context // deadline and cancellation of the calls made for the transaction
+}
+
+// Ctx returns Context of txParams, the background one if it is not set.
+func (p *TxParams) Ctx() context.Context {
+    if p.Context == nil {
+        return context.Background()
+    }
+
+    return p.Context
 }
 
 //construct dot
@@ -62,21 +74,45 @@ func newTxDot(conf interface{}) (dot.Dot, error) {
 
 //Data structure needed when generating newer component
 func TxTypeLive() []*dot.TypeLives {
-    return []*dot.TypeLives{
+    t := []*dot.TypeLives{
         &dot.TypeLives{
             Meta: dot.Metadata{TypeId: TxTypeId,
                 NewDoter: func(conf interface{}) (dot dot.Dot, err error) {
                     return newTxDot(conf)
                 }},
         },
-        auth.AccountTypeLive(),
     }
+
+    t = append(t, auth.SignersTypeLive()...)
+    return t
 }
 
 func (c *Transaction) Create(l dot.Line) error {
     return nil
 }
 
+// Authorize returns txParams with the password of its session if a token is given,
+// the session must be unlocked for txParams.From and operation.
+func (c *Transaction) Authorize(txParams *TxParams, operation string) (*TxParams, error) {
+    if txParams.Token == "" {
+        return txParams, nil
+    }
+
+    if c.Sessions == nil {
+        return nil, errors.New("sessions are not available")
+    }
+
+    password, err := c.Sessions.Password(txParams.Token, txParams.From.String(), operation)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to authorize "+operation)
+    }
+
+    p := *txParams
+    p.Password = password
+
+    return &p, nil
+}
+
 func (c *Transaction) BuildTransactOpts(txParams *TxParams) *bind.TransactOpts {
     gp := txParams.GasPrice
     if gp == nil {
@@ -97,34 +133,25 @@ func (c *Transaction) BuildTransactOpts(txParams *TxParams) *bind.TransactOpts {
         Nonce: nil,
         Signer: func(signer types.Signer, address common.Address,
             transaction *types.Transaction) (*types.Transaction, error) {
-            return c.SignTransaction(signer, address, transaction, txParams.Password)
+            return c.SignTransaction(txParams.Ctx(), signer, address, transaction, txParams.Password)
         },
         Value:    txParams.Value,
         GasPrice: gp,
         GasLimit: gl,
-        Context:  context.Background(),
+        Context:  txParams.Ctx(),
     }
 
     return opts
 }
 
 func (c *Transaction) SignTransaction(
+    ctx context.Context,
     signer types.Signer,
     address common.Address,
     transaction *types.Transaction,
     password string,
 ) (*types.Transaction, error) {
-    h := signer.Hash(transaction)
-
-    var sign []byte
-    var err error
-
-    sign, err = c.Account.SignTransaction(h[:], address.String(), password)
-    if err != nil {
-        return nil, err
-    }
-
-    return transaction.WithSignature(signer, sign)
+    return c.Signers.Signer(address.String()).SignTx(ctx, signer, address, transaction, password)
 }
 
 func (c *Transaction) BuildCallOpts(txParams *TxParams) *bind.CallOpts {
@@ -132,7 +159,7 @@ func (c *Transaction) BuildCallOpts(txParams *TxParams) *bind.CallOpts {
         Pending:     txParams.Pending,
         From:        txParams.From,
         BlockNumber: nil,
-        Context:     context.Background(),
+        Context:     txParams.Ctx(),
     }
 
     return opts
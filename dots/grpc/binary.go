@@ -2,40 +2,54 @@ package grpc
 
 import (
     "context"
-    "encoding/json"
-    "errors"
+    "github.com/pkg/errors"
     "github.com/ethereum/go-ethereum/common"
     "github.com/scryinfo/dot/dot"
     "github.com/scryinfo/dot/dots/grpc/gserver"
     "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/amount"
+    "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/auth/signdata"
     "github.com/scryinfo/dp/dots/binary/scry"
+    "github.com/scryinfo/dp/dots/errkind"
     "github.com/scryinfo/dp/dots/eth/event"
     "github.com/scryinfo/dp/dots/eth/event/subscribe"
     "github.com/scryinfo/dp/dots/eth/transaction"
     "go.uber.org/zap"
+    "google.golang.org/grpc/health"
+    healthpb "google.golang.org/grpc/health/grpc_health_v1"
+    "google.golang.org/grpc/reflection"
     "math/big"
     "sync"
+    "sync/atomic"
     "time"
 )
 
 const (
     BinaryGrpcServerTypeId = "96a6e2b5-f0b6-48dc-b0ff-2d9f2c5c9f1d"
-    ScanEventInterval      = 200  //milli seconds
-)
-
 
-type ChanEvent chan event.Event
+    defaultMaxMetaDataSize = 4<<20 - 64<<10 //fits in the default message size limit of grpc clients
+)
 
 type BinaryGrpcServer struct {
     config       binaryGrpcServerConfig
-    eventChanMap sync.Map
+    hubs         sync.Map //*eventHub by address
     chainWrapper scry.ChainWrapper
+    statusSource atomic.Value //StatusSource
+    health       *health.Server
+    stop         chan struct{}
     Subscriber   *subscribe.Subscribe `dot:""`
     ServerNobl   gserver.ServerNobl   `dot:""`
+    Sessions     *auth.Sessions       `dot:"af5f72bc-ed92-4aee-98ec-9944d4f4a0c7"`
 }
 
 type binaryGrpcServerConfig struct {
-    EventChanCapacity uint64
+    EventChanCapacity   uint64 //events queued for a stream, it is closed if they are more
+    EventBufferSize     uint64 //events kept for streams to resume, per address
+    MaxMetaDataSize     uint64 //bytes of meta data returned by GetPurchasedMetaData
+    HealthCheckInterval uint64 //seconds between the checks of dependencies for the health service
+    HealthCheckTimeout  uint64 //seconds the checks of dependencies can take
+    HubIdleTimeout      uint64 //seconds the events of an address are kept after its last stream closes
 }
 
 func newBinaryGrpcServerDot(conf interface{}) (dot.Dot, error) {
@@ -75,6 +89,7 @@ func BinaryGrpcServerTypeLive() []*dot.TypeLives {
             },
         },
         subscribe.SubsTypeLive(),
+        GatewayTypeLive(),
     }
 
     t = append(t, gserver.HttpNoblTypeLives()...)
@@ -87,11 +102,29 @@ func (c *BinaryGrpcServer) Create(l dot.Line) error {
 }
 
 func (c *BinaryGrpcServer) Start(ignore bool) error {
-    api.RegisterBinaryServiceServer(c.ServerNobl.Server(), c)
+    s := c.ServerNobl.Server()
+    api.RegisterBinaryServiceServer(s, c)
+
+    c.health = health.NewServer()
+    healthpb.RegisterHealthServer(s, c.health)
+    reflection.Register(s)
+
+    c.stop = make(chan struct{})
+    go c.checkHealth(c.health, c.stop)
+    go c.evictHubs(c.stop)
+
     return nil
 }
 
 func (c *BinaryGrpcServer) Stop(ignore bool) error {
+    if c.stop != nil {
+        close(c.stop)
+        c.stop = nil
+    }
+    if c.health != nil {
+        c.health.Shutdown()
+    }
+
     return nil
 }
 
@@ -115,35 +148,36 @@ func (c *BinaryGrpcServer) SubscribeEvent(ctx context.Context, info *api.Subscri
         errMsg := "client address can not be empty"
         dot.Logger().Errorln("BinaryGrpcServer::SubscribeEvent", zap.String("error:", errMsg))
         rs.ErrMsg = errMsg
-        return rs, errors.New(errMsg)
+        return rs, statusError(errkind.New(errkind.InvalidArgument, errMsg))
     }
 
-    //data channel for server streaming
-
-    //event channel stored event data between grpc client and grpc server
-    var ce ChanEvent
-    if rv, ok := c.eventChanMap.Load(hexAddr); !ok {
+    //events of the address are sent to its streams through the hub
+    addr := common.HexToAddress(hexAddr)
+    var hub *eventHub
+    if rv, ok := c.hubs.Load(addr.String()); !ok {
         errMsg := "failed to subscribe event since no server streaming channel found"
         dot.Logger().Errorln("BinaryGrpcServer::SubscribeEvent", zap.String("error:", errMsg))
         rs.ErrMsg = errMsg
-        return rs, errors.New(errMsg)
+        return rs, statusError(errkind.New(errkind.NotFound, errMsg))
     } else {
-        ce = rv.(ChanEvent)
+        hub = rv.(*eventHub)
     }
 
-    //grpc client addr
-    addr := common.HexToAddress(info.GetAddress())
+    //the hub is looked up for every event, since it is replaced once it is evicted
     for _, ev := range info.GetEvent() {
         err := c.Subscriber.Subscribe(addr, ev, func(event event.Event) bool {
-            ce <- event
+            if rv, ok := c.hubs.Load(addr.String()); ok {
+                rv.(*eventHub).publish(event)
+            }
             return true
         })
 
         if err != nil {
             dot.Logger().Errorln("BinaryGrpcServer::SubscribeEvent", zap.Error(err))
             rs.ErrMsg = err.Error()
-            return rs, err
+            return rs, statusError(err)
         }
+        hub.subscribed([]string{ev}, true)
     }
 
     return rs, nil
@@ -160,10 +194,13 @@ func (c *BinaryGrpcServer) UnSubscribeEvent(
         errMsg := "client address can not be empty"
         dot.Logger().Errorln("BinaryGrpcServer::UnSubscribeEvent", zap.String("error:", errMsg))
         rs.ErrMsg = errMsg
-        return rs, errors.New(errMsg)
+        return rs, statusError(errkind.New(errkind.InvalidArgument, errMsg))
     }
 
     addr := common.HexToAddress(info.GetAddress())
+    if rv, ok := c.hubs.Load(addr.String()); ok {
+        rv.(*eventHub).subscribed(info.GetEvent(), false)
+    }
     for _, ev := range info.GetEvent() {
         err := c.Subscriber.UnSubscribe(addr, ev)
         if err != nil {
@@ -174,8 +211,9 @@ func (c *BinaryGrpcServer) UnSubscribeEvent(
     return rs, nil
 }
 
-//the function should be called firstly to create server stream channel
-func (c *BinaryGrpcServer) RecvEvents(client *api.ClientInfo,srv api.BinaryService_RecvEventsServer) error {
+//the function should be called firstly to create server stream channel. An address can have several
+//streams, each of them gets all events of the address, the buffered events after the cursor first.
+func (c *BinaryGrpcServer) RecvEvents(client *api.ClientInfo, srv api.BinaryService_RecvEventsServer) error {
     defer func() {
         if err := recover(); err != nil {
             dot.Logger().Errorln("BinaryGrpcServer::RecvEvents", zap.Any("error:", err))
@@ -186,72 +224,139 @@ func (c *BinaryGrpcServer) RecvEvents(client *api.ClientInfo,srv api.BinaryServi
     if client == nil || client.Address == "" {
         errMsg := "client address can not be empty"
         dot.Logger().Errorln("BinaryGrpcServer::RecvEvents", zap.String("error:", errMsg))
-        return errors.New(errMsg)
+        return statusError(errkind.New(errkind.InvalidArgument, errMsg))
     }
 
-    //event channel stored event data between grpc client and grpc server
-    var ce ChanEvent
-    if rv, ok := c.eventChanMap.Load(client.Address); !ok {
-        ce = make(ChanEvent, c.config.EventChanCapacity)
-        c.eventChanMap.Store(client.Address, ce)
-    } else {
-        ce = rv.(ChanEvent)
+    //a hub which is evicted meanwhile is about to be removed, the stream is opened on the next one
+    var hub *eventHub
+    var stream *eventStream
+    var replay []event.Event
+    cursor := client.GetCursor()
+    for stream == nil {
+        rv, _ := c.hubs.LoadOrStore(
+            common.HexToAddress(client.Address).String(),
+            newEventHub(int(c.config.EventBufferSize), int(c.config.EventChanCapacity)),
+        )
+        hub = rv.(*eventHub)
+        stream, replay = hub.open(cursor != nil, cursor.GetBlockNumber(), uint(cursor.GetLogIndex()))
     }
+    defer hub.close(stream)
 
     //channel created event
-    ce <- *makeChannelCreatedEvent()
+    if err := sendEvent(srv, makeChannelCreatedEvent(), client.TypedEvents); err != nil {
+        return err
+    }
+
+    for i := range replay {
+        if err := sendEvent(srv, &replay[i], client.TypedEvents); err != nil {
+            return err
+        }
+    }
 
     //push stream
     for {
         select {
-        case e := <- ce:
-            dot.Logger().Debugln("BinaryGrpcServer::RecvEvents", zap.String("event:", e.Name))
-
-            ev, err := makeProtoEvent(&e)
-            if err != nil {
-                dot.Logger().Errorln("BinaryGrpcServer::RecvEvents", zap.String("error:", err.Error()))
-                break
+        case e := <-stream.events:
+            if err := sendEvent(srv, &e, client.TypedEvents); err != nil {
+                return err
             }
 
-            err = srv.Send(ev)
-            if err != nil {
-                dot.Logger().Errorln("BinaryGrpcServer::RecvEvents", zap.String("error:", err.Error()))
-                ce <- e
-                return err
+        case <-stream.lagged:
+            for len(stream.events) > 0 {
+                e := <-stream.events
+                if err := sendEvent(srv, &e, client.TypedEvents); err != nil {
+                    return err
+                }
             }
 
-        case <-time.After(time.Microsecond * ScanEventInterval):
+            errMsg := "event stream is closed since it can't keep up, resume it from the last event received"
+            dot.Logger().Warnln("BinaryGrpcServer::RecvEvents", zap.String("address", client.Address))
+            return statusError(errkind.New(errkind.Aborted, errMsg))
+
+        case <-srv.Context().Done():
+            return srv.Context().Err()
         }
     }
 }
 
-func makeChannelCreatedEvent() *event.Event {
-    return &event.Event{
-        Name: "ChannelCreated",
+//send an event to a stream, events which can't be converted are skipped
+func sendEvent(srv api.BinaryService_RecvEventsServer, e *event.Event, withJson bool) error {
+    dot.Logger().Debugln("BinaryGrpcServer::RecvEvents", zap.String("event:", e.Name))
+
+    ev, err := makeProtoEvent(e, withJson)
+    if err != nil {
+        dot.Logger().Errorln("BinaryGrpcServer::RecvEvents", zap.String("error:", err.Error()))
+        return nil
+    }
+
+    err = srv.Send(ev)
+    if err != nil {
+        dot.Logger().Errorln("BinaryGrpcServer::RecvEvents", zap.String("error:", err.Error()))
+        return err
     }
+
+    return nil
 }
 
-func makeProtoEvent(e *event.Event) (*api.Event, error) {
-    obj := map[string]interface{} {
-        "BlockNumber": e.BlockNumber,
-        "ContractAddress": e.Address.String(),
-        "EventName": e.Name,
-        "TxHash": e.TxHash.String(),
-        "EventData": e.Data.String(),
+//acknowledged events are dropped from the buffer of the address, by a session of the address only
+func (c *BinaryGrpcServer) AckEvents(ctx context.Context, params *api.AckEventsParams) (*api.Result, error) {
+    if params == nil || params.Address == "" || params.Cursor == nil {
+        e := "client address and cursor can not be empty"
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+    if _, err := c.Sessions.Password(params.Token, params.Address, scry.OpAckEvents); err != nil {
+        dot.Logger().Errorln("BinaryGrpcServer::AckEvents", zap.Error(err))
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    jsonEvent, err := json.Marshal(obj)
-    if err != nil {
-        dot.Logger().Errorln("BinaryGrpcServer::makeProtoEvent", zap.String("error:", err.Error()))
-        return nil, err
+    rv, ok := c.hubs.Load(common.HexToAddress(params.Address).String())
+    if !ok {
+        e := "no server streaming channel found"
+        return makeResult(false, e), statusError(errkind.New(errkind.NotFound, e))
     }
 
-    pe := &api.Event{
-        Time: time.Now().Unix(),
-        JsonData: string(jsonEvent),
+    rv.(*eventHub).ack(params.Cursor.BlockNumber, uint(params.Cursor.LogIndex))
+
+    return makeResult(true, ""), nil
+}
+
+// evictHubs evicts the hubs which are idle until stop is closed.
+func (c *BinaryGrpcServer) evictHubs(stop chan struct{}) {
+    for {
+        select {
+        case <-stop:
+            return
+        case <-time.After(hubEvictInterval):
+        }
+
+        c.evictIdleHubs(time.Now())
     }
+}
+
+// evictIdleHubs removes the hubs which have no stream for the idle timeout, and unsubscribes their events.
+func (c *BinaryGrpcServer) evictIdleHubs(now time.Time) {
+    timeout := seconds(c.config.HubIdleTimeout, defaultHubIdleTimeout)
+
+    c.hubs.Range(func(key, value interface{}) bool {
+        names, ok := value.(*eventHub).evict(now, timeout)
+        if !ok {
+            return true
+        }
 
-    return pe, nil
+        c.hubs.Delete(key)
+        for _, name := range names {
+            if err := c.Subscriber.UnSubscribe(common.HexToAddress(key.(string)), name); err != nil {
+                dot.Logger().Errorln("BinaryGrpcServer::evictIdleHubs", zap.Error(err))
+            }
+        }
+        return true
+    })
+}
+
+func makeChannelCreatedEvent() *event.Event {
+    return &event.Event{
+        Name: "ChannelCreated",
+    }
 }
 
 func (c *BinaryGrpcServer) Publish(ctx context.Context, params *api.PublishParams) (*api.PublishResult, error) {
@@ -261,18 +366,30 @@ func (c *BinaryGrpcServer) Publish(ctx context.Context, params *api.PublishParam
     if c.chainWrapper == nil {
         errMsg := "invalid scry chain interface"
         makePublishResult(&pr, "", errMsg, false)
-        return pr, errors.New(errMsg)
+        return pr, statusError(errkind.New(errkind.NodeUnavailable, errMsg))
     }
 
     if params == nil || params.TxParam == nil {
         errMsg := "null publish parameters"
         makePublishResult(&pr, "", errMsg, false)
-        return pr, errors.New(errMsg)
+        return pr, statusError(errkind.New(errkind.InvalidArgument, errMsg))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        makePublishResult(&pr, "", err.Error(), false)
+        return pr, statusError(err)
+    }
+
+    price, err := amount.Parse(params.PriceDecimal, params.Price)
+    if err != nil {
+        makePublishResult(&pr, "", err.Error(), false)
+        return pr, statusError(err)
     }
 
     pid, err := c.chainWrapper.Publish(
-        makeTxParams(params.TxParam),
-        big.NewInt(params.Price),
+        txParams,
+        price,
         params.MetaDataID,
         params.ProofDataIDs,
         params.ProofNum,
@@ -282,24 +399,40 @@ func (c *BinaryGrpcServer) Publish(ctx context.Context, params *api.PublishParam
     if err != nil {
         e := err.Error()
         makePublishResult(&pr, "", e, false)
-        return pr, err
+        return pr, statusError(err)
     }
 
     makePublishResult(&pr, pid, "", true)
     return pr, nil
 }
 
-func makeTxParams(p *api.TxParams) *transaction.TxParams {
+func makeTxParams(ctx context.Context, p *api.TxParams) (*transaction.TxParams, error) {
+    if p == nil {
+        return nil, errkind.New(errkind.InvalidArgument, "null transaction parameters")
+    }
+
+    value, err := amount.Parse(p.ValueDecimal, p.Value)
+    if err != nil {
+        return nil, err
+    }
+
+    gasPrice, err := amount.Parse(p.GasPriceDecimal, p.GasPrice)
+    if err != nil {
+        return nil, err
+    }
+
     t := &transaction.TxParams{
         From: common.HexToAddress(p.From),
         Password: p.Password,
-        Value: big.NewInt(p.Value),
+        Token: p.Token,
+        Value: value,
         Pending: p.Pending,
         GasLimit: p.GasLimit,
-        GasPrice: big.NewInt(p.GasPrice),
+        GasPrice: gasPrice,
+        Context: ctx,
     }
 
-    return t
+    return t, nil
 }
 
 func makePublishResult(r **api.PublishResult, pid, e string, s bool)  {
@@ -324,13 +457,13 @@ func (c *BinaryGrpcServer) CreateAccount(
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
         makeAccountResult(&ar, "", e, false)
-        return ar, errors.New(e)
+        return ar, statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     client, err:= scry.CreateScryClient(in.Password, c.chainWrapper)
     if err != nil {
         makeAccountResult(&ar, "", err.Error(), false)
-        return ar, err
+        return ar, statusError(err)
     }
 
     makeAccountResult(&ar, client.Account().Addr, "", true)
@@ -349,24 +482,47 @@ func makeAccountResult(r **api.AccountResult, aid, e string, s bool)  {
     }
 }
 
+func (c *BinaryGrpcServer) ImportAccount(
+    ctx context.Context,
+    in *api.ImportAccountParams,
+) (*api.AccountResult, error) {
+    var ar *api.AccountResult
+    makeAccountResult(&ar, "", "", true)
+
+    if c.chainWrapper == nil {
+        e := "invalid scry chain interface"
+        makeAccountResult(&ar, "", e, false)
+        return ar, statusError(errkind.New(errkind.NodeUnavailable, e))
+    }
+
+    client, err := scry.ImportScryClient(in.Keystore, in.KeystorePassword, in.Password, c.chainWrapper)
+    if err != nil {
+        makeAccountResult(&ar, "", err.Error(), false)
+        return ar, statusError(err)
+    }
+
+    makeAccountResult(&ar, client.Account().Addr, "", true)
+    return ar, nil
+}
+
 func (c *BinaryGrpcServer) Authenticate(
     ctx context.Context,
     in *api.ClientInfo,
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     client := scry.NewScryClient(in.Address, c.chainWrapper)
     if client == nil {
         e := "failed to new scry client"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.Unknown, e))
     }
 
     _, err := client.Authenticate(in.Password)
     if err != nil {
-        return makeResult(false, err.Error()), err
+        return makeResult(false, err.Error()), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -378,23 +534,37 @@ func (c *BinaryGrpcServer) TransferEth(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     client := scry.NewScryClient(in.To, c.chainWrapper)
     if client == nil {
         e := "failed to create scry client"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.Unknown, e))
     }
 
-    err := client.TransferEthFrom(
+    password := in.Password
+    if in.Token != "" {
+        var err error
+        password, err = c.Sessions.Password(in.Token, in.From, scry.OpTransferEth)
+        if err != nil {
+            return makeResult(false, err.Error()), statusError(err)
+        }
+    }
+
+    value, err := amount.Parse(in.ValueDecimal, in.Value)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    err = client.TransferEthFrom(
         common.HexToAddress(in.From),
-        in.Password,
-        big.NewInt(in.Value),
+        password,
+        value,
         c.chainWrapper.Conn(),
     )
     if err != nil {
-        return makeResult(false, err.Error()), err
+        return makeResult(false, err.Error()), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -405,19 +575,19 @@ func (c *BinaryGrpcServer) GetEthBalance(
     in *api.EthBalanceParams,
 ) (*api.EthBalanceResult, error) {
     var r *api.EthBalanceResult
-    makeEthBalanceResult(&r, "", true, 0)
+    makeEthBalanceResult(&r, "", true, nil)
 
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        makeEthBalanceResult(&r, e, false, 0)
-        return r, errors.New(e)
+        makeEthBalanceResult(&r, e, false, nil)
+        return r, statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     client := scry.NewScryClient(in.Owner, c.chainWrapper)
     if client == nil {
         e := "failed to create scry client"
-        makeEthBalanceResult(&r, e, false, 0)
-        return r, errors.New(e)
+        makeEthBalanceResult(&r, e, false, nil)
+        return r, statusError(errkind.New(errkind.Unknown, e))
     }
 
     b, err := client.GetEth(
@@ -425,48 +595,296 @@ func (c *BinaryGrpcServer) GetEthBalance(
         c.chainWrapper.Conn(),
     )
     if err != nil {
-        makeEthBalanceResult(&r, err.Error(), false, 0)
-        return r, err
+        makeEthBalanceResult(&r, err.Error(), false, nil)
+        return r, statusError(err)
     }
 
-    makeEthBalanceResult(&r, "", true, b.Int64())
-    return r, err
+    makeEthBalanceResult(&r, "", true, b)
+    return r, statusError(err)
 }
 
-func makeEthBalanceResult(r **api.EthBalanceResult, e string, s bool, b int64)  {
+func makeEthBalanceResult(r **api.EthBalanceResult, e string, s bool, b *big.Int)  {
+    dec, compat := amount.Format(b)
     if *r == nil {
         *r = &api.EthBalanceResult{
-            Balance: b,
+            Balance: compat,
+            BalanceDecimal: dec,
             Result: makeResult(s, e),
         }
     } else {
-        (*r).Balance = b
+        (*r).Balance = compat
+        (*r).BalanceDecimal = dec
         (*r).Result = makeResult(s, e)
     }
 }
 
+func (c *BinaryGrpcServer) ListAccounts(
+    ctx context.Context,
+    in *api.ListAccountsParams,
+) (*api.AccountListResult, error) {
+    if _, err := c.Sessions.Address(in.Token, scry.OpListAccounts); err != nil {
+        return &api.AccountListResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    ids, err := scry.ListAccounts()
+    if err != nil {
+        return &api.AccountListResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    return &api.AccountListResult{Result: makeResult(true, ""), AccountIds: ids}, nil
+}
+
+func (c *BinaryGrpcServer) ExportAccount(
+    ctx context.Context,
+    in *api.ExportAccountParams,
+) (*api.ExportAccountResult, error) {
+    if c.chainWrapper == nil {
+        e := "invalid scry chain interface"
+        return &api.ExportAccountResult{Result: makeResult(false, e)}, statusError(errkind.New(errkind.NodeUnavailable, e))
+    }
+
+    client := scry.NewScryClient(in.Address, c.chainWrapper)
+    if client == nil {
+        e := "failed to create scry client"
+        return &api.ExportAccountResult{Result: makeResult(false, e)}, statusError(errkind.New(errkind.Unknown, e))
+    }
+
+    keyJson, err := client.ExportAccount(in.Password, in.ExportPassword)
+    if err != nil {
+        return &api.ExportAccountResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    return &api.ExportAccountResult{Result: makeResult(true, ""), KeyJson: keyJson}, nil
+}
+
+func (c *BinaryGrpcServer) ChangePassword(
+    ctx context.Context,
+    in *api.ChangePasswordParams,
+) (*api.Result, error) {
+    if c.chainWrapper == nil {
+        e := "invalid scry chain interface"
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
+    }
+
+    client := scry.NewScryClient(in.Address, c.chainWrapper)
+    if client == nil {
+        e := "failed to create scry client"
+        return makeResult(false, e), statusError(errkind.New(errkind.Unknown, e))
+    }
+
+    if err := client.ChangePassword(in.OldPassword, in.NewPassword); err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    return makeResult(true, ""), nil
+}
+
+func (c *BinaryGrpcServer) DeleteAccount(
+    ctx context.Context,
+    in *api.ClientInfo,
+) (*api.Result, error) {
+    if c.chainWrapper == nil {
+        e := "invalid scry chain interface"
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
+    }
+
+    client := scry.NewScryClient(in.Address, c.chainWrapper)
+    if client == nil {
+        e := "failed to create scry client"
+        return makeResult(false, e), statusError(errkind.New(errkind.Unknown, e))
+    }
+
+    if err := client.DeleteAccount(in.Password); err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    return makeResult(true, ""), nil
+}
+
+func (c *BinaryGrpcServer) Unlock(
+    ctx context.Context,
+    in *api.UnlockParams,
+) (*api.UnlockResult, error) {
+    session, err := c.Sessions.Unlock(ctx, in.Address, in.Password, in.Operations, time.Duration(in.Ttl)*time.Second)
+    if err != nil {
+        return &api.UnlockResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    return &api.UnlockResult{
+        Result:  makeResult(true, ""),
+        Token:   session.Token,
+        Expires: session.Expires.Unix(),
+    }, nil
+}
+
+func (c *BinaryGrpcServer) Lock(
+    ctx context.Context,
+    in *api.LockParams,
+) (*api.Result, error) {
+    c.Sessions.Lock(in.Token)
+    return makeResult(true, ""), nil
+}
+
+func (c *BinaryGrpcServer) SignMessage(
+    ctx context.Context,
+    in *api.SignMessageParams,
+) (*api.SignatureResult, error) {
+    client, password, err := c.userClient(in.Address, in.Password, in.Token, scry.OpSign)
+    if err != nil {
+        return &api.SignatureResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    sig, err := client.SignMessageContext(ctx, in.Message, password)
+    if err != nil {
+        return &api.SignatureResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    return &api.SignatureResult{Result: makeResult(true, ""), Signature: sig}, nil
+}
+
+func (c *BinaryGrpcServer) SignTypedData(
+    ctx context.Context,
+    in *api.SignTypedDataParams,
+) (*api.SignatureResult, error) {
+    client, password, err := c.userClient(in.Address, in.Password, in.Token, scry.OpSign)
+    if err != nil {
+        return &api.SignatureResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    sig, err := client.SignTypedDataContext(ctx, []byte(in.TypedData), password)
+    if err != nil {
+        return &api.SignatureResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    return &api.SignatureResult{Result: makeResult(true, ""), Signature: sig}, nil
+}
+
+// userClient returns the client of address and its password, which is taken from the session of token
+// if it is set, the session must allow op.
+func (c *BinaryGrpcServer) userClient(address, password, token, op string) (scry.Client, string, error) {
+    if c.chainWrapper == nil {
+        return nil, "", errkind.New(errkind.NodeUnavailable, "invalid scry chain interface")
+    }
+
+    client := scry.NewScryClient(address, c.chainWrapper)
+    if client == nil {
+        return nil, "", errors.New("failed to create scry client")
+    }
+
+    if password == "" && token != "" {
+        var err error
+        if password, err = c.Sessions.Password(token, address, op); err != nil {
+            return nil, "", err
+        }
+    }
+
+    return client, password, nil
+}
+
+func (c *BinaryGrpcServer) Decrypt(
+    ctx context.Context,
+    in *api.DecryptParams,
+) (*api.DecryptResult, error) {
+    client, password, err := c.userClient(in.Address, in.Password, in.Token, scry.OpDecrypt)
+    if err != nil {
+        return &api.DecryptResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    plainText, err := client.DecryptContext(ctx, in.CipherText, password)
+    if err != nil {
+        return &api.DecryptResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    return &api.DecryptResult{Result: makeResult(true, ""), PlainText: plainText}, nil
+}
+
+func (c *BinaryGrpcServer) GetPurchasedMetaData(
+    ctx context.Context,
+    in *api.PurchasedMetaDataParams,
+) (*api.PurchasedMetaDataResult, error) {
+    client, password, err := c.userClient(in.Address, in.Password, in.Token, scry.OpDecrypt)
+    if err != nil {
+        return &api.PurchasedMetaDataResult{Result: makeResult(false, err.Error())}, statusError(err)
+    }
+
+    maxSize := int64(c.config.MaxMetaDataSize)
+    if maxSize <= 0 {
+        maxSize = defaultMaxMetaDataSize
+    }
+
+    id, content, err := client.PurchasedMetaDataContext(ctx, in.MetaDataIdEncBuyer, password, maxSize)
+    if err != nil {
+        return &api.PurchasedMetaDataResult{Result: makeResult(false, err.Error()), MetaDataId: id}, statusError(err)
+    }
+
+    return &api.PurchasedMetaDataResult{Result: makeResult(true, ""), MetaDataId: id, Content: content}, nil
+}
+
+func (c *BinaryGrpcServer) VerifyMessage(
+    ctx context.Context,
+    in *api.VerifyMessageParams,
+) (*api.VerifySignatureResult, error) {
+    signer, err := signdata.VerifyMessage(in.Message, in.Signature, in.Address)
+    return makeVerifySignatureResult(signer, err), statusError(err)
+}
+
+func (c *BinaryGrpcServer) VerifyTypedData(
+    ctx context.Context,
+    in *api.VerifyTypedDataParams,
+) (*api.VerifySignatureResult, error) {
+    td, err := signdata.ParseTypedData([]byte(in.TypedData))
+    if err != nil {
+        return &api.VerifySignatureResult{Result: makeResult(false, err.Error())}, statusError(errkind.Wrap(errkind.InvalidArgument, err))
+    }
+
+    signer, err := signdata.VerifyTypedData(td, in.Signature, in.Address)
+    return makeVerifySignatureResult(signer, err), statusError(err)
+}
+
+func makeVerifySignatureResult(signer common.Address, err error) *api.VerifySignatureResult {
+    r := &api.VerifySignatureResult{Result: makeResult(true, "")}
+    if err != nil {
+        r.Result = makeResult(false, err.Error())
+    }
+    if signer != (common.Address{}) {
+        r.Signer = signer.Hex()
+    }
+
+    return r
+}
+
 func (c *BinaryGrpcServer) TransferTokens(
     ctx context.Context,
     params *api.TransferTokenParams,
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    value, err := amount.Parse(params.ValueDecimal, params.Value)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    err := c.chainWrapper.TransferTokens(
-        makeTxParams(params.TxParam),
+    err = c.chainWrapper.TransferTokens(
+        txParams,
         common.HexToAddress(params.To),
-        big.NewInt(params.Value),
+        value,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -477,35 +895,44 @@ func (c *BinaryGrpcServer) GetTokenBalance(
     params *api.TokenBalanceParams,
 ) (*api.TokenBalanceResult, error) {
     var r *api.TokenBalanceResult
-    makeTokenBalanceResult(&r, "", true, 0)
+    makeTokenBalanceResult(&r, "", true, nil)
 
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        makeTokenBalanceResult(&r, e, false, 0)
-        return r, errors.New(e)
+        makeTokenBalanceResult(&r, e, false, nil)
+        return r, statusError(errkind.New(errkind.NodeUnavailable, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        makeTokenBalanceResult(&r, err.Error(), false, nil)
+        return r, statusError(err)
     }
 
     b, err := c.chainWrapper.GetTokenBalance(
-        makeTxParams(params.TxParam),
+        txParams,
         common.HexToAddress(params.Owner),
     )
     if err != nil {
-        makeTokenBalanceResult(&r, err.Error(), false, 0)
-        return r, err
+        makeTokenBalanceResult(&r, err.Error(), false, nil)
+        return r, statusError(err)
     }
 
-    makeTokenBalanceResult(&r, "", true, b.Int64())
-    return r, err
+    makeTokenBalanceResult(&r, "", true, b)
+    return r, statusError(err)
 }
 
-func makeTokenBalanceResult(r **api.TokenBalanceResult, e string, s bool, b int64)  {
+func makeTokenBalanceResult(r **api.TokenBalanceResult, e string, s bool, b *big.Int)  {
+    dec, compat := amount.Format(b)
     if *r == nil {
         *r = &api.TokenBalanceResult{
-            Balance: b,
+            Balance: compat,
+            BalanceDecimal: dec,
             Result: makeResult(s, e),
         }
     } else {
-        (*r).Balance = b
+        (*r).Balance = compat
+        (*r).BalanceDecimal = dec
         (*r).Result = makeResult(s, e)
     }
 }
@@ -516,22 +943,27 @@ func (c *BinaryGrpcServer) PrepareToBuy(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
     }
 
-    err := c.chainWrapper.PrepareToBuy(
-        makeTxParams(params.TxParam),
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    err = c.chainWrapper.PrepareToBuy(
+        txParams,
         params.PublishId,
         params.StartVerify,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -543,21 +975,26 @@ func (c *BinaryGrpcServer) BuyData(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    err := c.chainWrapper.BuyData(
-        makeTxParams(params.TxParam),
+    err = c.chainWrapper.BuyData(
+        txParams,
         big.NewInt(params.TxId),
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -569,21 +1006,26 @@ func (c *BinaryGrpcServer) CancelTransaction(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
     }
 
-    err := c.chainWrapper.CancelTransaction(
-        makeTxParams(params.TxParam),
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    err = c.chainWrapper.CancelTransaction(
+        txParams,
         big.NewInt(params.TxId),
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -596,23 +1038,28 @@ func (c *BinaryGrpcServer) ReEncryptMetaDataId(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
     //get buyer address and arbitrators address
-    err := c.chainWrapper.ReEncryptMetaDataId(
-        makeTxParams(params.TxParam),
+    err = c.chainWrapper.ReEncryptMetaDataId(
+        txParams,
         big.NewInt(params.TxId),
         params.EncodedDataWithSeller,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -624,22 +1071,27 @@ func (c *BinaryGrpcServer) ConfirmDataTruth(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
     }
 
-    err := c.chainWrapper.ConfirmDataTruth(
-        makeTxParams(params.TxParam),
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    err = c.chainWrapper.ConfirmDataTruth(
+        txParams,
         big.NewInt(params.TxId),
         params.Truth,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -651,22 +1103,32 @@ func (c *BinaryGrpcServer) ApproveTransfer(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    err := c.chainWrapper.ApproveTransfer(
-        makeTxParams(params.TxParam),
+    value, err := amount.Parse(params.ValueDecimal, params.Value)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
+    }
+
+    err = c.chainWrapper.ApproveTransfer(
+        txParams,
         common.HexToAddress(params.SpenderAddr),
-        big.NewInt(params.Value),
+        value,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -678,23 +1140,28 @@ func (c *BinaryGrpcServer) Vote(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    err := c.chainWrapper.Vote(
-        makeTxParams(params.TxParam),
+    err = c.chainWrapper.Vote(
+        txParams,
         big.NewInt(params.TxId),
         params.Judge,
         params.Comments,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -707,20 +1174,25 @@ func (c *BinaryGrpcServer) RegisterAsVerifier(
 
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    err := c.chainWrapper.RegisterAsVerifier(
-        makeTxParams(params.TxParam),
+    err = c.chainWrapper.RegisterAsVerifier(
+        txParams,
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
@@ -732,23 +1204,28 @@ func (c *BinaryGrpcServer) CreditsToVerifier(
 ) (*api.Result, error) {
     if c.chainWrapper == nil {
         e := "invalid scry chain interface"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.NodeUnavailable, e))
     }
 
     if params == nil || params.TxParam == nil {
         e := "null publish parameters"
-        return makeResult(false, e), errors.New(e)
+        return makeResult(false, e), statusError(errkind.New(errkind.InvalidArgument, e))
+    }
+
+    txParams, err := makeTxParams(ctx, params.TxParam)
+    if err != nil {
+        return makeResult(false, err.Error()), statusError(err)
     }
 
-    err := c.chainWrapper.CreditsToVerifier(
-        makeTxParams(params.TxParam),
+    err = c.chainWrapper.CreditsToVerifier(
+        txParams,
         big.NewInt(params.TxId),
         uint8(params.Index),
         uint8(params.Credit),
     )
     if err != nil {
         e := err.Error()
-        return makeResult(false, e), err
+        return makeResult(false, e), statusError(err)
     }
 
     return makeResult(true, ""), nil
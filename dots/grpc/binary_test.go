@@ -0,0 +1,254 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "bytes"
+    "context"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/auth"
+    "github.com/scryinfo/dp/dots/auth/keystore"
+    "github.com/scryinfo/dp/dots/binary/scry"
+    "github.com/scryinfo/dp/dots/eth/event"
+    "github.com/scryinfo/dp/dots/eth/event/subscribe"
+    "github.com/scryinfo/dp/dots/storage"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "reflect"
+    "testing"
+    "time"
+)
+
+func TestListAccountsSession(t *testing.T) {
+    d, err := auth.SessionsTypeLive().Meta.NewDoter(nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &BinaryGrpcServer{Sessions: d.(*auth.Sessions)}
+
+    for _, token := range []string{"", "unknown"} {
+        rs, err := s.ListAccounts(context.Background(), &api.ListAccountsParams{Token: token})
+        if status.Code(err) != codes.Unauthenticated || rs.Result.Success || len(rs.AccountIds) != 0 {
+            t.Error("listed accounts without session", token, rs, err)
+        }
+    }
+}
+
+func TestAckEventsSession(t *testing.T) {
+    d, err := auth.SessionsTypeLive().Meta.NewDoter(nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    s := &BinaryGrpcServer{Sessions: d.(*auth.Sessions)}
+
+    addr := common.HexToAddress("0x1")
+    h := newEventHub(10, 10)
+    h.publish(testEvent(1, 0))
+    s.hubs.Store(addr.String(), h)
+
+    for _, token := range []string{"", "unknown"} {
+        rs, err := s.AckEvents(context.Background(), &api.AckEventsParams{
+            Address: addr.Hex(),
+            Cursor:  &api.EventCursor{BlockNumber: 1},
+            Token:   token,
+        })
+        if status.Code(err) != codes.Unauthenticated || rs.Success {
+            t.Error("acknowledged events without session", token, rs, err)
+        }
+    }
+    if _, replay := h.open(true, 0, 0); len(replay) != 1 {
+        t.Error("events are dropped without session", positions(replay))
+    }
+}
+
+func TestEvictIdleHubs(t *testing.T) {
+    sub := &subscribe.Subscribe{}
+    sub.SetRepo(event.NewRepository())
+    s := &BinaryGrpcServer{Subscriber: sub}
+
+    addr := common.HexToAddress("0x1")
+    h := newEventHub(10, 10)
+    s.hubs.Store(addr.String(), h)
+    if _, err := s.SubscribeEvent(context.Background(), &api.SubscribeInfo{Address: addr.Hex(), Event: []string{"Vote"}}); err != nil {
+        t.Fatal(err)
+    }
+
+    stream, _ := h.open(false, 0, 0)
+    s.evictIdleHubs(time.Now().Add(2 * defaultHubIdleTimeout))
+    if _, ok := s.hubs.Load(addr.String()); !ok {
+        t.Fatal("evicted a hub with a stream")
+    }
+
+    h.close(stream)
+    s.evictIdleHubs(time.Now().Add(defaultHubIdleTimeout - time.Minute))
+    if _, ok := s.hubs.Load(addr.String()); !ok {
+        t.Fatal("evicted a hub before its idle timeout")
+    }
+
+    s.evictIdleHubs(time.Now().Add(defaultHubIdleTimeout + time.Minute))
+    if _, ok := s.hubs.Load(addr.String()); ok {
+        t.Fatal("idle hub is kept")
+    }
+    if n := sub.Counts()["Vote"]; n != 0 {
+        t.Error("events of an evicted hub are still subscribed", n)
+    }
+    if stream, _ = h.open(false, 0, 0); stream != nil {
+        t.Error("opened a stream of an evicted hub")
+    }
+}
+
+// testLine injects the signers and the storage into clients, in place of the default line.
+type testLine struct {
+    dot.Line
+    injecter *testInjecter
+}
+
+func (c *testLine) ToInjecter() dot.Injecter {
+    return c.injecter
+}
+
+type testInjecter struct {
+    dot.Injecter
+    signers *auth.Signers
+    storage storage.Storage
+}
+
+func (c *testInjecter) Inject(obj interface{}) error {
+    v := reflect.ValueOf(obj)
+    for v.Kind() == reflect.Ptr {
+        v = v.Elem()
+    }
+    if f := v.FieldByName("Signers"); f.IsValid() && f.CanSet() {
+        f.Set(reflect.ValueOf(c.signers))
+    }
+    if f := v.FieldByName("Storage"); f.IsValid() && f.CanSet() {
+        f.Set(reflect.ValueOf(&c.storage).Elem())
+    }
+
+    return nil
+}
+
+func (c *testInjecter) GetByLiveId(id dot.LiveId) (dot.Dot, error) {
+    if id == dot.LiveId(auth.SignersTypeId) {
+        return c.signers, nil
+    }
+
+    return nil, errors.Errorf("no dot of live id %s", id)
+}
+
+type testChainWrapper struct {
+    scry.ChainWrapper
+}
+
+func TestPurchasedMetaDataHandlers(t *testing.T) {
+    dir, err := ioutil.TempDir("", "binary")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    d, err := auth.SignersTypeLive()[0].Meta.NewDoter([]byte(
+        `{"default":"keystore","keystoreDir":"` + filepath.ToSlash(filepath.Join(dir, "keystore")) + `"}`,
+    ))
+    if err != nil {
+        t.Fatal(err)
+    }
+    signers := d.(*auth.Signers)
+    if err = signers.Create(nil); err != nil {
+        t.Fatal(err)
+    }
+    local, err := storage.NewLocal(filepath.Join(dir, "storage"))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    defaultLine := dot.GetDefaultLine()
+    dot.SetDefaultLine(&testLine{injecter: &testInjecter{signers: signers, storage: local}})
+    defer dot.SetDefaultLine(defaultLine)
+
+    d, err = auth.SessionsTypeLive().Meta.NewDoter(nil)
+    if err != nil {
+        t.Fatal(err)
+    }
+    sessions := d.(*auth.Sessions)
+    sessions.Signers = signers
+    s := &BinaryGrpcServer{Sessions: sessions}
+
+    other, err := keystore.Open(filepath.Join(dir, "other"), true)
+    if err != nil {
+        t.Fatal(err)
+    }
+    addr, err := other.NewAccount("old")
+    if err != nil {
+        t.Fatal(err)
+    }
+    keyJson, err := other.Export(addr, "old", "exported")
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    ctx := context.Background()
+    in := &api.ImportAccountParams{Keystore: keyJson, KeystorePassword: "exported", Password: "buyer"}
+    if ar, err := s.ImportAccount(ctx, in); status.Code(err) != codes.Unavailable || ar.Result.Success {
+        t.Error("imported an account without chain", ar, err)
+    }
+    s.chainWrapper = &testChainWrapper{}
+    if ar, err := s.ImportAccount(ctx, &api.ImportAccountParams{Keystore: keyJson, Password: "buyer"}); err == nil || ar.Result.Success {
+        t.Error("imported an account with a wrong keystore password", ar, err)
+    }
+    ar, err := s.ImportAccount(ctx, in)
+    if err != nil || !ar.Result.Success || common.HexToAddress(ar.AccountId) != common.HexToAddress(addr) {
+        t.Fatal("failed to import an account", ar, err)
+    }
+    buyer := ar.AccountId
+
+    meta := []byte("meta data of the buyer")
+    id, err := local.SaveEncrypted(bytes.NewReader(meta), signers.WrapKey(ctx, buyer))
+    if err != nil {
+        t.Fatal(err)
+    }
+    idEnc, err := signers.Seal(ctx, []byte(id), buyer)
+    if err != nil {
+        t.Fatal(err)
+    }
+    data, err := scry.EncodeRecipients([]scry.Recipient{{Address: common.HexToAddress(buyer), CipherText: idEnc}})
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    dr, err := s.Decrypt(ctx, &api.DecryptParams{Address: buyer, Password: "buyer", CipherText: data})
+    if err != nil || !dr.Result.Success || string(dr.PlainText) != id {
+        t.Error("failed to decrypt", dr, err)
+    }
+    if dr, err = s.Decrypt(ctx, &api.DecryptParams{Address: buyer, Password: "wrong", CipherText: data}); err == nil || dr.Result.Success {
+        t.Error("decrypted with a wrong password", dr, err)
+    }
+    stranger := common.HexToAddress("0x1").Hex()
+    if dr, err = s.Decrypt(ctx, &api.DecryptParams{Address: stranger, Password: "buyer", CipherText: data}); status.Code(err) != codes.PermissionDenied {
+        t.Error("decrypted a cipher text of others", dr, err)
+    }
+
+    session, err := sessions.Unlock(ctx, buyer, "buyer", []string{scry.OpDecrypt}, 0)
+    if err != nil {
+        t.Fatal(err)
+    }
+    pr, err := s.GetPurchasedMetaData(ctx, &api.PurchasedMetaDataParams{Address: buyer, Token: session.Token, MetaDataIdEncBuyer: data})
+    if err != nil || !pr.Result.Success || pr.MetaDataId != id || !bytes.Equal(pr.Content, meta) {
+        t.Error("failed to get purchased meta data", pr, err)
+    }
+    if pr, err = s.GetPurchasedMetaData(ctx, &api.PurchasedMetaDataParams{Address: buyer, Token: "unknown", MetaDataIdEncBuyer: data}); status.Code(err) != codes.Unauthenticated {
+        t.Error("got purchased meta data without session", pr, err)
+    }
+
+    s.config.MaxMetaDataSize = 4
+    if pr, err = s.GetPurchasedMetaData(ctx, &api.PurchasedMetaDataParams{Address: buyer, Password: "buyer", MetaDataIdEncBuyer: data}); err == nil || pr.MetaDataId != id {
+        t.Error("got purchased meta data larger than the max size", pr, err)
+    }
+}
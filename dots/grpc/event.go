@@ -0,0 +1,266 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/eth/event"
+    "github.com/scryinfo/dp/dots/storage"
+    "go.uber.org/zap"
+    "math/big"
+    "time"
+)
+
+// makeProtoEvent converts e to its typed message, with the json of it as well if withJson is true.
+// Events without a message are sent as json only.
+func makeProtoEvent(e *event.Event, withJson bool) (*api.Event, error) {
+    pe := &api.Event{
+        Time:            time.Now().Unix(),
+        Name:            e.Name,
+        BlockNumber:     e.BlockNumber,
+        TxHash:          e.TxHash.String(),
+        LogIndex:        uint32(e.LogIndex),
+        ContractAddress: e.Address.String(),
+    }
+
+    f := &eventFields{data: e.Data}
+    if e.Data.Get("users") != nil {
+        pe.Users = f.addresses("users")
+    }
+
+    switch e.Name {
+    case "ChannelCreated":
+        pe.Payload = &api.Event_ChannelCreated{ChannelCreated: &api.ChannelCreatedEvent{}}
+    case "DataPublish":
+        pe.Payload = &api.Event_DataPublish{DataPublish: &api.DataPublishEvent{
+            PublishId:     f.string("publishId"),
+            Price:         f.amount("price"),
+            DespDataId:    f.string("despDataId"),
+            SupportVerify: f.bool("supportVerify"),
+        }}
+    case "TransactionCreate":
+        pe.Payload = &api.Event_TransactionCreate{TransactionCreate: &api.TransactionCreateEvent{
+            TransactionId: f.txId("transactionId"),
+            PublishId:     f.string("publishId"),
+            ProofIds:      f.cids("proofIds"),
+            NeedVerify:    f.bool("needVerify"),
+            State:         f.uint8("state"),
+        }}
+    case "RegisterVerifier":
+        pe.Payload = &api.Event_RegisterVerifier{RegisterVerifier: &api.RegisterVerifierEvent{}}
+    case "VerifiersChosen":
+        pe.Payload = &api.Event_VerifiersChosen{VerifiersChosen: &api.VerifiersChosenEvent{
+            TransactionId: f.txId("transactionId"),
+            PublishId:     f.string("publishId"),
+            ProofIds:      f.cids("proofIds"),
+            State:         f.uint8("state"),
+        }}
+    case "Vote":
+        pe.Payload = &api.Event_Vote{Vote: &api.VoteEvent{
+            TransactionId: f.txId("transactionId"),
+            Judge:         f.bool("judge"),
+            Comments:      f.string("comments"),
+            State:         f.uint8("state"),
+            Index:         f.uint8("index"),
+        }}
+    case "Buy":
+        pe.Payload = &api.Event_Buy{Buy: &api.BuyEvent{
+            TransactionId:       f.txId("transactionId"),
+            PublishId:           f.string("publishId"),
+            MetaDataIdEncSeller: f.bytes("metaDataIdEncSeller"),
+            State:               f.uint8("state"),
+            Index:               f.uint8("index"),
+        }}
+    case "ReadyForDownload":
+        pe.Payload = &api.Event_ReadyForDownload{ReadyForDownload: &api.ReadyForDownloadEvent{
+            TransactionId:      f.txId("transactionId"),
+            MetaDataIdEncBuyer: f.bytes("metaDataIdEncBuyer"),
+            State:              f.uint8("state"),
+            Index:              f.uint8("index"),
+        }}
+    case "TransactionClose":
+        pe.Payload = &api.Event_TransactionClose{TransactionClose: &api.TransactionCloseEvent{
+            TransactionId: f.txId("transactionId"),
+            State:         f.uint8("state"),
+            Index:         f.uint8("index"),
+        }}
+    case "VerifierDisable":
+        pe.Payload = &api.Event_VerifierDisable{VerifierDisable: &api.VerifierDisableEvent{
+            Verifier: f.address("verifier"),
+        }}
+    case "ArbitrationBegin":
+        pe.Payload = &api.Event_ArbitrationBegin{ArbitrationBegin: &api.ArbitrationBeginEvent{
+            TransactionId:           f.txId("transactionId"),
+            PublishId:               f.string("publishId"),
+            ProofIds:                f.cids("proofIds"),
+            MetaDataIdEncArbitrator: f.bytes("metaDataIdEncArbitrator"),
+        }}
+    case "ArbitrationResult":
+        pe.Payload = &api.Event_ArbitrationResult{ArbitrationResult: &api.ArbitrationResultEvent{
+            TransactionId: f.txId("transactionId"),
+            Judge:         f.bool("judge"),
+            Identify:      f.uint8("identify"),
+        }}
+    case "Approval":
+        pe.Payload = &api.Event_Approval{Approval: &api.ApprovalEvent{
+            Owner:   f.address("owner"),
+            Spender: f.address("spender"),
+            Value:   f.amount("value"),
+        }}
+    default:
+        withJson = true
+    }
+    if f.err != nil {
+        dot.Logger().Errorln("BinaryGrpcServer::makeProtoEvent", zap.String("event", e.Name), zap.Error(f.err))
+        return nil, f.err
+    }
+
+    if withJson {
+        obj := map[string]interface{}{
+            "BlockNumber":     e.BlockNumber,
+            "ContractAddress": e.Address.String(),
+            "EventName":       e.Name,
+            "TxHash":          e.TxHash.String(),
+            "EventData":       e.Data.String(),
+        }
+
+        jsonEvent, err := json.Marshal(obj)
+        if err != nil {
+            dot.Logger().Errorln("BinaryGrpcServer::makeProtoEvent", zap.String("error:", err.Error()))
+            return nil, err
+        }
+        pe.JsonData = string(jsonEvent)
+    }
+
+    return pe, nil
+}
+
+// eventFields reads typed fields of event data, the first field of a wrong type is recorded in err.
+type eventFields struct {
+    data event.JSONObj
+    err  error
+}
+
+func (f *eventFields) get(name string) interface{} {
+    v := f.data.Get(name)
+    if v == nil && f.err == nil {
+        f.err = errors.New("event field '" + name + "' is missing")
+    }
+
+    return v
+}
+
+func (f *eventFields) mismatch(name string) {
+    if f.err == nil {
+        f.err = errors.New("event field '" + name + "' has a wrong type")
+    }
+}
+
+func (f *eventFields) string(name string) string {
+    v, ok := f.get(name).(string)
+    if !ok {
+        f.mismatch(name)
+    }
+
+    return v
+}
+
+func (f *eventFields) bool(name string) bool {
+    v, ok := f.get(name).(bool)
+    if !ok {
+        f.mismatch(name)
+    }
+
+    return v
+}
+
+func (f *eventFields) uint8(name string) uint32 {
+    v, ok := f.get(name).(uint8)
+    if !ok {
+        f.mismatch(name)
+    }
+
+    return uint32(v)
+}
+
+func (f *eventFields) bytes(name string) []byte {
+    v, ok := f.get(name).([]byte)
+    if !ok {
+        f.mismatch(name)
+    }
+
+    return v
+}
+
+func (f *eventFields) bigInt(name string) *big.Int {
+    v, ok := f.get(name).(*big.Int)
+    if !ok || v == nil {
+        f.mismatch(name)
+        return new(big.Int)
+    }
+
+    return v
+}
+
+func (f *eventFields) txId(name string) uint64 {
+    v := f.bigInt(name)
+    if !v.IsUint64() {
+        f.mismatch(name)
+    }
+
+    return v.Uint64()
+}
+
+func (f *eventFields) amount(name string) string {
+    return f.bigInt(name).String()
+}
+
+// address reads an address, indexed ones are hex strings in event data.
+func (f *eventFields) address(name string) string {
+    switch v := f.get(name).(type) {
+    case common.Address:
+        return v.String()
+    case string:
+        if common.IsHexAddress(v) {
+            return common.HexToAddress(v).String()
+        }
+    }
+    f.mismatch(name)
+
+    return ""
+}
+
+func (f *eventFields) addresses(name string) []string {
+    v, ok := f.get(name).([]common.Address)
+    if !ok {
+        f.mismatch(name)
+    }
+
+    out := make([]string, 0, len(v))
+    for _, a := range v {
+        out = append(out, a.String())
+    }
+
+    return out
+}
+
+// cids decodes the content IDs recorded on chain.
+func (f *eventFields) cids(name string) []string {
+    v, ok := f.get(name).([][32]byte)
+    if !ok {
+        f.mismatch(name)
+        return nil
+    }
+
+    ids, err := storage.Bytes32ToCids(v)
+    if err != nil && f.err == nil {
+        f.err = errors.Wrap(err, "event field '"+name+"'")
+    }
+
+    return ids
+}
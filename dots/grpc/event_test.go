@@ -0,0 +1,73 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/eth/event"
+    "github.com/scryinfo/dp/dots/storage"
+    "math/big"
+    "testing"
+)
+
+func TestMakeProtoEvent(t *testing.T) {
+    proofIds := []string{"QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"}
+    slots, err := storage.CidsToBytes32(proofIds)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    data := event.NewJSONObj()
+    data.Set("seqNo", "app")
+    data.Set("transactionId", big.NewInt(7))
+    data.Set("publishId", "p1")
+    data.Set("proofIds", slots)
+    data.Set("needVerify", true)
+    data.Set("state", uint8(1))
+    data.Set("users", []common.Address{common.HexToAddress("0x01")})
+
+    e := &event.Event{BlockNumber: 10, LogIndex: 2, Name: "TransactionCreate", Data: data}
+    pe, err := makeProtoEvent(e, false)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if pe.JsonData != "" || pe.BlockNumber != 10 || pe.LogIndex != 2 || len(pe.Users) != 1 {
+        t.Error("wrong envelope", pe)
+    }
+
+    tc := pe.GetTransactionCreate()
+    if tc == nil || tc.TransactionId != 7 || tc.PublishId != "p1" || !tc.NeedVerify || tc.State != 1 ||
+        len(tc.ProofIds) != 1 || tc.ProofIds[0] != proofIds[0] {
+        t.Error("wrong payload", tc)
+    }
+
+    if pe, err = makeProtoEvent(e, true); err != nil || pe.JsonData == "" {
+        t.Error("json isn't kept", err)
+    }
+
+    data.Set("state", "1")
+    if _, err = makeProtoEvent(e, false); err == nil {
+        t.Error("converted a field of a wrong type")
+    }
+}
+
+func TestMakeProtoEventApproval(t *testing.T) {
+    value, _ := new(big.Int).SetString("1000000000000000000000", 10)
+    data := event.NewJSONObj()
+    // indexed addresses are hex
+    data.Set("owner", common.HexToAddress("0x01").Hex())
+    data.Set("spender", common.HexToAddress("0x02").Hex())
+    data.Set("value", value)
+
+    pe, err := makeProtoEvent(&event.Event{Name: "Approval", Data: data}, true)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    a, ok := pe.Payload.(*api.Event_Approval)
+    if !ok || a.Approval.Value != value.String() || a.Approval.Spender != common.HexToAddress("0x02").String() {
+        t.Error("wrong payload", pe.Payload)
+    }
+}
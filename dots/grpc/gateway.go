@@ -0,0 +1,259 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "context"
+    "fmt"
+    "github.com/golang/protobuf/jsonpb"
+    "github.com/grpc-ecosystem/grpc-gateway/runtime"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/auth/tlsconfig"
+    "go.uber.org/zap"
+    "google.golang.org/grpc"
+    "google.golang.org/grpc/status"
+    "io"
+    "net"
+    "net/http"
+    "strconv"
+    "strings"
+)
+
+const (
+    GatewayTypeId = "f8fae2b4-1835-4978-8b3a-e5403ec3b589"
+)
+
+// Gateway serves the BinaryService as JSON over http. The routes are in the OpenAPI documents,
+// which are served at /openapi/. Events are also streamed as server-sent events at /v1/binary/events.
+// The KeyService isn't served, it manages keys without sessions and is kept to the binaries.
+type Gateway struct {
+    config   gatewayConfig
+    client   api.BinaryServiceClient
+    conn     *grpc.ClientConn
+    server   *http.Server
+    listener net.Listener
+    cancel   context.CancelFunc
+}
+
+type gatewayConfig struct {
+    Addr       string           `json:"addr"`     //the gateway isn't served if it is empty
+    Endpoint   string           `json:"endpoint"` //grpc address of the BinaryService
+    Tls        tlsconfig.Config `json:"tls"`      //of the BinaryService
+    OpenApiDir string           `json:"openApiDir"`
+}
+
+//construct dot
+func newGatewayDot(conf interface{}) (dot.Dot, error) {
+    dConf := &gatewayConfig{}
+    if bs, ok := conf.([]byte); ok && len(bs) > 0 {
+        if err := dot.UnMarshalConfig(bs, dConf); err != nil {
+            return nil, err
+        }
+    }
+
+    d := &Gateway{config: *dConf}
+    return d, nil
+}
+
+//Data structure needed when generating newer component
+func GatewayTypeLive() *dot.TypeLives {
+    return &dot.TypeLives{
+        Meta: dot.Metadata{TypeId: GatewayTypeId, NewDoter: func(conf interface{}) (dot.Dot, error) {
+            return newGatewayDot(conf)
+        }},
+    }
+}
+
+func (c *Gateway) Create(l dot.Line) error {
+    if c.config.Addr != "" && c.config.Endpoint == "" {
+        return errors.New("endpoint of the gateway can not be empty")
+    }
+
+    return nil
+}
+
+func (c *Gateway) Start(ignore bool) error {
+    if c.config.Addr == "" {
+        return nil
+    }
+
+    handler, err := c.handler()
+    if err != nil {
+        dot.Logger().Errorln("failed to create gateway", zap.Error(err))
+        return err
+    }
+
+    c.listener, err = net.Listen("tcp", c.config.Addr)
+    if err != nil {
+        dot.Logger().Errorln("failed to listen:"+c.config.Addr, zap.Error(err))
+        return err
+    }
+
+    c.server = &http.Server{Handler: handler}
+    go func() {
+        if err := c.server.Serve(c.listener); err != nil && err != http.ErrServerClosed {
+            dot.Logger().Errorln("gateway stopped", zap.Error(err))
+        }
+    }()
+
+    dot.Logger().Infoln("gateway is listening at " + c.listener.Addr().String())
+
+    return nil
+}
+
+func (c *Gateway) Stop(ignore bool) error {
+    if c.server != nil {
+        c.server.Close()
+    }
+    if c.cancel != nil {
+        c.cancel()
+    }
+    if c.conn != nil {
+        c.conn.Close()
+        c.conn = nil
+    }
+
+    return nil
+}
+
+func (c *Gateway) Destroy(ignore bool) error {
+    return nil
+}
+
+// Addr returns the address the gateway is listening at, it is only valid after Start.
+func (c *Gateway) Addr() string {
+    if c.listener == nil {
+        return ""
+    }
+
+    return c.listener.Addr().String()
+}
+
+func (c *Gateway) handler() (http.Handler, error) {
+    var ctx context.Context
+    ctx, c.cancel = context.WithCancel(context.Background())
+
+    gw := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}))
+
+    opts := []grpc.DialOption{grpc.WithInsecure()}
+    if c.config.Tls.Enabled() {
+        creds, err := tlsconfig.NewClientCredentials(c.config.Tls)
+        if err != nil {
+            return nil, errors.Wrap(err, "invalid tls configuration of binary service")
+        }
+        opts = []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+    }
+
+    cn, err := grpc.Dial(c.config.Endpoint, opts...)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to connect the binary service")
+    }
+    c.conn = cn
+    c.client = api.NewBinaryServiceClient(cn)
+    if err = api.RegisterBinaryServiceHandler(ctx, gw, cn); err != nil {
+        return nil, err
+    }
+
+    mux := http.NewServeMux()
+    mux.HandleFunc("/v1/binary/events", c.serveEvents)
+    if c.config.OpenApiDir != "" {
+        mux.Handle("/openapi/", http.StripPrefix("/openapi/", http.FileServer(http.Dir(c.config.OpenApiDir))))
+    }
+    mux.Handle("/", gw)
+
+    return mux, nil
+}
+
+// serveEvents streams the events of the address in the query as server-sent events, the id of an event
+// is its cursor "blockNumber-logIndex". Streams resume after the Last-Event-ID header or the cursor in the query.
+func (c *Gateway) serveEvents(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodGet {
+        http.Error(w, "method is not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    flusher, ok := w.(http.Flusher)
+    if !ok {
+        http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+        return
+    }
+
+    q := r.URL.Query()
+    info := &api.ClientInfo{Address: q.Get("address"), TypedEvents: q.Get("typedEvents") == "true"}
+
+    cursor := q.Get("cursor")
+    if id := r.Header.Get("Last-Event-ID"); id != "" {
+        cursor = id
+    }
+    if cursor != "" {
+        var err error
+        if info.Cursor, err = parseCursor(cursor); err != nil {
+            http.Error(w, err.Error(), http.StatusBadRequest)
+            return
+        }
+    }
+
+    stream, err := c.client.RecvEvents(r.Context(), info)
+    if err != nil {
+        http.Error(w, err.Error(), runtime.HTTPStatusFromCode(status.Code(err)))
+        return
+    }
+
+    m := jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+    started := false
+    for {
+        e, err := stream.Recv()
+        if err == io.EOF {
+            return
+        } else if err != nil {
+            s := status.Convert(err)
+            if !started {
+                http.Error(w, s.Message(), runtime.HTTPStatusFromCode(s.Code()))
+            } else if r.Context().Err() == nil {
+                fmt.Fprintf(w, "event: error\ndata: %s\n\n", strings.Replace(s.Message(), "\n", " ", -1))
+                flusher.Flush()
+            }
+            return
+        }
+
+        data, err := m.MarshalToString(e)
+        if err != nil {
+            dot.Logger().Errorln("Gateway::serveEvents", zap.Error(err))
+            continue
+        }
+
+        if !started {
+            w.Header().Set("Content-Type", "text/event-stream")
+            w.Header().Set("Cache-Control", "no-cache")
+            w.WriteHeader(http.StatusOK)
+            started = true
+        }
+
+        //events which are not on chain, such as ChannelCreated, have no cursor
+        if e.BlockNumber > 0 {
+            fmt.Fprintf(w, "id: %d-%d\n", e.BlockNumber, e.LogIndex)
+        }
+        fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Name, data)
+        flusher.Flush()
+    }
+}
+
+func parseCursor(s string) (*api.EventCursor, error) {
+    parts := strings.Split(s, "-")
+    if len(parts) != 2 {
+        return nil, errors.New("invalid cursor '" + s + "'")
+    }
+
+    block, err := strconv.ParseUint(parts[0], 10, 64)
+    if err != nil {
+        return nil, errors.New("invalid cursor '" + s + "'")
+    }
+    index, err := strconv.ParseUint(parts[1], 10, 32)
+    if err != nil {
+        return nil, errors.New("invalid cursor '" + s + "'")
+    }
+
+    return &api.EventCursor{BlockNumber: block, LogIndex: uint32(index)}, nil
+}
@@ -0,0 +1,126 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "bufio"
+    "context"
+    "encoding/json"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/eth/event"
+    "google.golang.org/grpc"
+    "net"
+    "net/http"
+    "strings"
+    "testing"
+)
+
+func startTestGateway(t *testing.T, s *BinaryGrpcServer) *Gateway {
+    l, err := net.Listen("tcp", "127.0.0.1:0")
+    if err != nil {
+        t.Fatal(err)
+    }
+    gs := grpc.NewServer()
+    api.RegisterBinaryServiceServer(gs, s)
+    go gs.Serve(l)
+
+    g := &Gateway{config: gatewayConfig{Addr: "127.0.0.1:0", Endpoint: l.Addr().String()}}
+    if err = g.Start(false); err != nil {
+        t.Fatal(err)
+    }
+
+    return g
+}
+
+func TestGatewayErrors(t *testing.T) {
+    g := startTestGateway(t, &BinaryGrpcServer{})
+    defer g.Stop(false)
+
+    resp, err := http.Post("http://"+g.Addr()+"/v1/binary/ack-events", "application/json", strings.NewReader("{}"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer resp.Body.Close()
+
+    var body struct {
+        Code    int               `json:"code"`
+        Details []json.RawMessage `json:"details"`
+    }
+    if err = json.NewDecoder(resp.Body).Decode(&body); err != nil {
+        t.Fatal(err)
+    }
+    if resp.StatusCode != http.StatusBadRequest || len(body.Details) != 1 ||
+        !strings.Contains(string(body.Details[0]), "INVALID_ARGUMENT") {
+        t.Error("wrong error response", resp.StatusCode, body)
+    }
+
+    // keys are managed by the binaries only
+    keys, err := http.Post("http://"+g.Addr()+"/v1/keys/generate-address", "application/json", strings.NewReader("{}"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    keys.Body.Close()
+    if keys.StatusCode != http.StatusNotFound {
+        t.Error("key service is served", keys.StatusCode)
+    }
+}
+
+func TestGatewayEvents(t *testing.T) {
+    s := &BinaryGrpcServer{}
+    addr := common.HexToAddress("0x01")
+
+    hub := newEventHub(10, 10)
+    for _, e := range []event.Event{testEvent(1, 0), testEvent(2, 3)} {
+        e.Name, e.Data = "RegisterVerifier", event.NewJSONObj()
+        hub.publish(e)
+    }
+    s.hubs.Store(addr.String(), hub)
+
+    g := startTestGateway(t, s)
+    defer g.Stop(false)
+
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+
+    req, _ := http.NewRequest(http.MethodGet, "http://"+g.Addr()+"/v1/binary/events?address="+addr.Hex(), nil)
+    req.Header.Set("Last-Event-ID", "1-0")
+    resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+        t.Fatal("wrong response", resp.StatusCode, resp.Header)
+    }
+
+    var ids, names []string
+    r := bufio.NewScanner(resp.Body)
+    for len(names) < 2 && r.Scan() {
+        line := r.Text()
+        if strings.HasPrefix(line, "id: ") {
+            ids = append(ids, strings.TrimPrefix(line, "id: "))
+        } else if strings.HasPrefix(line, "event: ") {
+            names = append(names, strings.TrimPrefix(line, "event: "))
+        }
+    }
+
+    if strings.Join(names, ",") != "ChannelCreated,RegisterVerifier" || strings.Join(ids, ",") != "2-3" {
+        t.Error("wrong events", names, ids)
+    }
+}
+
+func TestParseCursor(t *testing.T) {
+    c, err := parseCursor("12-3")
+    if err != nil || c.BlockNumber != 12 || c.LogIndex != 3 {
+        t.Error("wrong cursor", c, err)
+    }
+
+    for _, s := range []string{"12", "a-1", "1-b", "1-2-3"} {
+        if _, err = parseCursor(s); err == nil {
+            t.Error("parsed invalid cursor", s)
+        }
+    }
+}
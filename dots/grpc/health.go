@@ -0,0 +1,141 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "context"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/storage"
+    "google.golang.org/grpc/health"
+    healthpb "google.golang.org/grpc/health/grpc_health_v1"
+    "sort"
+    "time"
+)
+
+const (
+    binaryServiceName = "api.BinaryService"
+
+    defaultHealthCheckInterval = 10 * time.Second
+    defaultHealthCheckTimeout  = 5 * time.Second
+)
+
+// BinaryStatus is the state of the binary which the service runs for.
+type BinaryStatus struct {
+    Dependencies map[string]error //by name, nil for the healthy ones
+    ScannedBlock uint64
+    HeadBlock    uint64
+    QueuedEvents int
+    Cache        *storage.CacheStats //nil if contents aren't cached
+}
+
+// StatusSource returns the state of the binary, it is called by the Status rpc and by health checks.
+type StatusSource func(ctx context.Context) BinaryStatus
+
+func (c *BinaryGrpcServer) SetStatusSource(s StatusSource) {
+    c.statusSource.Store(s)
+}
+
+func (c *BinaryGrpcServer) Status(ctx context.Context, params *api.StatusParams) (*api.StatusResult, error) {
+    return c.collectStatus(ctx), nil
+}
+
+func (c *BinaryGrpcServer) collectStatus(ctx context.Context) *api.StatusResult {
+    rs := &api.StatusResult{Result: makeResult(true, ""), Ready: true}
+
+    if source, ok := c.statusSource.Load().(StatusSource); ok && source != nil {
+        ctx, cancel := context.WithTimeout(ctx, seconds(c.config.HealthCheckTimeout, defaultHealthCheckTimeout))
+        defer cancel()
+
+        st := source(ctx)
+        names := make([]string, 0, len(st.Dependencies))
+        for name := range st.Dependencies {
+            names = append(names, name)
+        }
+        sort.Strings(names)
+
+        for _, name := range names {
+            d := &api.DependencyStatus{Name: name, Healthy: true}
+            if err := st.Dependencies[name]; err != nil {
+                d.Healthy, d.Error = false, err.Error()
+                rs.Ready = false
+            }
+            rs.Dependencies = append(rs.Dependencies, d)
+        }
+
+        rs.ScannedBlock = st.ScannedBlock
+        rs.HeadBlock = st.HeadBlock
+        rs.QueuedEvents = uint32(st.QueuedEvents)
+        if st.Cache != nil {
+            rs.Cache = &api.CacheStatus{
+                Hits:         st.Cache.Hits,
+                Misses:       st.Cache.Misses,
+                Evictions:    st.Cache.Evictions,
+                Corruptions:  st.Cache.Corruptions,
+                PassThroughs: st.Cache.PassThroughs,
+                Entries:      uint32(st.Cache.Entries),
+                Size:         st.Cache.Size,
+                MaxSize:      st.Cache.MaxSize,
+            }
+        }
+    }
+
+    if c.Subscriber != nil {
+        counts := c.Subscriber.Counts()
+        events := make([]string, 0, len(counts))
+        for name := range counts {
+            events = append(events, name)
+        }
+        sort.Strings(events)
+
+        for _, name := range events {
+            rs.Subscribers = append(rs.Subscribers, &api.EventSubscribers{Event: name, Count: uint32(counts[name])})
+        }
+    }
+
+    c.hubs.Range(func(key, value interface{}) bool {
+        rs.Streams += uint32(value.(*eventHub).streamCount())
+        return true
+    })
+
+    return rs
+}
+
+// checkHealth sets the health of the BinaryService by its dependencies until stop is closed,
+// the server itself ("") is serving while it runs.
+func (c *BinaryGrpcServer) checkHealth(h *health.Server, stop chan struct{}) {
+    interval := seconds(c.config.HealthCheckInterval, defaultHealthCheckInterval)
+
+    //checks in flight are cancelled when stop is closed
+    ctx, cancel := context.WithCancel(context.Background())
+    defer cancel()
+    go func() {
+        <-stop
+        cancel()
+    }()
+
+    for {
+        s := healthpb.HealthCheckResponse_NOT_SERVING
+        if c.collectStatus(ctx).Ready {
+            s = healthpb.HealthCheckResponse_SERVING
+        }
+        if ctx.Err() != nil {
+            return
+        }
+        h.SetServingStatus(binaryServiceName, s)
+
+        select {
+        case <-stop:
+            return
+        case <-time.After(interval):
+        }
+    }
+}
+
+func seconds(v uint64, def time.Duration) time.Duration {
+    if v == 0 {
+        return def
+    }
+
+    return time.Duration(v) * time.Second
+}
@@ -0,0 +1,137 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "context"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/eth/event"
+    "github.com/scryinfo/dp/dots/eth/event/subscribe"
+    "github.com/scryinfo/dp/dots/storage"
+    "google.golang.org/grpc/health"
+    healthpb "google.golang.org/grpc/health/grpc_health_v1"
+    "testing"
+    "time"
+)
+
+func TestStatus(t *testing.T) {
+    s := &BinaryGrpcServer{}
+
+    rs, err := s.Status(context.Background(), &api.StatusParams{})
+    if err != nil || !rs.Ready || len(rs.Dependencies) != 0 {
+        t.Error("wrong status without source", rs, err)
+    }
+
+    s.SetStatusSource(func(ctx context.Context) BinaryStatus {
+        if _, ok := ctx.Deadline(); !ok {
+            t.Error("status is checked without timeout")
+        }
+
+        return BinaryStatus{
+            Dependencies: map[string]error{"storage": errors.New("down"), "node": nil},
+            ScannedBlock: 10,
+            HeadBlock:    12,
+            QueuedEvents: 3,
+            Cache:        &storage.CacheStats{Hits: 4, PassThroughs: 1, Entries: 2, Size: 18, MaxSize: 20},
+        }
+    })
+
+    hub := newEventHub(10, 10)
+    hub.open(false, 0, 0)
+    s.hubs.Store("0x01", hub)
+
+    s.Subscriber = &subscribe.Subscribe{}
+    s.Subscriber.SetRepo(event.NewRepository())
+    for _, addr := range []string{"0x01", "0x02"} {
+        s.Subscriber.Subscribe(common.HexToAddress(addr), "Vote", func(event.Event) bool { return true })
+    }
+
+    rs, err = s.Status(context.Background(), &api.StatusParams{})
+    if err != nil || rs.Ready || rs.ScannedBlock != 10 || rs.HeadBlock != 12 || rs.QueuedEvents != 3 || rs.Streams != 1 {
+        t.Fatal("wrong status", rs, err)
+    }
+
+    if c := rs.Cache; c == nil || c.Hits != 4 || c.PassThroughs != 1 || c.Entries != 2 || c.Size != 18 || c.MaxSize != 20 {
+        t.Error("wrong cache status", c)
+    }
+
+    deps := rs.Dependencies
+    if len(deps) != 2 || deps[0].Name != "node" || !deps[0].Healthy ||
+        deps[1].Name != "storage" || deps[1].Healthy || deps[1].Error != "down" {
+        t.Error("wrong dependencies", deps)
+    }
+    if subs := rs.Subscribers; len(subs) != 1 || subs[0].Event != "Vote" || subs[0].Count != 2 {
+        t.Error("wrong subscribers", subs)
+    }
+}
+
+func TestCheckHealth(t *testing.T) {
+    s := &BinaryGrpcServer{config: binaryGrpcServerConfig{HealthCheckInterval: 1}}
+    healthy := make(chan bool, 1)
+    healthy <- false
+    s.SetStatusSource(func(ctx context.Context) BinaryStatus {
+        st := BinaryStatus{Dependencies: map[string]error{"node": errors.New("down")}}
+        select {
+        case ok := <-healthy:
+            if ok {
+                st.Dependencies["node"] = nil
+            }
+        default:
+        }
+
+        return st
+    })
+
+    h := health.NewServer()
+    stop := make(chan struct{})
+    defer close(stop)
+    go s.checkHealth(h, stop)
+
+    wait := func(want healthpb.HealthCheckResponse_ServingStatus) {
+        for i := 0; i < 50; i++ {
+            rs, err := h.Check(context.Background(), &healthpb.HealthCheckRequest{Service: binaryServiceName})
+            if err == nil && rs.Status == want {
+                return
+            }
+            time.Sleep(50 * time.Millisecond)
+        }
+        t.Fatal("health is not", want)
+    }
+
+    wait(healthpb.HealthCheckResponse_NOT_SERVING)
+    healthy <- true
+    wait(healthpb.HealthCheckResponse_SERVING)
+
+    rs, err := h.Check(context.Background(), &healthpb.HealthCheckRequest{})
+    if err != nil || rs.Status != healthpb.HealthCheckResponse_SERVING {
+        t.Error("server is not serving", rs, err)
+    }
+}
+
+func TestCheckHealthStop(t *testing.T) {
+    s := &BinaryGrpcServer{}
+    checking := make(chan struct{})
+    s.SetStatusSource(func(ctx context.Context) BinaryStatus {
+        close(checking)
+        <-ctx.Done()
+        return BinaryStatus{}
+    })
+
+    stop := make(chan struct{})
+    done := make(chan struct{})
+    go func() {
+        s.checkHealth(health.NewServer(), stop)
+        close(done)
+    }()
+
+    <-checking
+    close(stop)
+    select {
+    case <-done:
+    case <-time.After(time.Second):
+        t.Fatal("check in flight is not cancelled")
+    }
+}
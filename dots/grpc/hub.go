@@ -0,0 +1,169 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "github.com/scryinfo/dp/dots/eth/event"
+    "sort"
+    "sync"
+    "time"
+)
+
+const (
+    defaultEventChanCapacity = 100
+    defaultEventBufferSize   = 1000
+    defaultHubIdleTimeout    = time.Hour
+
+    hubEvictInterval = time.Minute
+)
+
+// eventHub fans the events of an address out to its streams, and buffers them so streams which
+// reconnect can resume after the last event they received. Buffered events are dropped when they
+// are acknowledged or the buffer is full. A hub without streams is evicted once it is idle for long,
+// its buffered events are dropped with it.
+type eventHub struct {
+    mutex    sync.Mutex
+    events   []event.Event //oldest first
+    capacity int
+    chanSize int
+    streams  map[*eventStream]struct{}
+    names    map[string]bool //events subscribed for the address
+    idle     time.Time       //the last stream closed, or the hub is created
+    evicted  bool
+}
+
+// eventStream is a stream of a hub, it is closed by the hub if it can't keep up with the events.
+type eventStream struct {
+    events chan event.Event
+    lagged chan struct{}
+}
+
+func newEventHub(capacity int, chanSize int) *eventHub {
+    if capacity <= 0 {
+        capacity = defaultEventBufferSize
+    }
+    if chanSize <= 0 {
+        chanSize = defaultEventChanCapacity
+    }
+
+    return &eventHub{
+        capacity: capacity,
+        chanSize: chanSize,
+        streams:  make(map[*eventStream]struct{}),
+        names:    make(map[string]bool),
+        idle:     time.Now(),
+    }
+}
+
+// after reports if e is on chain after the cursor.
+func after(e *event.Event, blockNumber uint64, logIndex uint) bool {
+    return e.BlockNumber > blockNumber || e.BlockNumber == blockNumber && e.LogIndex > logIndex
+}
+
+func (h *eventHub) publish(e event.Event) {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    if len(h.events) >= h.capacity {
+        h.events = append(h.events[:0], h.events[len(h.events)-h.capacity+1:]...)
+    }
+    h.events = append(h.events, e)
+
+    for s := range h.streams {
+        select {
+        case s.events <- e:
+        default:
+            delete(h.streams, s)
+            close(s.lagged)
+        }
+    }
+}
+
+// open adds a stream, which gets the buffered events after the cursor first if resume is true.
+// The stream is nil if the hub is evicted.
+func (h *eventHub) open(resume bool, blockNumber uint64, logIndex uint) (*eventStream, []event.Event) {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    if h.evicted {
+        return nil, nil
+    }
+
+    var replay []event.Event
+    if resume {
+        for i := range h.events {
+            if after(&h.events[i], blockNumber, logIndex) {
+                replay = append(replay, h.events[i:]...)
+                break
+            }
+        }
+    }
+
+    s := &eventStream{events: make(chan event.Event, h.chanSize), lagged: make(chan struct{})}
+    h.streams[s] = struct{}{}
+
+    return s, replay
+}
+
+func (h *eventHub) close(s *eventStream) {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    delete(h.streams, s)
+    if len(h.streams) == 0 {
+        h.idle = time.Now()
+    }
+}
+
+// ack drops the buffered events up to the cursor.
+func (h *eventHub) ack(blockNumber uint64, logIndex uint) {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    i := 0
+    for i < len(h.events) && !after(&h.events[i], blockNumber, logIndex) {
+        i++
+    }
+    h.events = append(h.events[:0], h.events[i:]...)
+}
+
+func (h *eventHub) streamCount() int {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    return len(h.streams)
+}
+
+func (h *eventHub) subscribed(names []string, ok bool) {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    for _, name := range names {
+        if ok {
+            h.names[name] = true
+        } else {
+            delete(h.names, name)
+        }
+    }
+}
+
+// evict marks the hub evicted if it has no stream since timeout before now, and returns the events
+// subscribed for it.
+func (h *eventHub) evict(now time.Time, timeout time.Duration) ([]string, bool) {
+    h.mutex.Lock()
+    defer h.mutex.Unlock()
+
+    if len(h.streams) > 0 || now.Sub(h.idle) < timeout {
+        return nil, false
+    }
+    h.evicted = true
+
+    names := make([]string, 0, len(h.names))
+    for name := range h.names {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+
+    return names, true
+}
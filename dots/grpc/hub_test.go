@@ -0,0 +1,79 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "github.com/scryinfo/dp/dots/eth/event"
+    "testing"
+)
+
+func testEvent(block uint64, index uint) event.Event {
+    return event.Event{BlockNumber: block, LogIndex: index, Name: "Vote"}
+}
+
+func positions(events []event.Event) [][2]uint64 {
+    var out [][2]uint64
+    for _, e := range events {
+        out = append(out, [2]uint64{e.BlockNumber, uint64(e.LogIndex)})
+    }
+
+    return out
+}
+
+func TestEventHubResume(t *testing.T) {
+    h := newEventHub(3, 10)
+    for _, e := range []event.Event{testEvent(1, 0), testEvent(1, 1), testEvent(2, 0), testEvent(3, 4)} {
+        h.publish(e)
+    }
+
+    // the oldest event is dropped from the full buffer
+    _, replay := h.open(true, 0, 0)
+    if got := positions(replay); len(got) != 3 || got[0] != [2]uint64{1, 1} {
+        t.Error("wrong replay", got)
+    }
+
+    _, replay = h.open(true, 2, 0)
+    if got := positions(replay); len(got) != 1 || got[0] != [2]uint64{3, 4} {
+        t.Error("wrong replay after cursor", got)
+    }
+
+    if _, replay = h.open(false, 0, 0); len(replay) != 0 {
+        t.Error("replayed without cursor", positions(replay))
+    }
+
+    h.ack(2, 0)
+    if _, replay = h.open(true, 0, 0); len(replay) != 1 {
+        t.Error("acknowledged events are replayed", positions(replay))
+    }
+}
+
+func TestEventHubStreams(t *testing.T) {
+    h := newEventHub(10, 1)
+    s1, _ := h.open(false, 0, 0)
+    s2, _ := h.open(false, 0, 0)
+
+    h.publish(testEvent(1, 0))
+    for _, s := range []*eventStream{s1, s2} {
+        if e := <-s.events; e.BlockNumber != 1 {
+            t.Error("wrong event", e)
+        }
+    }
+
+    h.close(s2)
+    if h.streamCount() != 1 {
+        t.Fatal("closed stream is kept")
+    }
+
+    // s1 can't keep up
+    h.publish(testEvent(2, 0))
+    h.publish(testEvent(3, 0))
+    select {
+    case <-s1.lagged:
+    default:
+        t.Fatal("lagging stream isn't closed")
+    }
+    if h.streamCount() != 0 {
+        t.Error("lagging stream is kept")
+    }
+}
@@ -0,0 +1,47 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/errkind"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+)
+
+var kindCodes = map[errkind.Kind]codes.Code{
+    errkind.InvalidArgument:       codes.InvalidArgument,
+    errkind.Unauthenticated:       codes.Unauthenticated,
+    errkind.PermissionDenied:      codes.PermissionDenied,
+    errkind.NotFound:              codes.NotFound,
+    errkind.InsufficientBalance:   codes.FailedPrecondition,
+    errkind.InsufficientAllowance: codes.FailedPrecondition,
+    errkind.ContractReverted:      codes.FailedPrecondition,
+    errkind.NodeUnavailable:       codes.Unavailable,
+    errkind.KeyServiceUnavailable: codes.Unavailable,
+    errkind.Aborted:               codes.Aborted,
+}
+
+// statusError converts err to a status with the code of its kind, the kind is in its ErrorDetail.
+func statusError(err error) error {
+    if err == nil {
+        return nil
+    }
+    if _, ok := status.FromError(err); ok {
+        return err
+    }
+
+    kind := errkind.Of(err)
+    code, ok := kindCodes[kind]
+    if !ok {
+        code = codes.Unknown
+    }
+
+    s := status.New(code, err.Error())
+    if sd, er := s.WithDetails(&api.ErrorDetail{Kind: kind.String(), Message: err.Error()}); er == nil {
+        s = sd
+    }
+
+    return s.Err()
+}
@@ -0,0 +1,53 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package grpc
+
+import (
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dp/api/go"
+    "github.com/scryinfo/dp/dots/errkind"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+    "testing"
+)
+
+func TestStatusError(t *testing.T) {
+    if statusError(nil) != nil {
+        t.Fatal("status of nil error")
+    }
+
+    cases := []struct {
+        err  error
+        code codes.Code
+        kind string
+    }{
+        {errors.New("failed"), codes.Unknown, "UNKNOWN"},
+        {errkind.New(errkind.InvalidArgument, "null publish parameters"), codes.InvalidArgument, "INVALID_ARGUMENT"},
+        {errors.Wrap(errkind.New(errkind.InsufficientAllowance, "not approved"), "failed to buy"), codes.FailedPrecondition, "INSUFFICIENT_ALLOWANCE"},
+        {errkind.New(errkind.KeyServiceUnavailable, "client is null"), codes.Unavailable, "KEY_SERVICE_UNAVAILABLE"},
+    }
+
+    for _, c := range cases {
+        s := status.Convert(statusError(c.err))
+        if s.Code() != c.code || s.Message() != c.err.Error() {
+            t.Error("wrong status of", c.err, s.Code(), s.Message())
+            continue
+        }
+
+        details := s.Details()
+        if len(details) != 1 {
+            t.Error("wrong details of", c.err, details)
+            continue
+        }
+        if d, ok := details[0].(*api.ErrorDetail); !ok || d.Kind != c.kind || d.Message != c.err.Error() {
+            t.Error("wrong detail of", c.err, details[0])
+        }
+    }
+
+    // statuses are kept
+    err := status.Error(codes.Canceled, "canceled")
+    if statusError(err) != err {
+        t.Error("status is converted again")
+    }
+}
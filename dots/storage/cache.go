@@ -0,0 +1,349 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "container/list"
+    "context"
+    "github.com/pkg/errors"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "sort"
+    "strings"
+    "sync"
+    "time"
+)
+
+// Cache keeps the contents read from a backend in a directory as files named by their IDs, the least
+// recently used ones are evicted once they exceed the size limit. Cached contents are checked
+// against their IDs when they are cached, and again when their files change, corrupted ones are fetched again.
+// Trees whose layout can't be reproduced are passed through without caching.
+type Cache struct {
+    Storage
+    dir     string
+    maxSize int64
+    mutex   sync.Mutex
+    entries map[string]*list.Element //of *cacheEntry by key
+    lru     *list.List               //most recently used first
+    size    int64
+    stats   CacheStats
+}
+
+type cacheEntry struct {
+    key      string
+    size     int64
+    modTime  time.Time
+    verified bool //the file of size and modTime matches key
+}
+
+// unchanged reports if f is the file verified for e.
+func (e cacheEntry) unchanged(f *os.File) bool {
+    fi, err := f.Stat()
+    return err == nil && e.verified && fi.Size() == e.size && fi.ModTime().Equal(e.modTime)
+}
+
+// CacheStats is the statistics of a cache since it is created.
+type CacheStats struct {
+    Hits         int64 `json:"hits"`
+    Misses       int64 `json:"misses"`
+    Evictions    int64 `json:"evictions"`
+    Corruptions  int64 `json:"corruptions"`
+    PassThroughs int64 `json:"passThroughs"` //misses which can't be verified
+    Entries      int   `json:"entries"`
+    Size         int64 `json:"size"`
+    MaxSize      int64 `json:"maxSize"`
+}
+
+// check if 'Cache' implements 'Storage' and 'Pinner' interface.
+var _ Storage = (*Cache)(nil)
+var _ Pinner = (*Cache)(nil)
+
+// NewCache caches the contents of backend in dir up to maxSize bytes, contents already in dir are kept
+// in the order they were last used.
+func NewCache(backend Storage, dir string, maxSize int64) (*Cache, error) {
+    if dir == "" || maxSize <= 0 {
+        return nil, errors.New("cache needs a directory and a size limit")
+    }
+    if err := os.MkdirAll(dir, 0700); err != nil {
+        return nil, errors.Wrap(err, "failed to create cache directory")
+    }
+
+    fis, err := ioutil.ReadDir(dir)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to read cache directory")
+    }
+    sort.Slice(fis, func(i, j int) bool { return fis[i].ModTime().After(fis[j].ModTime()) })
+
+    c := &Cache{Storage: backend, dir: dir, maxSize: maxSize, entries: make(map[string]*list.Element), lru: list.New()}
+    for _, fi := range fis {
+        if strings.HasPrefix(fi.Name(), ".") {
+            os.Remove(filepath.Join(dir, fi.Name()))
+            continue
+        }
+        if fi.IsDir() {
+            continue
+        }
+        if _, err := ParseCid(fi.Name()); err != nil {
+            continue
+        }
+
+        c.entries[fi.Name()] = c.lru.PushBack(&cacheEntry{key: fi.Name(), size: fi.Size()})
+        c.size += fi.Size()
+    }
+    c.evict("")
+
+    return c, nil
+}
+
+func (c *Cache) Get(key string, outDir string) error {
+    err := getFile(c, key, outDir)
+    if errors.Cause(err) == ErrIsDirectory {
+        //directories aren't cached
+        return c.Storage.Get(key, outDir)
+    }
+
+    return err
+}
+
+// Open returns a reader of the cached content of key, which is fetched from the backend if it isn't cached.
+// Contents which can't be verified aren't cached.
+func (c *Cache) Open(ctx context.Context, key string, opts ...Option) (io.ReadCloser, error) {
+    id, err := ParseCid(key)
+    if err != nil {
+        return nil, err
+    }
+    if _, _, err = idHash(id); err != nil {
+        return c.Storage.Open(ctx, key, opts...)
+    }
+    key = id.String()
+
+    if f := c.openCached(id, key); f != nil {
+        return newStreamReadCloser(ctx, f, opts), nil
+    }
+
+    rc, err := c.fetch(ctx, id, key, opts)
+    if err != nil {
+        return nil, err
+    }
+
+    return newStreamReadCloser(ctx, rc, nil), nil
+}
+
+// GetDecrypted writes the content of the envelope stored as key to outFile,
+// outFile is removed if the envelope can't be decrypted.
+func (c *Cache) GetDecrypted(key string, outFile string, unwrap UnwrapKey) error {
+    rc, err := c.Open(context.Background(), key)
+    if err != nil {
+        return err
+    }
+    defer rc.Close()
+
+    return decryptToFile(outFile, rc, unwrap)
+}
+
+func (c *Cache) Pin(ctx context.Context, key string) error {
+    p, ok := c.Storage.(Pinner)
+    if !ok {
+        return errors.New("storage can't pin contents")
+    }
+
+    return p.Pin(ctx, key)
+}
+
+func (c *Cache) Unpin(ctx context.Context, key string) error {
+    p, ok := c.Storage.(Pinner)
+    if !ok {
+        return errors.New("storage can't pin contents")
+    }
+
+    return p.Unpin(ctx, key)
+}
+
+func (c *Cache) Pinned(ctx context.Context, key string) (bool, error) {
+    p, ok := c.Storage.(Pinner)
+    if !ok {
+        return false, errors.New("storage can't pin contents")
+    }
+
+    return p.Pinned(ctx, key)
+}
+
+// Ping pings the backend, backends which can't be pinged are taken as reachable.
+func (c *Cache) Ping(ctx context.Context) error {
+    if p, ok := c.Storage.(Pinger); ok {
+        return p.Ping(ctx)
+    }
+
+    return nil
+}
+
+func (c *Cache) Stats() CacheStats {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    s := c.stats
+    s.Entries = c.lru.Len()
+    s.Size = c.size
+    s.MaxSize = c.maxSize
+
+    return s
+}
+
+// openCached returns the cached file of key if it matches id, nil if it isn't cached or is corrupted.
+func (c *Cache) openCached(id Cid, key string) *os.File {
+    var entry cacheEntry
+    c.mutex.Lock()
+    e, ok := c.entries[key]
+    if ok {
+        entry = *e.Value.(*cacheEntry)
+    }
+    c.mutex.Unlock()
+    if !ok {
+        return nil
+    }
+
+    name := filepath.Join(c.dir, key)
+    f, err := os.Open(name)
+    if err == nil && !entry.unchanged(f) {
+        var computed Cid
+        if computed, err = computeId(id, f); err == nil && !computed.SameContent(id) {
+            err = ErrContentMismatch
+        }
+        if err == nil {
+            _, err = f.Seek(0, io.SeekStart)
+        }
+    }
+
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    if err != nil {
+        if f != nil {
+            f.Close()
+        }
+        if e, ok := c.entries[key]; ok {
+            c.remove(e)
+            c.stats.Corruptions++
+        }
+        return nil
+    }
+
+    now := time.Now()
+    os.Chtimes(name, now, now)
+    if e, ok := c.entries[key]; ok {
+        c.lru.MoveToFront(e)
+        if fi, err := f.Stat(); err == nil {
+            ce := e.Value.(*cacheEntry)
+            ce.modTime, ce.verified = fi.ModTime(), ce.size == fi.Size()
+        }
+    }
+    c.stats.Hits++
+
+    return f
+}
+
+// fetch reads the content of key from the backend into the cache, the content is removed
+// and ErrContentMismatch is returned if it doesn't match id. Content which can't be verified
+// is returned in a temporary file, which is removed when it is closed.
+func (c *Cache) fetch(ctx context.Context, id Cid, key string, opts []Option) (io.ReadCloser, error) {
+    c.mutex.Lock()
+    c.stats.Misses++
+    c.mutex.Unlock()
+
+    rc, err := c.Storage.Open(ctx, key)
+    if err != nil {
+        return nil, err
+    }
+    defer rc.Close()
+
+    tmp, err := ioutil.TempFile(c.dir, ".fetch-")
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to create cache file")
+    }
+    computed, err := computeId(id, io.TeeReader(newStreamReader(ctx, rc, opts), tmp))
+    if err == ErrUnverifiable {
+        if _, err = tmp.Seek(0, io.SeekStart); err == nil {
+            c.mutex.Lock()
+            c.stats.PassThroughs++
+            c.mutex.Unlock()
+            return tempFile{tmp}, nil
+        }
+    }
+    defer os.Remove(tmp.Name())
+
+    if err == nil && !computed.SameContent(id) {
+        err = ErrContentMismatch
+    }
+    if er := tmp.Close(); err == nil {
+        err = er
+    }
+    if err != nil {
+        return nil, err
+    }
+
+    fi, err := os.Stat(tmp.Name())
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to read cache file")
+    }
+
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    if e, ok := c.entries[key]; ok {
+        c.lru.Remove(e)
+        c.size -= e.Value.(*cacheEntry).size
+    }
+
+    name := filepath.Join(c.dir, key)
+    if err = os.Rename(tmp.Name(), name); err != nil {
+        delete(c.entries, key)
+        return nil, errors.Wrap(err, "failed to cache content")
+    }
+    f, err := os.Open(name)
+    if err != nil {
+        delete(c.entries, key)
+        return nil, errors.Wrap(err, "failed to open cached content")
+    }
+
+    c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, size: fi.Size(), modTime: fi.ModTime(), verified: true})
+    c.size += fi.Size()
+    c.evict(key)
+
+    return f, nil
+}
+
+type tempFile struct {
+    *os.File
+}
+
+func (f tempFile) Close() error {
+    err := f.File.Close()
+    os.Remove(f.Name())
+
+    return err
+}
+
+// evict removes the least recently used contents but keep until the cache fits its size limit,
+// the caller holds the mutex.
+func (c *Cache) evict(keep string) {
+    for c.size > c.maxSize {
+        e := c.lru.Back()
+        if e == nil || e.Value.(*cacheEntry).key == keep {
+            return
+        }
+
+        c.remove(e)
+        c.stats.Evictions++
+    }
+}
+
+// remove removes the content of e, the caller holds the mutex.
+func (c *Cache) remove(e *list.Element) {
+    ce := c.lru.Remove(e).(*cacheEntry)
+    delete(c.entries, ce.key)
+    c.size -= ce.size
+    os.Remove(filepath.Join(c.dir, ce.key))
+}
@@ -0,0 +1,149 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "context"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func readAll(t *testing.T, s Storage, key string) string {
+    rc, err := s.Open(context.Background(), key)
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer rc.Close()
+
+    bs, err := ioutil.ReadAll(rc)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    return string(bs)
+}
+
+func TestCache(t *testing.T) {
+    dir, err := ioutil.TempDir("", "cache")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    backend, err := NewLocal(filepath.Join(dir, "store"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    // two contents fit
+    c, err := NewCache(backend, filepath.Join(dir, "cache"), 20)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    keys := make([]string, 3)
+    for i, content := range []string{"content 1", "content 2", "content 3"} {
+        if keys[i], err = c.Save([]byte(content)); err != nil {
+            t.Fatal(err)
+        }
+    }
+
+    readAll(t, c, keys[0])
+    readAll(t, c, keys[1])
+    readAll(t, c, keys[0])
+    if s := c.Stats(); s.Hits != 1 || s.Misses != 2 || s.Entries != 2 || s.Size != 18 {
+        t.Fatal("wrong stats", s)
+    }
+
+    // keys[1] is the least recently used
+    readAll(t, c, keys[2])
+    if s := c.Stats(); s.Evictions != 1 || s.Entries != 2 {
+        t.Fatal("wrong stats after eviction", s)
+    }
+    if _, err = os.Stat(filepath.Join(dir, "cache", keys[1])); !os.IsNotExist(err) {
+        t.Error("evicted content is kept", err)
+    }
+
+    // verified contents aren't hashed again while their files are unchanged
+    name := filepath.Join(dir, "cache", keys[0])
+    fi, err := os.Stat(name)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err = ioutil.WriteFile(name, []byte("unhashed!"), 0600); err != nil {
+        t.Fatal(err)
+    }
+    os.Chtimes(name, fi.ModTime(), fi.ModTime())
+    if got := readAll(t, c, keys[0]); got != "unhashed!" {
+        t.Error("verified content is hashed again", got)
+    }
+
+    // corrupted contents are fetched again
+    if err = ioutil.WriteFile(filepath.Join(dir, "cache", keys[0]), []byte("corrupted"), 0600); err != nil {
+        t.Fatal(err)
+    }
+    if got := readAll(t, c, keys[0]); got != "content 1" {
+        t.Error("read corrupted content", got)
+    }
+    if s := c.Stats(); s.Corruptions != 1 || s.Misses != 4 {
+        t.Fatal("wrong stats after corruption", s)
+    }
+
+    // the backend no longer has contents which are cached
+    os.Remove(filepath.Join(dir, "store", keys[2]))
+    if got := readAll(t, c, keys[2]); got != "content 3" {
+        t.Error("wrong cached content", got)
+    }
+
+    c, err = NewCache(backend, filepath.Join(dir, "cache"), 20)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if s := c.Stats(); s.Entries != 2 || s.Size != 18 {
+        t.Fatal("cache isn't reloaded", s)
+    }
+    if got := readAll(t, c, keys[2]); got != "content 3" || c.Stats().Hits != 1 {
+        t.Error("reloaded content isn't hit", got)
+    }
+}
+
+func TestCachePassThrough(t *testing.T) {
+    dir, err := ioutil.TempDir("", "cache")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    // a tree added with '--trickle'
+    key := "QmPwHr8mTdqvQK6sdJXjRVzZL5x1aDumxbMT6kcegjgfxB"
+    content := layoutContent()
+    c, err := NewCache(blocks{key: content}, dir, int64(len(content)))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    for i := 0; i < 2; i++ {
+        if got := readAll(t, c, key); got != string(content) {
+            t.Fatal("wrong content passed through")
+        }
+    }
+    if s := c.Stats(); s.PassThroughs != 2 || s.Misses != 2 || s.Entries != 0 || s.Size != 0 {
+        t.Error("wrong stats", s)
+    }
+    if fis, err := ioutil.ReadDir(dir); err != nil || len(fis) != 0 {
+        t.Error("passed through content is kept", len(fis), err)
+    }
+
+    // contents which can be verified are still checked
+    raw := rawCid(content)
+    c, err = NewCache(blocks{raw.String(): bytes.Repeat([]byte("x"), 10)}, dir, int64(len(content)))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if _, err = c.Open(context.Background(), raw.String()); err != ErrContentMismatch {
+        t.Error("forged content is read", err)
+    }
+}
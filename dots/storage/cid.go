@@ -0,0 +1,219 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "encoding/base32"
+    "encoding/binary"
+    "github.com/btcsuite/btcutil/base58"
+    "github.com/pkg/errors"
+    "strings"
+)
+
+// Multicodec codes of content IDs.
+const (
+    CodecDagPb = 0x70
+    CodecRaw   = 0x55
+
+    HashSha2_256    = 0x12
+    HashBlake2b_256 = 0xb220
+)
+
+var (
+    ErrInvalidCid           = errors.New("invalid content ID")
+    ErrUnsupportedMultibase = errors.New("unsupported multibase of content ID, only base32 and base58btc are supported")
+
+    cidBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+// Cid is a CIDv0, a base58 dag-pb sha2-256 multihash, or a CIDv1 with its codec and any multihash.
+type Cid struct {
+    Version   uint64
+    Codec     uint64
+    Multihash []byte
+}
+
+// ParseCid parses a CIDv0, or a CIDv1 in base32 ('b' or upper case 'B') or base58btc ('z') multibase.
+func ParseCid(s string) (Cid, error) {
+    if len(s) == 46 && strings.HasPrefix(s, "Qm") {
+        mh := base58.Decode(s)
+        if len(mh) != 34 || mh[0] != HashSha2_256 || mh[1] != 32 {
+            return Cid{}, ErrInvalidCid
+        }
+
+        return Cid{Version: 0, Codec: CodecDagPb, Multihash: mh}, nil
+    }
+
+    if len(s) < 2 {
+        return Cid{}, ErrInvalidCid
+    }
+
+    var bs []byte
+    var err error
+    switch s[0] {
+    case 'b':
+        if strings.ToLower(s[1:]) != s[1:] {
+            return Cid{}, ErrInvalidCid
+        }
+        bs, err = cidBase32.DecodeString(strings.ToUpper(s[1:]))
+    case 'B':
+        bs, err = cidBase32.DecodeString(s[1:])
+    case 'z':
+        bs = base58.Decode(s[1:])
+    default:
+        return Cid{}, errors.Wrap(ErrUnsupportedMultibase, string(s[0]))
+    }
+    if err != nil || len(bs) == 0 {
+        return Cid{}, ErrInvalidCid
+    }
+
+    return CidFromBytes(bs)
+}
+
+// CidFromBytes decodes the binary form of a CIDv1.
+func CidFromBytes(bs []byte) (Cid, error) {
+    version, n := binary.Uvarint(bs)
+    if n <= 0 || version != 1 {
+        return Cid{}, ErrInvalidCid
+    }
+    codec, m := binary.Uvarint(bs[n:])
+    if m <= 0 {
+        return Cid{}, ErrInvalidCid
+    }
+
+    c := Cid{Version: 1, Codec: codec, Multihash: bs[n+m:]}
+    if _, _, err := c.Hash(); err != nil {
+        return Cid{}, err
+    }
+
+    return c, nil
+}
+
+// Hash returns the code and the digest of the multihash.
+func (c Cid) Hash() (uint64, []byte, error) {
+    code, n := binary.Uvarint(c.Multihash)
+    if n <= 0 {
+        return 0, nil, ErrInvalidCid
+    }
+    length, m := binary.Uvarint(c.Multihash[n:])
+    if m <= 0 || uint64(len(c.Multihash)-n-m) != length {
+        return 0, nil, ErrInvalidCid
+    }
+
+    return code, c.Multihash[n+m:], nil
+}
+
+// Bytes returns the binary form, which is the multihash for a CIDv0.
+func (c Cid) Bytes() []byte {
+    if c.Version == 0 {
+        return c.Multihash
+    }
+
+    bs := appendVarint(nil, c.Version)
+    bs = appendVarint(bs, c.Codec)
+    return append(bs, c.Multihash...)
+}
+
+// String returns the CIDv0 in base58 or the CIDv1 in base32, as IPFS does.
+func (c Cid) String() string {
+    if c.Version == 0 {
+        return base58.Encode(c.Multihash)
+    }
+
+    return "b" + strings.ToLower(cidBase32.EncodeToString(c.Bytes()))
+}
+
+// V0 returns the CIDv0 of a dag-pb sha2-256 ID, false for other IDs.
+func (c Cid) V0() (Cid, bool) {
+    code, digest, err := c.Hash()
+    if err != nil || c.Codec != CodecDagPb || code != HashSha2_256 || len(digest) != 32 {
+        return Cid{}, false
+    }
+
+    return Cid{Version: 0, Codec: CodecDagPb, Multihash: c.Multihash}, true
+}
+
+// SameContent reports if both IDs have the same multihash, so they name the same block,
+// such as a CIDv0 and the CIDv1 of it.
+func (c Cid) SameContent(o Cid) bool {
+    return c.Codec == o.Codec && bytes.Equal(c.Multihash, o.Multihash)
+}
+
+// Content IDs are recorded on chain as bytes32 values. A CIDv0 is its sha2-256 digest, as before,
+// other IDs are a header slot
+//   magic(8) length(1) 0...
+// followed by the binary form of the CID in ceil(length/32) slots, zero padded.
+// So the number of slots is not the number of IDs, the contract's numberOfProof counts slots,
+// consumers decode the slots with Bytes32ToCids before counting the IDs.
+var cidSlotMagic = []byte{0, 's', 'c', 'r', 'y', 'c', 'i', 'd'}
+
+// CidsToBytes32 encodes content IDs to record them on chain.
+func CidsToBytes32(ids []string) ([][32]byte, error) {
+    var out [][32]byte
+    for _, id := range ids {
+        c, err := ParseCid(id)
+        if err != nil {
+            return nil, errors.Wrap(err, "invalid ipfs hash "+id)
+        }
+
+        if c.Version == 0 {
+            var slot [32]byte
+            copy(slot[:], c.Multihash[2:])
+            out = append(out, slot)
+            continue
+        }
+
+        bs := c.Bytes()
+        if len(bs) > 0xff {
+            return nil, errors.New("content ID " + id + " is too long")
+        }
+
+        var header [32]byte
+        copy(header[:], cidSlotMagic)
+        header[len(cidSlotMagic)] = byte(len(bs))
+        out = append(out, header)
+
+        for len(bs) > 0 {
+            var slot [32]byte
+            n := copy(slot[:], bs)
+            bs = bs[n:]
+            out = append(out, slot)
+        }
+    }
+
+    return out, nil
+}
+
+// Bytes32ToCids decodes content IDs recorded on chain, lists of CIDv0 only are recorded before
+// other IDs are supported, they are decoded as they were.
+func Bytes32ToCids(slots [][32]byte) ([]string, error) {
+    var ids []string
+    for i := 0; i < len(slots); i++ {
+        if !bytes.HasPrefix(slots[i][:], cidSlotMagic) {
+            ids = append(ids, base58.Encode(append([]byte{HashSha2_256, 32}, slots[i][:]...)))
+            continue
+        }
+
+        length := int(slots[i][len(cidSlotMagic)])
+        n := (length + 31) / 32
+        if length == 0 || i+n >= len(slots) {
+            return nil, ErrInvalidCid
+        }
+
+        var bs []byte
+        for _, slot := range slots[i+1 : i+1+n] {
+            bs = append(bs, slot[:]...)
+        }
+        c, err := CidFromBytes(bs[:length])
+        if err != nil {
+            return nil, err
+        }
+
+        ids = append(ids, c.String())
+        i += n
+    }
+
+    return ids, nil
+}
@@ -0,0 +1,117 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "context"
+    "crypto/sha256"
+    "github.com/btcsuite/btcutil/base58"
+    "github.com/pkg/errors"
+    "golang.org/x/crypto/blake2b"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func TestCid(t *testing.T) {
+    v0, err := ParseCid("QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o")
+    if err != nil {
+        t.Fatal(err)
+    }
+    if v0.String() != "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o" {
+        t.Error("CIDv0 changed", v0)
+    }
+
+    v1 := Cid{Version: 1, Codec: CodecDagPb, Multihash: v0.Multihash}
+    if !strings.HasPrefix(v1.String(), "bafybei") {
+        t.Error("wrong CIDv1", v1)
+    }
+    for _, s := range []string{v1.String(), "B" + strings.ToUpper(v1.String()[1:]), "z" + base58.Encode(v1.Bytes())} {
+        parsed, err := ParseCid(s)
+        if err != nil {
+            t.Fatal(err)
+        }
+        if back, ok := parsed.V0(); !ok || !parsed.SameContent(v0) || back.String() != v0.String() {
+            t.Errorf("%s isn't the CIDv1 of %s", s, v0)
+        }
+    }
+
+    for _, s := range []string{"", "Qm", "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5", "bafy", "bAFY" + v1.String()[4:]} {
+        if _, err = ParseCid(s); err == nil {
+            t.Errorf("parsed %q", s)
+        }
+    }
+    for _, s := range []string{"x" + v1.String()[1:], "Z" + base58.Encode(v1.Bytes()), "f0170"} {
+        if _, err = ParseCid(s); errors.Cause(err) != ErrUnsupportedMultibase {
+            t.Errorf("parsed %q in an unsupported multibase, error %v", s, err)
+        }
+    }
+}
+
+func TestCidsToBytes32(t *testing.T) {
+    legacy := []string{"QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o", "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"}
+
+    slots, err := CidsToBytes32(legacy)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if len(slots) != 2 || base58.Encode(append([]byte{0x12, 0x20}, slots[0][:]...)) != legacy[0] {
+        t.Fatal("CIDv0 isn't recorded as before")
+    }
+
+    sum := blake2b.Sum256([]byte("proof"))
+    raw := Cid{Version: 1, Codec: CodecRaw, Multihash: append([]byte{0xa0, 0xe4, 0x02, 32}, sum[:]...)}
+    if _, err = ParseCid(raw.String()); err != nil {
+        t.Fatal(err)
+    }
+
+    ids := []string{legacy[0], raw.String(), legacy[1]}
+    if slots, err = CidsToBytes32(ids); err != nil {
+        t.Fatal(err)
+    }
+    got, err := Bytes32ToCids(slots)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if strings.Join(got, ",") != strings.Join(ids, ",") {
+        t.Errorf("decoded %v, want %v", got, ids)
+    }
+
+    if _, err = Bytes32ToCids(slots[:2]); err != ErrInvalidCid {
+        t.Error("decoded a truncated ID", err)
+    }
+}
+
+func TestGetVerifiedCidV1(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := NewLocal(filepath.Join(dir, "store"))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    key, err := s.Save([]byte("proof"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    v0, _ := ParseCid(key)
+    v1 := Cid{Version: 1, Codec: CodecDagPb, Multihash: v0.Multihash}.String()
+
+    v, err := GetVerified(context.Background(), s, v1, filepath.Join(dir, "proof"))
+    if err != nil || !v.Verified || v.ComputedId != v1 {
+        t.Errorf("verification %+v, error %v", v, err)
+    }
+
+    sum := sha256.Sum256([]byte("proof"))
+    raw := Cid{Version: 1, Codec: CodecRaw, Multihash: append([]byte{0x12, 32}, sum[:]...)}.String()
+    if _, err = GetVerified(context.Background(), s, raw, filepath.Join(dir, "raw")); err != ErrNotFound {
+        t.Error("got a raw block from local storage", err)
+    }
+}
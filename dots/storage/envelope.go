@@ -0,0 +1,312 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bufio"
+    "bytes"
+    "crypto/aes"
+    "crypto/cipher"
+    "crypto/rand"
+    "encoding/binary"
+    "github.com/pkg/errors"
+    "io"
+)
+
+// An envelope is the header followed by chunks of the content sealed with AES-256-GCM
+// under a random data key, the data key is wrapped for the recipient, such as with ECIES:
+//   magic(4) version(1) chunkSize(4) noncePrefix(7) keyLen(2) wrappedKey(keyLen)
+// the nonce of chunk i is noncePrefix ‖ i(4) ‖ last(1), the header is the additional
+// data of every chunk, so chunks can't be reordered, truncated or moved to another envelope.
+const (
+    EnvelopeVersion   = 1
+    EnvelopeChunkSize = 64 * 1024
+
+    dataKeySize     = 32
+    noncePrefixSize = 7
+    maxChunkSize    = 16 * 1024 * 1024
+)
+
+var (
+    envelopeMagic = []byte("SENV")
+
+    ErrNotEnvelope     = errors.New("content is not an envelope")
+    ErrEnvelopeVersion = errors.New("unsupported envelope version")
+    ErrEnvelopeCorrupt = errors.New("envelope is corrupt or truncated")
+)
+
+// WrapKey wraps the data key of an envelope for its recipient.
+type WrapKey func(dataKey []byte) ([]byte, error)
+
+// UnwrapKey returns the data key of an envelope from the wrapped one.
+type UnwrapKey func(wrappedKey []byte) ([]byte, error)
+
+// IsEnvelope reports if head, the first bytes of a content, starts an envelope.
+func IsEnvelope(head []byte) bool {
+    return bytes.HasPrefix(head, envelopeMagic)
+}
+
+type encryptReader struct {
+    src    *bufio.Reader
+    aead   cipher.AEAD
+    header []byte
+    prefix []byte
+    index  uint32
+    plain  []byte
+    out    bytes.Buffer
+    done   bool
+}
+
+// NewEncryptReader returns a reader of the envelope of src, the content is read
+// and sealed chunk by chunk, so it can be of any size.
+func NewEncryptReader(src io.Reader, wrap WrapKey) (io.Reader, error) {
+    dataKey := make([]byte, dataKeySize)
+    prefix := make([]byte, noncePrefixSize)
+    if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+        return nil, errors.Wrap(err, "failed to generate data key")
+    }
+    if _, err := io.ReadFull(rand.Reader, prefix); err != nil {
+        return nil, errors.Wrap(err, "failed to generate nonce")
+    }
+
+    wrapped, err := wrap(dataKey)
+    if err != nil {
+        return nil, errors.WithMessage(err, "failed to wrap data key")
+    }
+    if len(wrapped) > 0xffff {
+        return nil, errors.New("wrapped data key is too long")
+    }
+
+    aead, err := newAead(dataKey)
+    zero(dataKey)
+    if err != nil {
+        return nil, err
+    }
+
+    header := make([]byte, 0, 18+len(wrapped))
+    header = append(header, envelopeMagic...)
+    header = append(header, EnvelopeVersion)
+    header = appendUint32(header, EnvelopeChunkSize)
+    header = append(header, prefix...)
+    header = append(header, byte(len(wrapped)>>8), byte(len(wrapped)))
+    header = append(header, wrapped...)
+
+    r := &encryptReader{
+        src:    bufio.NewReaderSize(src, EnvelopeChunkSize+1),
+        aead:   aead,
+        header: header,
+        prefix: prefix,
+        plain:  make([]byte, EnvelopeChunkSize),
+    }
+    r.out.Write(header)
+
+    return r, nil
+}
+
+func (r *encryptReader) Read(p []byte) (int, error) {
+    for r.out.Len() == 0 {
+        if r.done {
+            return 0, io.EOF
+        }
+        if err := r.sealChunk(); err != nil {
+            return 0, err
+        }
+    }
+
+    return r.out.Read(p)
+}
+
+func (r *encryptReader) sealChunk() error {
+    n, err := io.ReadFull(r.src, r.plain)
+    if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+        return err
+    }
+
+    // the chunk is the last one if nothing follows it
+    last := n < len(r.plain)
+    if !last {
+        if _, err = r.src.Peek(1); err == io.EOF {
+            last = true
+        } else if err != nil {
+            return err
+        }
+    }
+
+    r.out.Write(r.aead.Seal(nil, nonce(r.prefix, r.index, last), r.plain[:n], r.header))
+    r.index++
+    r.done = last
+
+    return nil
+}
+
+type decryptReader struct {
+    src    *bufio.Reader
+    aead   cipher.AEAD
+    header []byte
+    prefix []byte
+    index  uint32
+    sealed []byte
+    out    []byte
+    done   bool
+}
+
+// NewDecryptReader reads the header of the envelope in src and returns a reader of
+// its content, which fails with ErrEnvelopeCorrupt if any chunk is changed or missing.
+func NewDecryptReader(src io.Reader, unwrap UnwrapKey) (io.Reader, error) {
+    br := bufio.NewReader(src)
+
+    header, wrapped, err := readHeader(br)
+    if err != nil {
+        return nil, err
+    }
+    chunkSize := binary.BigEndian.Uint32(header[5:9])
+    prefix := header[9:16]
+
+    dataKey, err := unwrap(wrapped)
+    if err != nil {
+        return nil, errors.WithMessage(err, "failed to unwrap data key")
+    }
+    aead, err := newAead(dataKey)
+    zero(dataKey)
+    if err != nil {
+        return nil, err
+    }
+
+    return &decryptReader{
+        src:    bufio.NewReaderSize(br, int(chunkSize)+aead.Overhead()+1),
+        aead:   aead,
+        header: header,
+        prefix: prefix,
+        sealed: make([]byte, int(chunkSize)+aead.Overhead()),
+    }, nil
+}
+
+// WrappedKey returns the wrapped data key in the header of the envelope in src,
+// so the data key can be wrapped again for others without reading the content.
+func WrappedKey(src io.Reader) ([]byte, error) {
+    _, wrapped, err := readHeader(src)
+    return wrapped, err
+}
+
+//the header returned includes the wrapped key, which follows its fixed part
+func readHeader(src io.Reader) ([]byte, []byte, error) {
+    header := make([]byte, 18)
+    if _, err := io.ReadFull(src, header); err != nil {
+        return nil, nil, ErrNotEnvelope
+    }
+    if !IsEnvelope(header) {
+        return nil, nil, ErrNotEnvelope
+    }
+    if header[4] != EnvelopeVersion {
+        return nil, nil, ErrEnvelopeVersion
+    }
+
+    chunkSize := binary.BigEndian.Uint32(header[5:9])
+    if chunkSize == 0 || chunkSize > maxChunkSize {
+        return nil, nil, ErrEnvelopeCorrupt
+    }
+    keyLen := int(binary.BigEndian.Uint16(header[16:18]))
+
+    wrapped := make([]byte, keyLen)
+    if _, err := io.ReadFull(src, wrapped); err != nil {
+        return nil, nil, ErrEnvelopeCorrupt
+    }
+
+    return append(header, wrapped...), wrapped, nil
+}
+
+func (r *decryptReader) Read(p []byte) (int, error) {
+    for len(r.out) == 0 {
+        if r.done {
+            return 0, io.EOF
+        }
+        if err := r.openChunk(); err != nil {
+            return 0, err
+        }
+    }
+
+    n := copy(p, r.out)
+    r.out = r.out[n:]
+
+    return n, nil
+}
+
+func (r *decryptReader) openChunk() error {
+    n, err := io.ReadFull(r.src, r.sealed)
+    if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+        return err
+    }
+
+    last := n < len(r.sealed)
+    if !last {
+        if _, err = r.src.Peek(1); err == io.EOF {
+            last = true
+        } else if err != nil {
+            return err
+        }
+    }
+
+    plain, err := r.aead.Open(r.sealed[:0], nonce(r.prefix, r.index, last), r.sealed[:n], r.header)
+    if err != nil {
+        return ErrEnvelopeCorrupt
+    }
+
+    r.out = plain
+    r.index++
+    r.done = last
+
+    return nil
+}
+
+// Encrypt writes the envelope of src to dst.
+func Encrypt(dst io.Writer, src io.Reader, wrap WrapKey) error {
+    r, err := NewEncryptReader(src, wrap)
+    if err != nil {
+        return err
+    }
+
+    _, err = io.Copy(dst, r)
+    return err
+}
+
+// Decrypt writes the content of the envelope in src to dst.
+func Decrypt(dst io.Writer, src io.Reader, unwrap UnwrapKey) error {
+    r, err := NewDecryptReader(src, unwrap)
+    if err != nil {
+        return err
+    }
+
+    _, err = io.Copy(dst, r)
+    return err
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+    block, err := aes.NewCipher(key)
+    if err != nil {
+        return nil, errors.Wrap(err, "invalid data key")
+    }
+
+    return cipher.NewGCM(block)
+}
+
+func nonce(prefix []byte, index uint32, last bool) []byte {
+    n := make([]byte, 0, 12)
+    n = append(n, prefix...)
+    n = appendUint32(n, index)
+    if last {
+        return append(n, 1)
+    }
+
+    return append(n, 0)
+}
+
+func appendUint32(b []byte, v uint32) []byte {
+    return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func zero(b []byte) {
+    for i := range b {
+        b[i] = 0
+    }
+}
@@ -0,0 +1,161 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "crypto/rand"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/ethereum/go-ethereum/crypto/ecies"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func eciesKeys(t *testing.T) (WrapKey, UnwrapKey) {
+    key, err := crypto.GenerateKey()
+    if err != nil {
+        t.Fatal(err)
+    }
+    priv := ecies.ImportECDSA(key)
+
+    wrap := func(dataKey []byte) ([]byte, error) {
+        return ecies.Encrypt(rand.Reader, &priv.PublicKey, dataKey, nil, nil)
+    }
+    unwrap := func(wrappedKey []byte) ([]byte, error) {
+        return priv.Decrypt(wrappedKey, nil, nil)
+    }
+
+    return wrap, unwrap
+}
+
+func TestEnvelope(t *testing.T) {
+    wrap, unwrap := eciesKeys(t)
+
+    for _, size := range []int{0, 1, EnvelopeChunkSize - 1, EnvelopeChunkSize, EnvelopeChunkSize + 1, 3*EnvelopeChunkSize + 7} {
+        plain := make([]byte, size)
+        rand.Read(plain)
+
+        var sealed bytes.Buffer
+        if err := Encrypt(&sealed, bytes.NewReader(plain), wrap); err != nil {
+            t.Fatal(err)
+        }
+        if !IsEnvelope(sealed.Bytes()) {
+            t.Fatalf("size %d: no envelope header", size)
+        }
+
+        var opened bytes.Buffer
+        if err := Decrypt(&opened, bytes.NewReader(sealed.Bytes()), unwrap); err != nil {
+            t.Fatalf("size %d: %v", size, err)
+        }
+        if !bytes.Equal(opened.Bytes(), plain) {
+            t.Errorf("size %d: content changed", size)
+        }
+    }
+}
+
+func TestEnvelopeTampered(t *testing.T) {
+    wrap, unwrap := eciesKeys(t)
+
+    plain := make([]byte, 2*EnvelopeChunkSize+100)
+    var sealed bytes.Buffer
+    if err := Encrypt(&sealed, bytes.NewReader(plain), wrap); err != nil {
+        t.Fatal(err)
+    }
+    bs := sealed.Bytes()
+    sealedChunk := EnvelopeChunkSize + 16
+
+    flipped := append([]byte(nil), bs...)
+    flipped[len(flipped)-1] ^= 1
+    truncated := bs[:len(bs)-(100+16)]
+    swapped := append([]byte(nil), bs...)
+    start := len(bs) - (100 + 16) - 2*sealedChunk
+    copy(swapped[start:], bs[start+sealedChunk:start+2*sealedChunk])
+    copy(swapped[start+sealedChunk:], bs[start:start+sealedChunk])
+
+    for name, bad := range map[string][]byte{"flipped": flipped, "truncated": truncated, "swapped": swapped} {
+        r, err := NewDecryptReader(bytes.NewReader(bad), unwrap)
+        if err != nil {
+            t.Fatal(err)
+        }
+        if _, err = io.Copy(new(bytes.Buffer), r); err != ErrEnvelopeCorrupt {
+            t.Errorf("%s: %v", name, err)
+        }
+    }
+
+    _, otherUnwrap := eciesKeys(t)
+    if err := Decrypt(new(bytes.Buffer), bytes.NewReader(bs), otherUnwrap); err == nil {
+        t.Error("decrypted with another key")
+    }
+    if err := Decrypt(new(bytes.Buffer), bytes.NewReader(plain), unwrap); err != ErrNotEnvelope {
+        t.Error("decrypted plain content", err)
+    }
+}
+
+func TestEnvelopeWrappedKey(t *testing.T) {
+    wrap, unwrap := eciesKeys(t)
+    rewrap, reunwrap := eciesKeys(t)
+
+    var sealed bytes.Buffer
+    if err := Encrypt(&sealed, bytes.NewReader([]byte("meta data")), wrap); err != nil {
+        t.Fatal(err)
+    }
+
+    wrapped, err := WrappedKey(bytes.NewReader(sealed.Bytes()))
+    if err != nil {
+        t.Fatal(err)
+    }
+    dataKey, err := unwrap(wrapped)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    // the data key wrapped again for another recipient opens the same envelope
+    rewrapped, err := rewrap(dataKey)
+    if err != nil {
+        t.Fatal(err)
+    }
+    var opened bytes.Buffer
+    err = Decrypt(&opened, bytes.NewReader(sealed.Bytes()), func([]byte) ([]byte, error) { return reunwrap(rewrapped) })
+    if err != nil || opened.String() != "meta data" {
+        t.Error("failed to open envelope with the key wrapped again", opened.String(), err)
+    }
+
+    if _, err = WrappedKey(bytes.NewReader([]byte("meta data"))); err != ErrNotEnvelope {
+        t.Error("found key in content which isn't an envelope", err)
+    }
+}
+
+func TestDecryptFile(t *testing.T) {
+    wrap, unwrap := eciesKeys(t)
+
+    dir, err := ioutil.TempDir("", "envelope")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    var sealed bytes.Buffer
+    if err = Encrypt(&sealed, bytes.NewReader([]byte("meta data")), wrap); err != nil {
+        t.Fatal(err)
+    }
+
+    file := filepath.Join(dir, "sealed")
+    if err = ioutil.WriteFile(file, sealed.Bytes(), 0600); err != nil {
+        t.Fatal(err)
+    }
+    if ok, err := DecryptFile(file, unwrap); !ok || err != nil {
+        t.Fatal("failed to decrypt file", ok, err)
+    }
+    if bs, _ := ioutil.ReadFile(file); string(bs) != "meta data" {
+        t.Errorf("decrypted %q", bs)
+    }
+
+    // the content is plain now, so it is kept as it is
+    if ok, err := DecryptFile(file, unwrap); ok || err != nil {
+        t.Error("decrypted plain file", ok, err)
+    }
+}
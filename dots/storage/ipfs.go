@@ -4,9 +4,16 @@
 package storage
 
 import (
+    "bufio"
+    "bytes"
+    "context"
     "github.com/ipfs/go-ipfs-api"
+    "github.com/ipfs/go-ipfs-files"
     "github.com/pkg/errors"
     "github.com/scryinfo/dot/dot"
+    "io"
+    "os"
+    "path/filepath"
     "strings"
 )
 
@@ -50,17 +57,188 @@ func (c *Ipfs) Initialize(storageSrvAddr string) error {
 }
 
 func (c *Ipfs) Save(value []byte) (string, error) {
+    return c.Put(context.Background(), bytes.NewReader(value))
+}
+
+// Get writes the content of key to the file named key in outDir, a directory is written with all its files.
+func (c *Ipfs) Get(key string, outDir string) error {
+    dir, err := c.isDir(context.Background(), key)
+    if err != nil {
+        return err
+    }
+    if !dir {
+        return getFile(c, key, outDir)
+    }
+
+    return errors.Wrap(c.sh.Get(key, filepath.Join(outDir, key)), "failed to get directory from ipfs")
+}
+
+// Put adds the content of src, which is streamed to the node.
+func (c *Ipfs) Put(ctx context.Context, src io.Reader, opts ...Option) (string, error) {
     if c.sh == nil {
         return "", errors.New("Ipfs api shell is nil")
     }
 
-    return c.sh.Add(strings.NewReader(string(value)))
+    slf := files.NewSliceDirectory([]files.DirEntry{files.FileEntry("", files.NewReaderFile(newStreamReader(ctx, src, opts)))})
+
+    var out struct {
+        Hash string
+    }
+    if err := c.sh.Request("add").Body(files.NewMultiFileReader(slf, true)).Exec(ctx, &out); err != nil {
+        return "", errors.Wrap(err, "failed to add to ipfs")
+    }
+
+    return out.Hash, nil
 }
 
-func (c *Ipfs) Get(key string, outDir string) error {
+// Open returns a reader of the content of key, which must be closed.
+func (c *Ipfs) Open(ctx context.Context, key string, opts ...Option) (io.ReadCloser, error) {
+    if c.sh == nil {
+        return nil, errors.New("Get from ipfs failed, ipfs api shell is nil. ")
+    }
+
+    resp, err := c.sh.Request("cat", key).Send(ctx)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to read from ipfs")
+    }
+    if resp.Error != nil {
+        //directories can't be read as a stream
+        if dir, er := c.isDir(ctx, key); er == nil && dir {
+            return nil, ErrIsDirectory
+        }
+        return nil, errors.Wrap(resp.Error, "failed to read from ipfs")
+    }
+
+    return newStreamReadCloser(ctx, resp.Output, opts), nil
+}
+
+func (c *Ipfs) isDir(ctx context.Context, key string) (bool, error) {
+    if c.sh == nil {
+        return false, errors.New("Ipfs api shell is nil")
+    }
+
+    var out struct {
+        Type string
+    }
+    if err := c.sh.Request("files/stat", "/ipfs/"+key).Exec(ctx, &out); err != nil {
+        return false, errors.Wrap(err, "failed to stat in ipfs")
+    }
+
+    return out.Type == "directory", nil
+}
+
+// SaveEncrypted stores the envelope of src, its data key is wrapped by wrap.
+func (c *Ipfs) SaveEncrypted(src io.Reader, wrap WrapKey) (string, error) {
+    r, err := NewEncryptReader(src, wrap)
+    if err != nil {
+        return "", err
+    }
+
+    return c.Put(context.Background(), r)
+}
+
+// GetDecrypted writes the content of the envelope stored as key to outFile,
+// outFile is removed if the envelope can't be decrypted.
+func (c *Ipfs) GetDecrypted(key string, outFile string, unwrap UnwrapKey) error {
+    rc, err := c.Open(context.Background(), key)
+    if err != nil {
+        return err
+    }
+    defer rc.Close()
+
+    return decryptToFile(outFile, rc, unwrap)
+}
+
+// Pin pins key recursively, so the node keeps it from garbage collection.
+func (c *Ipfs) Pin(ctx context.Context, key string) error {
     if c.sh == nil {
-        return errors.New("Get from ipfs failed, ipfs api shell is nil. ")
+        return errors.New("Ipfs api shell is nil")
+    }
+
+    return errors.Wrap(c.sh.Request("pin/add", key).Option("recursive", true).Exec(ctx, nil), "failed to pin in ipfs")
+}
+
+func (c *Ipfs) Unpin(ctx context.Context, key string) error {
+    if c.sh == nil {
+        return errors.New("Ipfs api shell is nil")
+    }
+
+    err := c.sh.Request("pin/rm", key).Option("recursive", true).Exec(ctx, nil)
+    if err != nil && strings.Contains(err.Error(), "not pinned") {
+        return nil
+    }
+
+    return errors.Wrap(err, "failed to unpin in ipfs")
+}
+
+func (c *Ipfs) Pinned(ctx context.Context, key string) (bool, error) {
+    if c.sh == nil {
+        return false, errors.New("Ipfs api shell is nil")
+    }
+
+    var out struct {
+        Keys map[string]struct {
+            Type string
+        }
+    }
+    err := c.sh.Request("pin/ls", key).Option("type", "recursive").Exec(ctx, &out)
+    if err != nil && strings.Contains(err.Error(), "not pinned") {
+        return false, nil
+    } else if err != nil {
+        return false, errors.Wrap(err, "failed to list pins in ipfs")
+    }
+
+    return len(out.Keys) > 0, nil
+}
+
+// Ping asks the node for its identity.
+func (c *Ipfs) Ping(ctx context.Context) error {
+    if c.sh == nil {
+        return errors.New("Ipfs api shell is nil")
+    }
+
+    return errors.Wrap(c.sh.Request("id").Exec(ctx, nil), "failed to reach ipfs")
+}
+
+func decryptToFile(outFile string, src io.Reader, unwrap UnwrapKey) error {
+    f, err := os.Create(outFile)
+    if err != nil {
+        return errors.Wrap(err, "failed to create output file")
+    }
+
+    err = Decrypt(f, src, unwrap)
+    if er := f.Close(); err == nil {
+        err = er
+    }
+    if err != nil {
+        os.Remove(outFile)
+        return err
+    }
+
+    return nil
+}
+
+// DecryptFile replaces the envelope in file with its content, it reports if file is an envelope.
+func DecryptFile(file string, unwrap UnwrapKey) (bool, error) {
+    f, err := os.Open(file)
+    if err != nil {
+        return false, errors.Wrap(err, "failed to open file")
+    }
+    defer f.Close()
+
+    br := bufio.NewReader(f)
+    if head, _ := br.Peek(len(envelopeMagic)); !IsEnvelope(head) {
+        return false, nil
+    }
+
+    tmp := file + ".decrypting"
+    if err = decryptToFile(tmp, br, unwrap); err != nil {
+        return true, err
+    }
+    if err = os.Rename(tmp, file); err != nil {
+        os.Remove(tmp)
+        return true, errors.Wrap(err, "failed to replace envelope")
     }
 
-    return c.sh.Get(key, outDir)
+    return true, nil
 }
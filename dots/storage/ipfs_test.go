@@ -0,0 +1,73 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "archive/tar"
+    "context"
+    "encoding/json"
+    "io/ioutil"
+    "net/http"
+    "net/http/httptest"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+// testIpfsNode serves the api of an ipfs node which has a directory with a file only.
+func testIpfsNode(t *testing.T, dir string) *httptest.Server {
+    return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        arg := r.URL.Query().Get("arg")
+        if strings.TrimPrefix(arg, "/ipfs/") != dir {
+            t.Error("unexpected key", arg)
+        }
+
+        switch r.URL.Path {
+        case "/api/v0/cat":
+            w.WriteHeader(http.StatusInternalServerError)
+            json.NewEncoder(w).Encode(map[string]interface{}{"Message": "this dag node is a directory", "Code": 0})
+        case "/api/v0/files/stat":
+            json.NewEncoder(w).Encode(map[string]interface{}{"Hash": dir, "Type": "directory"})
+        case "/api/v0/get":
+            tw := tar.NewWriter(w)
+            tw.WriteHeader(&tar.Header{Name: dir, Typeflag: tar.TypeDir, Mode: 0755})
+            tw.WriteHeader(&tar.Header{Name: dir + "/data.txt", Typeflag: tar.TypeReg, Mode: 0644, Size: 4})
+            tw.Write([]byte("scry"))
+            tw.Close()
+        default:
+            t.Error("unexpected request", r.URL.Path)
+            w.WriteHeader(http.StatusNotFound)
+        }
+    }))
+}
+
+func TestIpfsDirectory(t *testing.T) {
+    const dir = "QmUNLLsPACCz1vLxQVkXqqLX5R1X345qqfHbsf67hvA3Nn"
+
+    node := testIpfsNode(t, dir)
+    defer node.Close()
+
+    c := &Ipfs{}
+    if err := c.Initialize(strings.TrimPrefix(node.URL, "http://")); err != nil {
+        t.Fatal(err)
+    }
+
+    if _, err := c.Open(context.Background(), dir); err != ErrIsDirectory {
+        t.Error("opened a directory", err)
+    }
+
+    outDir, err := ioutil.TempDir("", "ipfs")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(outDir)
+
+    if err = c.Get(dir, outDir); err != nil {
+        t.Fatal(err)
+    }
+    if bs, err := ioutil.ReadFile(filepath.Join(outDir, dir, "data.txt")); err != nil || string(bs) != "scry" {
+        t.Error("wrong file of directory", string(bs), err)
+    }
+}
@@ -0,0 +1,213 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "context"
+    "github.com/pkg/errors"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+)
+
+var ErrNotFound = errors.New("content is not found")
+
+// Local stores contents as files named by their IDs in a directory, so it runs without an IPFS node.
+type Local struct {
+    dir string
+}
+
+func NewLocal(dir string) (*Local, error) {
+    if dir == "" {
+        return nil, errors.New("directory of local storage is empty")
+    }
+    if err := os.MkdirAll(dir, 0700); err != nil {
+        return nil, errors.Wrap(err, "failed to create local storage directory")
+    }
+
+    return &Local{dir: dir}, nil
+}
+
+// Initialize does nothing, local storage has no node.
+func (c *Local) Initialize(nodeAddr string) error {
+    return nil
+}
+
+func (c *Local) Save(value []byte) (string, error) {
+    return c.Put(context.Background(), bytes.NewReader(value))
+}
+
+func (c *Local) Get(key string, outDir string) error {
+    return getFile(c, key, outDir)
+}
+
+// Put stores the content of src, nothing is stored if it fails or ctx is done.
+func (c *Local) Put(ctx context.Context, src io.Reader, opts ...Option) (string, error) {
+    return c.add(newStreamReader(ctx, src, opts))
+}
+
+// Open returns a reader of the content of key, which must be closed.
+func (c *Local) Open(ctx context.Context, key string, opts ...Option) (io.ReadCloser, error) {
+    f, err := c.open(key)
+    if err != nil {
+        return nil, err
+    }
+
+    return newStreamReadCloser(ctx, f, opts), nil
+}
+
+// SaveEncrypted stores the envelope of src, its data key is wrapped by wrap.
+func (c *Local) SaveEncrypted(src io.Reader, wrap WrapKey) (string, error) {
+    r, err := NewEncryptReader(src, wrap)
+    if err != nil {
+        return "", err
+    }
+
+    return c.add(r)
+}
+
+// GetDecrypted writes the content of the envelope stored as key to outFile,
+// outFile is removed if the envelope can't be decrypted.
+func (c *Local) GetDecrypted(key string, outFile string, unwrap UnwrapKey) error {
+    f, err := c.open(key)
+    if err != nil {
+        return err
+    }
+    defer f.Close()
+
+    return decryptToFile(outFile, f, unwrap)
+}
+
+// Pin records key in the pin set of the storage, key must be stored.
+// Local storage has no garbage collection, so pins only keep track of what is pinned as IPFS does.
+func (c *Local) Pin(ctx context.Context, key string) error {
+    f, err := c.open(key)
+    if err != nil {
+        return err
+    }
+    f.Close()
+
+    name, err := c.pinFile(key)
+    if err != nil {
+        return err
+    }
+    if err = os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+        return errors.Wrap(err, "failed to create pins directory")
+    }
+
+    return errors.Wrap(ioutil.WriteFile(name, nil, 0600), "failed to pin content")
+}
+
+// Unpin removes key from the pin set, the content is kept since others may still use it.
+func (c *Local) Unpin(ctx context.Context, key string) error {
+    name, err := c.pinFile(key)
+    if err == ErrNotFound {
+        return nil
+    } else if err != nil {
+        return err
+    }
+
+    if err = os.Remove(name); err != nil && !os.IsNotExist(err) {
+        return errors.Wrap(err, "failed to unpin content")
+    }
+
+    return nil
+}
+
+// Pinned reports if key is in the pin set and still stored.
+func (c *Local) Pinned(ctx context.Context, key string) (bool, error) {
+    name, err := c.pinFile(key)
+    if err == ErrNotFound {
+        return false, nil
+    } else if err != nil {
+        return false, err
+    }
+    if _, err = os.Stat(name); os.IsNotExist(err) {
+        return false, nil
+    } else if err != nil {
+        return false, errors.Wrap(err, "failed to check pin")
+    }
+
+    f, err := c.open(key)
+    if err == ErrNotFound {
+        return false, nil
+    } else if err != nil {
+        return false, err
+    }
+
+    return true, f.Close()
+}
+
+// Ping checks the directory of the storage.
+func (c *Local) Ping(ctx context.Context) error {
+    _, err := os.Stat(c.dir)
+
+    return errors.Wrap(err, "failed to reach local storage")
+}
+
+// add writes src to a temporary file while computing its ID, then renames the file to the ID.
+func (c *Local) add(src io.Reader) (string, error) {
+    f, err := ioutil.TempFile(c.dir, ".add-")
+    if err != nil {
+        return "", errors.Wrap(err, "failed to create file")
+    }
+    defer os.Remove(f.Name())
+
+    key, err := ContentId(io.TeeReader(src, f))
+    if er := f.Close(); err == nil {
+        err = er
+    }
+    if err != nil {
+        return "", err
+    }
+
+    if err = os.Rename(f.Name(), filepath.Join(c.dir, key)); err != nil {
+        return "", errors.Wrap(err, "failed to store content")
+    }
+
+    return key, nil
+}
+
+func (c *Local) open(key string) (*os.File, error) {
+    name, err := c.name(key)
+    if err != nil {
+        return nil, err
+    }
+
+    f, err := os.Open(filepath.Join(c.dir, name))
+    if os.IsNotExist(err) {
+        return nil, ErrNotFound
+    } else if err != nil {
+        return nil, errors.Wrap(err, "failed to open content")
+    }
+
+    return f, nil
+}
+
+// pinFile returns the file which records key is pinned.
+func (c *Local) pinFile(key string) (string, error) {
+    name, err := c.name(key)
+    if err != nil {
+        return "", err
+    }
+
+    return filepath.Join(c.dir, ".pins", name), nil
+}
+
+// name returns the file name of key, ErrNotFound for IDs which can't be stored.
+func (c *Local) name(key string) (string, error) {
+    // contents are stored by their CIDv0, keys are parsed so they can't name other files
+    id, err := ParseCid(key)
+    if err != nil {
+        return "", errors.New("invalid content ID " + key)
+    }
+    v0, ok := id.V0()
+    if !ok {
+        return "", ErrNotFound
+    }
+
+    return v0.String(), nil
+}
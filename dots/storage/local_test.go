@@ -0,0 +1,202 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "context"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "strings"
+    "testing"
+)
+
+func TestContentId(t *testing.T) {
+    // IDs given by 'ipfs add'
+    for content, id := range map[string]string{
+        "":              "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH",
+        "hello world\n": "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o",
+    } {
+        got, err := ContentId(strings.NewReader(content))
+        if err != nil {
+            t.Fatal(err)
+        }
+        if got != id {
+            t.Errorf("ID of %q is %s, want %s", content, got, id)
+        }
+    }
+}
+
+func TestLocal(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    var s Storage
+    if s, err = NewLocal(filepath.Join(dir, "store")); err != nil {
+        t.Fatal(err)
+    }
+
+    // more than one level of the dag
+    content := bytes.Repeat([]byte("0123456789abcdef"), unixfsChunkSize/16*3+5)
+    key, err := s.Save(content)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if id, _ := ContentId(bytes.NewReader(content)); key != id {
+        t.Errorf("saved as %s, ID is %s", key, id)
+    }
+
+    if err = s.Get(key, dir); err != nil {
+        t.Fatal(err)
+    }
+    got, err := ioutil.ReadFile(filepath.Join(dir, key))
+    if err != nil || !bytes.Equal(got, content) {
+        t.Error("content changed", err)
+    }
+
+    wrap, unwrap := eciesKeys(t)
+    ekey, err := s.SaveEncrypted(bytes.NewReader(content), wrap)
+    if err != nil {
+        t.Fatal(err)
+    }
+    outFile := filepath.Join(dir, "decrypted")
+    if err = s.GetDecrypted(ekey, outFile, unwrap); err != nil {
+        t.Fatal(err)
+    }
+    if got, err = ioutil.ReadFile(outFile); err != nil || !bytes.Equal(got, content) {
+        t.Error("decrypted content changed", err)
+    }
+
+    if err = s.Get("QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH", dir); err != ErrNotFound {
+        t.Error("got content never saved", err)
+    }
+    if err = s.Get("../store", dir); err == nil {
+        t.Error("got content of an invalid ID")
+    }
+}
+
+func TestLocalStream(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := NewLocal(dir)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    content := bytes.Repeat([]byte{7}, 2*unixfsChunkSize+1)
+
+    var read int64
+    key, err := s.Put(context.Background(), bytes.NewReader(content), Progress(func(n int64) { read = n }))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if read != int64(len(content)) {
+        t.Errorf("progress is %d of %d", read, len(content))
+    }
+
+    rc, err := s.Open(context.Background(), key)
+    if err != nil {
+        t.Fatal(err)
+    }
+    got, err := ioutil.ReadAll(rc)
+    rc.Close()
+    if err != nil || !bytes.Equal(got, content) {
+        t.Error("content changed", err)
+    }
+
+    if rc, err = s.Open(context.Background(), key, MaxSize(unixfsChunkSize)); err != nil {
+        t.Fatal(err)
+    }
+    _, err = ioutil.ReadAll(rc)
+    rc.Close()
+    if err != ErrTooLarge {
+        t.Error("read more than the size limit", err)
+    }
+
+    if _, err = s.Put(context.Background(), bytes.NewReader(content), MaxSize(unixfsChunkSize)); err != ErrTooLarge {
+        t.Error("stored more than the size limit", err)
+    }
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    if _, err = s.Put(ctx, bytes.NewReader(content)); err != context.Canceled {
+        t.Error("stored after cancel", err)
+    }
+
+    files, err := ioutil.ReadDir(dir)
+    if err != nil || len(files) != 1 {
+        t.Errorf("%d files are left, error %v", len(files), err)
+    }
+}
+
+func TestLocalPing(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := NewLocal(filepath.Join(dir, "store"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if err = s.Ping(context.Background()); err != nil {
+        t.Error(err)
+    }
+
+    os.RemoveAll(dir)
+    if err = s.Ping(context.Background()); err == nil {
+        t.Error("pinged removed storage")
+    }
+}
+
+func TestLocalPins(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := NewLocal(filepath.Join(dir, "store"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    ctx := context.Background()
+
+    key, err := s.Save([]byte("shared"))
+    if err != nil {
+        t.Fatal(err)
+    }
+    if pinned, err := s.Pinned(ctx, key); err != nil || pinned {
+        t.Error("stored content is pinned", err)
+    }
+
+    if err = s.Pin(ctx, key); err != nil {
+        t.Fatal(err)
+    }
+    if pinned, err := s.Pinned(ctx, key); err != nil || !pinned {
+        t.Error("content isn't pinned", err)
+    }
+
+    if err = s.Unpin(ctx, key); err != nil {
+        t.Fatal(err)
+    }
+    if pinned, err := s.Pinned(ctx, key); err != nil || pinned {
+        t.Error("content is still pinned", err)
+    }
+    if err = s.Get(key, dir); err != nil {
+        t.Error("unpin removed the content", err)
+    }
+
+    if err = s.Pin(ctx, "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"); err != ErrNotFound {
+        t.Error("pinned missing content", err)
+    }
+}
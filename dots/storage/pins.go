@@ -0,0 +1,429 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "context"
+    "encoding/json"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "go.uber.org/zap"
+    "io/ioutil"
+    "os"
+    "sort"
+    "sync"
+    "time"
+)
+
+const (
+    PinsTypeId = "6c2bde47-66a9-4af6-a03d-2860541789cc"
+
+    defaultPinRetention     = 30 * 24 * 3600 //seconds
+    defaultPinCheckInterval = 3600           //seconds
+)
+
+// Pinner is implemented by backends which keep contents from garbage collection.
+type Pinner interface {
+    Pin(ctx context.Context, key string) error
+    Unpin(ctx context.Context, key string) error
+    Pinned(ctx context.Context, key string) (bool, error)
+}
+
+// check if 'Ipfs' and 'Local' implement 'Pinner' interface.
+var _ Pinner = (*Ipfs)(nil)
+var _ Pinner = (*Local)(nil)
+
+// Pins keeps the contents of publications pinned, while any transaction of a publication is open
+// and for the retention after the last one closes.
+type Pins struct {
+    config  pinsConfig
+    mutex   sync.Mutex
+    records map[string]*PinRecord //by publish id
+    txs     map[string]string     //publish ids by transaction id
+    stop    chan struct{}
+    Storage Storage `dot:"0d3dd0df-241e-4fc3-a615-c5713f9f8db7"`
+}
+
+type pinsConfig struct {
+    StateFile     string `json:"stateFile"`     //records are kept in memory only if empty
+    Retention     int64  `json:"retention"`     //seconds
+    CheckInterval int64  `json:"checkInterval"` //seconds, negative to collect and check on demand only
+}
+
+// PinRecord is the pinned contents of a publication.
+type PinRecord struct {
+    PublishId string   `json:"publishId"`
+    Cids      []string `json:"cids"`
+    OpenTxs   []string `json:"openTxs"`
+    ClosedAt  int64    `json:"closedAt"` //unix time the last open transaction closed, 0 if any is open
+    Unpinned  bool     `json:"unpinned"`
+}
+
+//construct dot
+func newPinsDot(conf interface{}) (dot.Dot, error) {
+    dConf := &pinsConfig{}
+    if bs, ok := conf.([]byte); ok && len(bs) > 0 {
+        if err := dot.UnMarshalConfig(bs, dConf); err != nil {
+            return nil, err
+        }
+    }
+
+    d := &Pins{config: *dConf}
+    return d, nil
+}
+
+//Data structure needed when generating newer component
+func PinsTypeLive() *dot.TypeLives {
+    return &dot.TypeLives{
+        Meta: dot.Metadata{TypeId: PinsTypeId, NewDoter: func(conf interface{}) (dot.Dot, error) {
+            return newPinsDot(conf)
+        }},
+    }
+}
+
+func (c *Pins) Create(l dot.Line) error {
+    return c.load()
+}
+
+func (c *Pins) Start(ignore bool) error {
+    if c.config.CheckInterval < 0 {
+        return nil
+    }
+
+    interval := c.config.CheckInterval
+    if interval == 0 {
+        interval = defaultPinCheckInterval
+    }
+
+    c.stop = make(chan struct{})
+    go c.check(time.Duration(interval)*time.Second, c.stop)
+
+    return nil
+}
+
+func (c *Pins) Stop(ignore bool) error {
+    if c.stop != nil {
+        close(c.stop)
+        c.stop = nil
+    }
+
+    return nil
+}
+
+// Track pins the contents of a publication, publishing should not go on if it fails.
+func (c *Pins) Track(ctx context.Context, publishId string, cids ...string) error {
+    p, ok := c.Storage.(Pinner)
+    if !ok {
+        return errors.New("storage can't pin contents")
+    }
+
+    var pinned []string
+    for _, id := range cids {
+        if id == "" || contains(pinned, id) {
+            continue
+        }
+        if err := p.Pin(ctx, id); err != nil {
+            return errors.Wrap(err, "failed to pin "+id)
+        }
+        pinned = append(pinned, id)
+    }
+
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    c.records[publishId] = &PinRecord{PublishId: publishId, Cids: pinned}
+
+    return c.save()
+}
+
+// Forget unpins the contents of a publication right away, such as one which failed to publish.
+func (c *Pins) Forget(ctx context.Context, publishId string) error {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    r, ok := c.records[publishId]
+    if !ok {
+        return nil
+    }
+
+    err := c.unpin(ctx, r)
+    delete(c.records, publishId)
+    for _, txId := range r.OpenTxs {
+        delete(c.txs, txId)
+    }
+    if er := c.save(); err == nil {
+        err = er
+    }
+
+    return err
+}
+
+// TransactionCreated keeps the contents of publishId pinned until txId closes,
+// contents of a publication which are already unpinned are pinned again.
+func (c *Pins) TransactionCreated(ctx context.Context, publishId string, txId string) error {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    r, ok := c.records[publishId]
+    if !ok {
+        return nil
+    }
+
+    if r.Unpinned {
+        if p, ok := c.Storage.(Pinner); ok {
+            for _, id := range r.Cids {
+                if err := p.Pin(ctx, id); err != nil {
+                    dot.Logger().Errorln("failed to pin "+id+" again", zap.Error(err))
+                }
+            }
+        }
+        r.Unpinned = false
+    }
+
+    if !contains(r.OpenTxs, txId) {
+        r.OpenTxs = append(r.OpenTxs, txId)
+    }
+    r.ClosedAt = 0
+    c.txs[txId] = publishId
+
+    return c.save()
+}
+
+// TransactionClosed starts the retention of the publication of txId if it's the last open one.
+func (c *Pins) TransactionClosed(txId string, now time.Time) error {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    publishId, ok := c.txs[txId]
+    if !ok {
+        return nil
+    }
+    delete(c.txs, txId)
+
+    r, ok := c.records[publishId]
+    if !ok {
+        return nil
+    }
+
+    for i, id := range r.OpenTxs {
+        if id == txId {
+            r.OpenTxs = append(r.OpenTxs[:i], r.OpenTxs[i+1:]...)
+            break
+        }
+    }
+    if len(r.OpenTxs) == 0 {
+        r.ClosedAt = now.Unix()
+    }
+
+    return c.save()
+}
+
+// Collect unpins the contents of publications whose retention is over at now and returns their ids,
+// contents shared with publications which are still kept stay pinned.
+func (c *Pins) Collect(ctx context.Context, now time.Time) ([]string, error) {
+    retention := c.config.Retention
+    if retention == 0 {
+        retention = defaultPinRetention
+    }
+
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    var expired []string
+    var err error
+    for publishId, r := range c.records {
+        if r.Unpinned || r.ClosedAt == 0 || now.Unix()-r.ClosedAt < retention {
+            continue
+        }
+
+        if er := c.unpin(ctx, r); er != nil {
+            err = er
+            continue
+        }
+        r.Unpinned = true
+        expired = append(expired, publishId)
+    }
+    sort.Strings(expired)
+
+    if len(expired) > 0 {
+        if er := c.save(); err == nil {
+            err = er
+        }
+    }
+
+    return expired, err
+}
+
+// Missing returns the contents which should be pinned but aren't, by publish id.
+func (c *Pins) Missing(ctx context.Context) (map[string][]string, error) {
+    p, ok := c.Storage.(Pinner)
+    if !ok {
+        return nil, errors.New("storage can't pin contents")
+    }
+
+    c.mutex.Lock()
+    records := make([]PinRecord, 0, len(c.records))
+    for _, r := range c.records {
+        if !r.Unpinned {
+            records = append(records, *r)
+        }
+    }
+    c.mutex.Unlock()
+
+    missing := make(map[string][]string)
+    for _, r := range records {
+        for _, id := range r.Cids {
+            pinned, err := p.Pinned(ctx, id)
+            if err != nil {
+                return nil, err
+            }
+            if !pinned {
+                missing[r.PublishId] = append(missing[r.PublishId], id)
+            }
+        }
+    }
+
+    return missing, nil
+}
+
+// Record returns a copy of the record of publishId.
+func (c *Pins) Record(publishId string) (PinRecord, bool) {
+    c.mutex.Lock()
+    defer c.mutex.Unlock()
+
+    r, ok := c.records[publishId]
+    if !ok {
+        return PinRecord{}, false
+    }
+
+    cp := *r
+    cp.Cids = append([]string(nil), r.Cids...)
+    cp.OpenTxs = append([]string(nil), r.OpenTxs...)
+
+    return cp, true
+}
+
+func (c *Pins) check(interval time.Duration, stop chan struct{}) {
+    ticker := time.NewTicker(interval)
+    defer ticker.Stop()
+
+    for {
+        select {
+        case <-stop:
+            return
+        case now := <-ticker.C:
+            ctx, cancel := context.WithTimeout(context.Background(), interval)
+
+            if expired, err := c.Collect(ctx, now); err != nil {
+                dot.Logger().Errorln("failed to unpin expired publications", zap.Error(err))
+            } else if len(expired) > 0 {
+                dot.Logger().Infoln("unpinned expired publications", zap.Strings("publishIds", expired))
+            }
+
+            missing, err := c.Missing(ctx)
+            if err != nil {
+                dot.Logger().Errorln("failed to check pins", zap.Error(err))
+            }
+            for publishId, ids := range missing {
+                dot.Logger().Warnln("contents of publication are not pinned", zap.String("publishId", publishId), zap.Strings("cids", ids))
+            }
+
+            cancel()
+        }
+    }
+}
+
+// unpin unpins the contents of r which no other kept publication has, the caller holds the mutex.
+func (c *Pins) unpin(ctx context.Context, r *PinRecord) error {
+    p, ok := c.Storage.(Pinner)
+    if !ok {
+        return errors.New("storage can't pin contents")
+    }
+
+    for _, id := range r.Cids {
+        if c.shared(r.PublishId, id) {
+            continue
+        }
+        if err := p.Unpin(ctx, id); err != nil {
+            return errors.Wrap(err, "failed to unpin "+id)
+        }
+    }
+
+    return nil
+}
+
+func (c *Pins) shared(publishId string, id string) bool {
+    for _, r := range c.records {
+        if r.PublishId != publishId && !r.Unpinned && contains(r.Cids, id) {
+            return true
+        }
+    }
+
+    return false
+}
+
+func (c *Pins) load() error {
+    c.records = make(map[string]*PinRecord)
+    c.txs = make(map[string]string)
+
+    if c.config.StateFile == "" {
+        return nil
+    }
+
+    bs, err := ioutil.ReadFile(c.config.StateFile)
+    if os.IsNotExist(err) {
+        return nil
+    } else if err != nil {
+        return errors.Wrap(err, "failed to read pin records")
+    }
+
+    var records []*PinRecord
+    if err = json.Unmarshal(bs, &records); err != nil {
+        return errors.Wrap(err, "invalid pin records")
+    }
+
+    for _, r := range records {
+        c.records[r.PublishId] = r
+        for _, txId := range r.OpenTxs {
+            c.txs[txId] = r.PublishId
+        }
+    }
+
+    return nil
+}
+
+// save writes the records to the state file, the caller holds the mutex.
+func (c *Pins) save() error {
+    if c.config.StateFile == "" {
+        return nil
+    }
+
+    records := make([]*PinRecord, 0, len(c.records))
+    for _, r := range c.records {
+        records = append(records, r)
+    }
+    sort.Slice(records, func(i, j int) bool { return records[i].PublishId < records[j].PublishId })
+
+    bs, err := json.MarshalIndent(records, "", "  ")
+    if err != nil {
+        return err
+    }
+
+    tmp := c.config.StateFile + ".tmp"
+    if err = ioutil.WriteFile(tmp, bs, 0600); err != nil {
+        return errors.Wrap(err, "failed to write pin records")
+    }
+
+    return errors.Wrap(os.Rename(tmp, c.config.StateFile), "failed to write pin records")
+}
+
+func contains(ss []string, s string) bool {
+    for _, v := range ss {
+        if v == s {
+            return true
+        }
+    }
+
+    return false
+}
@@ -0,0 +1,95 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "context"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "reflect"
+    "testing"
+    "time"
+)
+
+func newTestPins(t *testing.T, dir string) *Pins {
+    s, err := NewLocal(filepath.Join(dir, "contents"))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    c := &Pins{Storage: s, config: pinsConfig{StateFile: filepath.Join(dir, "pins.json"), Retention: 60}}
+    if err = c.Create(nil); err != nil {
+        t.Fatal(err)
+    }
+
+    return c
+}
+
+func TestPins(t *testing.T) {
+    dir, err := ioutil.TempDir("", "pins")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    ctx := context.Background()
+    c := newTestPins(t, dir)
+
+    meta, _ := c.Storage.Save([]byte("meta data"))
+    proof, _ := c.Storage.Save([]byte("proof"))
+    shared, _ := c.Storage.Save([]byte("details"))
+
+    if err = c.Track(ctx, "p1", meta, proof, shared); err != nil {
+        t.Fatal(err)
+    }
+    if err = c.Track(ctx, "p2", shared); err != nil {
+        t.Fatal(err)
+    }
+    if err = c.Track(ctx, "p3", "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"); err == nil {
+        t.Fatal("pinned missing content")
+    }
+
+    if err = c.TransactionCreated(ctx, "p1", "1"); err != nil {
+        t.Fatal(err)
+    }
+
+    now := time.Now()
+    if err = c.TransactionClosed("1", now); err != nil {
+        t.Fatal(err)
+    }
+
+    // records survive restarts
+    c = newTestPins(t, dir)
+    r, ok := c.Record("p1")
+    if !ok || len(r.OpenTxs) != 0 || r.ClosedAt != now.Unix() {
+        t.Fatal("wrong record", r)
+    }
+
+    if expired, err := c.Collect(ctx, now.Add(59*time.Second)); err != nil || len(expired) != 0 {
+        t.Fatal("collected in retention", expired, err)
+    }
+
+    expired, err := c.Collect(ctx, now.Add(time.Minute))
+    if err != nil || !reflect.DeepEqual(expired, []string{"p1"}) {
+        t.Fatal("wrong expired publications", expired, err)
+    }
+
+    for id, want := range map[string]bool{meta: false, proof: false, shared: true} {
+        if pinned, _ := c.Storage.(Pinner).Pinned(ctx, id); pinned != want {
+            t.Error("wrong pin of", id, pinned)
+        }
+    }
+
+    missing, err := c.Missing(ctx)
+    if err != nil || len(missing) != 0 {
+        t.Fatal("wrong missing pins", missing, err)
+    }
+
+    os.Remove(filepath.Join(dir, "contents", shared))
+    missing, err = c.Missing(ctx)
+    if err != nil || !reflect.DeepEqual(missing, map[string][]string{"p2": {shared}}) {
+        t.Fatal("wrong missing pins", missing, err)
+    }
+}
@@ -3,8 +3,87 @@
 
 package storage
 
+import (
+    "context"
+    "github.com/pkg/errors"
+    "github.com/scryinfo/dot/dot"
+    "io"
+)
+
+const (
+    StorageTypeId = "0d3dd0df-241e-4fc3-a615-c5713f9f8db7"
+    StorageLiveId = "0d3dd0df-241e-4fc3-a615-c5713f9f8db7"
+
+    BackendIpfs  = "ipfs"
+    BackendLocal = "local"
+)
+
+// Storage stores contents by their IDs, which are IPFS CIDs whatever the backend is.
 type Storage interface {
-    Init(nodeAddr string) error
+    Initialize(nodeAddr string) error
     Save(value []byte) (string, error)
+    // Get writes the content of key to the file named key in outDir.
     Get(key string, outDir string) error
+    // Put stores the content of src as it is read, so it can be of any size.
+    Put(ctx context.Context, src io.Reader, opts ...Option) (string, error)
+    // Open returns a reader of the content of key, which must be closed.
+    Open(ctx context.Context, key string, opts ...Option) (io.ReadCloser, error)
+    SaveEncrypted(src io.Reader, wrap WrapKey) (string, error)
+    GetDecrypted(key string, outFile string, unwrap UnwrapKey) error
+}
+
+// check if 'Ipfs' and 'Local' implement 'Storage' interface.
+var _ Storage = (*Ipfs)(nil)
+var _ Storage = (*Local)(nil)
+
+// Pinger checks if a storage can be reached.
+type Pinger interface {
+    Ping(ctx context.Context) error
+}
+
+// check if 'Ipfs', 'Local' and 'Cache' implement 'Pinger' interface.
+var _ Pinger = (*Ipfs)(nil)
+var _ Pinger = (*Local)(nil)
+var _ Pinger = (*Cache)(nil)
+
+type storageConfig struct {
+    Backend   string `json:"backend"`
+    LocalDir  string `json:"localDir"`
+    CacheDir  string `json:"cacheDir"`  //contents read are not cached if empty
+    CacheSize int64  `json:"cacheSize"` //bytes
+}
+
+//construct dot, the dot is the backend selected in config, ipfs by default, behind a cache if it's configured
+func newStorageDot(conf interface{}) (dot.Dot, error) {
+    dConf := &storageConfig{}
+    if bs, ok := conf.([]byte); ok && len(bs) > 0 {
+        if err := dot.UnMarshalConfig(bs, dConf); err != nil {
+            return nil, err
+        }
+    }
+
+    var backend Storage
+    var err error
+    switch dConf.Backend {
+    case "", BackendIpfs:
+        backend = &Ipfs{}
+    case BackendLocal:
+        backend, err = NewLocal(dConf.LocalDir)
+    default:
+        err = errors.New("unknown storage backend '" + dConf.Backend + "'")
+    }
+    if err != nil || dConf.CacheDir == "" {
+        return backend, err
+    }
+
+    return NewCache(backend, dConf.CacheDir, dConf.CacheSize)
+}
+
+//Data structure needed when generating newer component
+func StorageTypeLive() *dot.TypeLives {
+    return &dot.TypeLives{
+        Meta: dot.Metadata{TypeId: StorageTypeId, NewDoter: func(conf interface{}) (dot.Dot, error) {
+            return newStorageDot(conf)
+        }},
+    }
 }
@@ -0,0 +1,108 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "context"
+    "github.com/pkg/errors"
+    "io"
+    "os"
+    "path/filepath"
+)
+
+var (
+    ErrTooLarge    = errors.New("content is larger than the size limit")
+    ErrIsDirectory = errors.New("content is a directory, which can only be got")
+)
+
+// Option is an option of Put and Open.
+type Option func(o *options)
+
+type options struct {
+    maxSize  int64
+    progress func(n int64)
+}
+
+// MaxSize limits the size of the content, reading more fails with ErrTooLarge.
+func MaxSize(n int64) Option {
+    return func(o *options) {
+        o.maxSize = n
+    }
+}
+
+// Progress is called with the number of bytes read so far after every read.
+func Progress(f func(n int64)) Option {
+    return func(o *options) {
+        o.progress = f
+    }
+}
+
+// streamReader applies the options to a content and stops reading it once ctx is done.
+type streamReader struct {
+    ctx  context.Context
+    src  io.Reader
+    opts options
+    n    int64
+}
+
+func newStreamReader(ctx context.Context, src io.Reader, opts []Option) *streamReader {
+    r := &streamReader{ctx: ctx, src: src}
+    for _, opt := range opts {
+        opt(&r.opts)
+    }
+
+    return r
+}
+
+func (r *streamReader) Read(p []byte) (int, error) {
+    if err := r.ctx.Err(); err != nil {
+        return 0, err
+    }
+
+    n, err := r.src.Read(p)
+    r.n += int64(n)
+    if r.opts.maxSize > 0 && r.n > r.opts.maxSize {
+        return n, ErrTooLarge
+    }
+    if r.opts.progress != nil && n > 0 {
+        r.opts.progress(r.n)
+    }
+
+    return n, err
+}
+
+type streamReadCloser struct {
+    *streamReader
+    io.Closer
+}
+
+func newStreamReadCloser(ctx context.Context, src io.ReadCloser, opts []Option) io.ReadCloser {
+    return streamReadCloser{streamReader: newStreamReader(ctx, src, opts), Closer: src}
+}
+
+// getFile writes the content of key in s to the file named key in outDir.
+func getFile(s Storage, key string, outDir string) error {
+    rc, err := s.Open(context.Background(), key)
+    if err != nil {
+        return err
+    }
+    defer rc.Close()
+
+    outFile := filepath.Join(outDir, key)
+    f, err := os.Create(outFile)
+    if err != nil {
+        return errors.Wrap(err, "failed to create output file")
+    }
+
+    _, err = io.Copy(f, rc)
+    if er := f.Close(); err == nil {
+        err = er
+    }
+    if err != nil {
+        os.Remove(outFile)
+        return err
+    }
+
+    return nil
+}
@@ -0,0 +1,197 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bufio"
+    "crypto/sha256"
+    "github.com/btcsuite/btcutil/base58"
+    "io"
+)
+
+// Content IDs are computed as 'ipfs add' does by default: the content is cut into chunks
+// of 256KiB, which are the leaves of a balanced dag-pb tree of unixfs file nodes with at most
+// 174 links per node, the ID is the CIDv0 of the root, the base58 sha2-256 multihash of the node.
+// With CIDv1 nodes link their children by CIDv1, and raw leaves are the chunks themselves.
+const (
+    unixfsChunkSize = 256 * 1024
+    unixfsMaxLinks  = 174
+
+    unixfsTypeFile = 2
+)
+
+type dagLink struct {
+    id       Cid
+    size     uint64
+    fileSize uint64
+}
+
+type dagBuilder struct {
+    src       *bufio.Reader
+    buf       []byte
+    err       error
+    cidV1     bool
+    rawLeaves bool
+}
+
+// ContentId returns the ID of the content of r as it would be stored in IPFS.
+func ContentId(r io.Reader) (string, error) {
+    id, err := layoutId(r, false, false)
+    if err != nil {
+        return "", err
+    }
+
+    return base58.Encode(id.Multihash), nil
+}
+
+// layoutId returns the ID of the content of r added with CIDv1 or raw leaves, as 'ipfs add --cid-version=1'
+// or '--raw-leaves' does, content of one chunk is a raw block with raw leaves.
+func layoutId(r io.Reader, cidV1 bool, rawLeaves bool) (Cid, error) {
+    b := &dagBuilder{
+        src:       bufio.NewReaderSize(r, unixfsChunkSize+1),
+        buf:       make([]byte, unixfsChunkSize),
+        cidV1:     cidV1,
+        rawLeaves: rawLeaves,
+    }
+
+    root, err := b.layout()
+    if err != nil {
+        return Cid{}, err
+    }
+
+    return root.id, nil
+}
+
+// layout builds the tree bottom up, the tree grows a level whenever the root is full.
+func (b *dagBuilder) layout() (dagLink, error) {
+    root, err := b.leaf()
+    if err != nil {
+        return dagLink{}, err
+    }
+
+    for depth := 1; !b.done(); depth++ {
+        if root, err = b.fill([]dagLink{root}, depth); err != nil {
+            return dagLink{}, err
+        }
+    }
+
+    return root, b.err
+}
+
+func (b *dagBuilder) fill(links []dagLink, depth int) (dagLink, error) {
+    for len(links) < unixfsMaxLinks && !b.done() {
+        var child dagLink
+        var err error
+        if depth == 1 {
+            child, err = b.leaf()
+        } else {
+            child, err = b.fill(nil, depth-1)
+        }
+        if err != nil {
+            return dagLink{}, err
+        }
+        links = append(links, child)
+    }
+
+    var fileSize, size uint64
+    blockSizes := make([]uint64, 0, len(links))
+    for _, l := range links {
+        fileSize += l.fileSize
+        size += l.size
+        blockSizes = append(blockSizes, l.fileSize)
+    }
+
+    node := encodeDagNode(links, encodeUnixfsFile(nil, fileSize, blockSizes))
+
+    return dagLink{id: b.nodeId(CodecDagPb, node), size: size + uint64(len(node)), fileSize: fileSize}, nil
+}
+
+func (b *dagBuilder) leaf() (dagLink, error) {
+    n, err := io.ReadFull(b.src, b.buf)
+    if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+        return dagLink{}, err
+    }
+
+    if b.rawLeaves {
+        return dagLink{id: b.nodeId(CodecRaw, b.buf[:n]), size: uint64(n), fileSize: uint64(n)}, nil
+    }
+
+    node := encodeDagNode(nil, encodeUnixfsFile(b.buf[:n], uint64(n), nil))
+
+    return dagLink{id: b.nodeId(CodecDagPb, node), size: uint64(len(node)), fileSize: uint64(n)}, nil
+}
+
+//raw blocks are always named by CIDv1
+func (b *dagBuilder) nodeId(codec uint64, node []byte) Cid {
+    if codec == CodecDagPb && !b.cidV1 {
+        return Cid{Version: 0, Codec: codec, Multihash: multihash(node)}
+    }
+
+    return Cid{Version: 1, Codec: codec, Multihash: multihash(node)}
+}
+
+func (b *dagBuilder) done() bool {
+    if b.err != nil {
+        return true
+    }
+
+    _, err := b.src.Peek(1)
+    if err != nil && err != io.EOF {
+        b.err = err
+    }
+
+    return err != nil
+}
+
+// encodeDagNode encodes a dag-pb node, links come before data.
+func encodeDagNode(links []dagLink, data []byte) []byte {
+    var out []byte
+    for _, l := range links {
+        var link []byte
+        link = appendBytesField(link, 1, l.id.Bytes())
+        link = appendBytesField(link, 2, nil)
+        link = appendVarintField(link, 3, l.size)
+        out = appendBytesField(out, 2, link)
+    }
+
+    return appendBytesField(out, 1, data)
+}
+
+func encodeUnixfsFile(data []byte, fileSize uint64, blockSizes []uint64) []byte {
+    out := appendVarintField(nil, 1, unixfsTypeFile)
+    if len(data) > 0 {
+        out = appendBytesField(out, 2, data)
+    }
+    out = appendVarintField(out, 3, fileSize)
+    for _, s := range blockSizes {
+        out = appendVarintField(out, 4, s)
+    }
+
+    return out
+}
+
+func multihash(data []byte) []byte {
+    sum := sha256.Sum256(data)
+    return append([]byte{0x12, 0x20}, sum[:]...)
+}
+
+func appendVarintField(b []byte, field int, v uint64) []byte {
+    b = appendVarint(b, uint64(field<<3))
+    return appendVarint(b, v)
+}
+
+func appendBytesField(b []byte, field int, v []byte) []byte {
+    b = appendVarint(b, uint64(field<<3|2))
+    b = appendVarint(b, uint64(len(v)))
+    return append(b, v...)
+}
+
+func appendVarint(b []byte, v uint64) []byte {
+    for v >= 0x80 {
+        b = append(b, byte(v)|0x80)
+        v >>= 7
+    }
+
+    return append(b, byte(v))
+}
@@ -0,0 +1,219 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "github.com/pkg/errors"
+    "golang.org/x/crypto/blake2b"
+    "hash"
+    "io"
+    "io/ioutil"
+    "os"
+    "time"
+)
+
+// VerificationExt is appended to the name of a fetched file to name its verification record.
+const VerificationExt = ".verification.json"
+
+var (
+    ErrContentMismatch = errors.New("content doesn't match its ID")
+    ErrUnverifiable    = errors.New("content of the ID can't be verified")
+)
+
+// Verification is the result of checking a fetched content against its ID recorded on chain,
+// it is recorded next to the content as evidence for arbitration.
+type Verification struct {
+    Id         string `json:"id"`
+    ComputedId string `json:"computedId"`
+    Size       int64  `json:"size"`
+    Sha256     string `json:"sha256"`
+    Verified   bool   `json:"verified"`
+    Time       int64  `json:"time"`
+}
+
+// GetVerified writes the content of key in s to outFile while computing its ID. If the ID isn't key,
+// outFile is removed and ErrContentMismatch is returned. Either way the verification is recorded.
+// IDs of dag-pb sha2-256 trees and of raw sha2-256 or blake2b-256 blocks can be verified, a tree is verified
+// in the layouts of 'ipfs add', other trees are kept unverified and ErrUnverifiable is returned.
+func GetVerified(ctx context.Context, s Storage, key string, outFile string, opts ...Option) (*Verification, error) {
+    c, err := ParseCid(key)
+    if err != nil {
+        return nil, err
+    }
+    if _, _, err = idHash(c); err != nil {
+        return nil, err
+    }
+
+    rc, err := s.Open(ctx, key, opts...)
+    if err != nil {
+        return nil, err
+    }
+    defer rc.Close()
+
+    f, err := os.Create(outFile)
+    if err != nil {
+        return nil, errors.Wrap(err, "failed to create output file")
+    }
+
+    h := sha256.New()
+    cr := &countReader{src: io.TeeReader(rc, io.MultiWriter(f, h))}
+
+    computed, err := computeId(c, cr)
+    if er := f.Close(); er != nil && (err == nil || err == ErrUnverifiable) {
+        err = er
+    }
+    if err != nil && err != ErrUnverifiable {
+        os.Remove(outFile)
+        return nil, err
+    }
+
+    v := &Verification{
+        Id:         key,
+        ComputedId: computed.String(),
+        Size:       cr.n,
+        Sha256:     hex.EncodeToString(h.Sum(nil)),
+        Verified:   err == nil && computed.SameContent(c),
+        Time:       time.Now().Unix(),
+    }
+    if err == nil && !v.Verified {
+        os.Remove(outFile)
+        err = ErrContentMismatch
+    }
+
+    if er := v.Save(outFile + VerificationExt); err == nil {
+        err = er
+    }
+
+    return v, err
+}
+
+// ReadVerified reads the content of key in s, its size is limited by the MaxSize option. ErrContentMismatch is
+// returned if its ID isn't key, the content is returned with ErrUnverifiable if it is a tree of another layout.
+func ReadVerified(ctx context.Context, s Storage, key string, opts ...Option) ([]byte, error) {
+    c, err := ParseCid(key)
+    if err != nil {
+        return nil, err
+    }
+    if _, _, err = idHash(c); err != nil {
+        return nil, err
+    }
+
+    rc, err := s.Open(ctx, key)
+    if err != nil {
+        return nil, err
+    }
+    defer rc.Close()
+
+    var buf bytes.Buffer
+    computed, err := computeId(c, io.TeeReader(newStreamReader(ctx, rc, opts), &buf))
+    if err == ErrUnverifiable {
+        return buf.Bytes(), err
+    }
+    if err != nil {
+        return nil, err
+    }
+    if !computed.SameContent(c) {
+        return nil, ErrContentMismatch
+    }
+
+    return buf.Bytes(), nil
+}
+
+// idHash returns the hash code of c and a hash to compute it, the hash is nil for dag-pb trees.
+func idHash(c Cid) (uint64, hash.Hash, error) {
+    code, _, err := c.Hash()
+    if err != nil {
+        return 0, nil, err
+    }
+
+    switch {
+    case c.Codec == CodecDagPb && code == HashSha2_256:
+        return code, nil, nil
+    case c.Codec == CodecRaw && code == HashSha2_256:
+        return code, sha256.New(), nil
+    case c.Codec == CodecRaw && code == HashBlake2b_256:
+        h, err := blake2b.New256(nil)
+        return code, h, err
+    }
+
+    return 0, nil, ErrUnverifiable
+}
+
+// computeId computes the ID of the content of r as c is computed, r is read to the end. ErrUnverifiable is
+// returned with the ID of the default layout if a tree isn't c in any layout of 'ipfs add'.
+func computeId(c Cid, r io.Reader) (Cid, error) {
+    code, raw, err := idHash(c)
+    if err != nil {
+        return Cid{}, err
+    }
+    if raw == nil {
+        return dagId(c, r)
+    }
+
+    computed := Cid{Version: c.Version, Codec: c.Codec}
+
+    if _, err = io.Copy(raw, r); err != nil {
+        return Cid{}, err
+    }
+    computed.Multihash = appendVarint(appendVarint(nil, code), uint64(raw.Size()))
+    computed.Multihash = append(computed.Multihash, raw.Sum(nil)...)
+
+    return computed, nil
+}
+
+// dagId computes the IDs of the content of r with file and raw leaves at once, the default leaves of the version
+// of c first, 'ipfs add' uses raw leaves with CIDv1. Trees of other chunkers or the trickle layout can't be told
+// from tampered content by their root.
+func dagId(c Cid, r io.Reader) (Cid, error) {
+    v1 := c.Version == 1
+    pr, pw := io.Pipe()
+    other := make(chan Cid, 1)
+    go func() {
+        id, err := layoutId(pr, v1, !v1)
+        pr.CloseWithError(err)
+        other <- id
+    }()
+
+    computed, err := layoutId(io.TeeReader(r, pw), v1, v1)
+    pw.CloseWithError(err)
+    ids := []Cid{computed, <-other}
+    if err != nil {
+        return Cid{}, err
+    }
+
+    for _, id := range ids {
+        if id.SameContent(c) {
+            return id, nil
+        }
+    }
+
+    return computed, ErrUnverifiable
+}
+
+// Save writes the verification to file in json.
+func (v *Verification) Save(file string) error {
+    bs, err := json.MarshalIndent(v, "", "  ")
+    if err != nil {
+        return err
+    }
+
+    return errors.Wrap(ioutil.WriteFile(file, bs, 0600), "failed to record verification")
+}
+
+type countReader struct {
+    src io.Reader
+    n   int64
+}
+
+func (r *countReader) Read(p []byte) (int, error) {
+    n, err := r.src.Read(p)
+    r.n += int64(n)
+
+    return n, err
+}
@@ -0,0 +1,189 @@
+// Scry Info.  All rights reserved.
+// license that can be found in the license file.
+
+package storage
+
+import (
+    "bytes"
+    "context"
+    "crypto/sha256"
+    "encoding/json"
+    "io"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+)
+
+func TestGetVerified(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := NewLocal(filepath.Join(dir, "store"))
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    proof := bytes.Repeat([]byte("proof"), 1000)
+    key, err := s.Save(proof)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    outFile := filepath.Join(dir, "proof.txt")
+    v, err := GetVerified(context.Background(), s, key, outFile)
+    if err != nil {
+        t.Fatal(err)
+    }
+    if !v.Verified || v.ComputedId != key || v.Size != int64(len(proof)) {
+        t.Errorf("wrong verification %+v", v)
+    }
+    if got, err := ioutil.ReadFile(outFile); err != nil || !bytes.Equal(got, proof) {
+        t.Error("proof changed", err)
+    }
+
+    // the stored content is replaced, as by a node returning other bytes for the ID, a tree of
+    // another layout can't be told from it, so it is kept unverified
+    if err = ioutil.WriteFile(filepath.Join(dir, "store", key), []byte("forged"), 0600); err != nil {
+        t.Fatal(err)
+    }
+    unverifiedFile := filepath.Join(dir, "unverified.txt")
+    if v, err = GetVerified(context.Background(), s, key, unverifiedFile); err != ErrUnverifiable {
+        t.Fatal("forged content verified", err)
+    }
+    if v.Verified || v.ComputedId == key {
+        t.Errorf("wrong verification %+v", v)
+    }
+    if _, err = os.Stat(unverifiedFile); err != nil {
+        t.Error("unverified content isn't kept", err)
+    }
+
+    // the content of a raw block is its hash
+    raw := rawCid(proof)
+    forgedFile := filepath.Join(dir, "forged.txt")
+    if v, err = GetVerified(context.Background(), blocks{raw.String(): []byte("forged")}, raw.String(), forgedFile); err != ErrContentMismatch {
+        t.Fatal("forged content verified", err)
+    }
+    if _, err = os.Stat(forgedFile); !os.IsNotExist(err) {
+        t.Error("forged content is kept")
+    }
+
+    bs, err := ioutil.ReadFile(forgedFile + VerificationExt)
+    if err != nil {
+        t.Fatal(err)
+    }
+    var recorded Verification
+    if err = json.Unmarshal(bs, &recorded); err != nil {
+        t.Fatal(err)
+    }
+    if recorded != *v || recorded.Verified || recorded.Size != 6 {
+        t.Errorf("wrong recorded verification %+v", recorded)
+    }
+}
+
+func TestReadVerified(t *testing.T) {
+    dir, err := ioutil.TempDir("", "storage")
+    if err != nil {
+        t.Fatal(err)
+    }
+    defer os.RemoveAll(dir)
+
+    s, err := NewLocal(dir)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    meta := []byte("meta data")
+    key, err := s.Save(meta)
+    if err != nil {
+        t.Fatal(err)
+    }
+
+    ctx := context.Background()
+    if got, err := ReadVerified(ctx, s, key, MaxSize(int64(len(meta)))); err != nil || !bytes.Equal(got, meta) {
+        t.Fatal("wrong content", string(got), err)
+    }
+    if _, err = ReadVerified(ctx, s, key, MaxSize(int64(len(meta))-1)); err != ErrTooLarge {
+        t.Error("read too large content", err)
+    }
+
+    if err = ioutil.WriteFile(filepath.Join(dir, key), []byte("forged"), 0600); err != nil {
+        t.Fatal(err)
+    }
+    if got, err := ReadVerified(ctx, s, key, MaxSize(100)); err != ErrUnverifiable || string(got) != "forged" {
+        t.Error("forged content verified", string(got), err)
+    }
+
+    raw := rawCid(meta)
+    if _, err = ReadVerified(ctx, blocks{raw.String(): []byte("forged")}, raw.String(), MaxSize(100)); err != ErrContentMismatch {
+        t.Error("forged content verified", err)
+    }
+}
+
+func TestVerifyLayouts(t *testing.T) {
+    // ids of the content added by 'ipfs add' with the default options, '--cid-version=1',
+    // '--trickle' and '--chunker=size-131072'
+    content := layoutContent()
+    ids := map[string]error{
+        "QmUXYjTnxnh9vvdWx3fc2e2PveWkpPohgpf5TcQhWThgDo":              nil,
+        "bafybeignwiuy6jghtkol7uuttycv2xc3vsehxishgfa3lvko2ixqyqgigm": nil,
+        "QmPwHr8mTdqvQK6sdJXjRVzZL5x1aDumxbMT6kcegjgfxB":              ErrUnverifiable,
+        "QmZygtD7hDNPbfEUPFjPvh44hqUAr4PpcxogFpi1dc485x":              ErrUnverifiable,
+    }
+
+    if id, err := ContentId(bytes.NewReader(content)); err != nil || id != "QmUXYjTnxnh9vvdWx3fc2e2PveWkpPohgpf5TcQhWThgDo" {
+        t.Error("wrong content id", id, err)
+    }
+    for id, want := range ids {
+        got, err := ReadVerified(context.Background(), blocks{id: content}, id)
+        if err != want || !bytes.Equal(got, content) {
+            t.Error("wrong verification of", id, err)
+        }
+    }
+}
+
+// layoutContent returns the content of three chunks which ids of layouts are computed for.
+func layoutContent() []byte {
+    content := make([]byte, 600*1024)
+    for i := range content {
+        content[i] = byte(i*7 + i/1000)
+    }
+
+    return content
+}
+
+// blocks is a storage of fixed contents, which can only be opened.
+type blocks map[string][]byte
+
+func (s blocks) Initialize(nodeAddr string) error    { return nil }
+func (s blocks) Save(value []byte) (string, error)   { return "", ErrNotFound }
+func (s blocks) Get(key string, outDir string) error { return getFile(s, key, outDir) }
+
+func (s blocks) SaveEncrypted(src io.Reader, wrap WrapKey) (string, error) {
+    return "", ErrNotFound
+}
+
+func (s blocks) Put(ctx context.Context, src io.Reader, opts ...Option) (string, error) {
+    return "", ErrNotFound
+}
+
+func (s blocks) GetDecrypted(key string, outFile string, unwrap UnwrapKey) error {
+    return ErrNotFound
+}
+
+func (s blocks) Open(ctx context.Context, key string, opts ...Option) (io.ReadCloser, error) {
+    bs, ok := s[key]
+    if !ok {
+        return nil, ErrNotFound
+    }
+
+    return newStreamReadCloser(ctx, ioutil.NopCloser(bytes.NewReader(bs)), opts), nil
+}
+
+func rawCid(bs []byte) Cid {
+    sum := sha256.Sum256(bs)
+    return Cid{Version: 1, Codec: CodecRaw, Multihash: append([]byte{HashSha2_256, 32}, sum[:]...)}
+}